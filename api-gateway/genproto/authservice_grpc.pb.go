@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,10 +20,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Register_FullMethodName          = "/ihavefood.AuthService/Register"
-	AuthService_Login_FullMethodName             = "/ihavefood.AuthService/Login"
-	AuthService_UpdatePhoneNumber_FullMethodName = "/ihavefood.AuthService/UpdatePhoneNumber"
-	AuthService_CreateAdmin_FullMethodName       = "/ihavefood.AuthService/CreateAdmin"
+	AuthService_Register_FullMethodName                 = "/ihavefood.AuthService/Register"
+	AuthService_Login_FullMethodName                    = "/ihavefood.AuthService/Login"
+	AuthService_StartPhoneLogin_FullMethodName          = "/ihavefood.AuthService/StartPhoneLogin"
+	AuthService_CompletePhoneLogin_FullMethodName       = "/ihavefood.AuthService/CompletePhoneLogin"
+	AuthService_StartSocialLogin_FullMethodName         = "/ihavefood.AuthService/StartSocialLogin"
+	AuthService_CompleteSocialLogin_FullMethodName      = "/ihavefood.AuthService/CompleteSocialLogin"
+	AuthService_VerifySecondFactor_FullMethodName       = "/ihavefood.AuthService/VerifySecondFactor"
+	AuthService_BeginTOTPEnrollment_FullMethodName      = "/ihavefood.AuthService/BeginTOTPEnrollment"
+	AuthService_ConfirmTOTPEnrollment_FullMethodName    = "/ihavefood.AuthService/ConfirmTOTPEnrollment"
+	AuthService_RequestEmailChange_FullMethodName       = "/ihavefood.AuthService/RequestEmailChange"
+	AuthService_ConfirmEmailChange_FullMethodName       = "/ihavefood.AuthService/ConfirmEmailChange"
+	AuthService_RequestPhoneNumberChange_FullMethodName = "/ihavefood.AuthService/RequestPhoneNumberChange"
+	AuthService_ConfirmPhoneNumberChange_FullMethodName = "/ihavefood.AuthService/ConfirmPhoneNumberChange"
+	AuthService_CreateAdmin_FullMethodName              = "/ihavefood.AuthService/CreateAdmin"
+	AuthService_ListAuths_FullMethodName                = "/ihavefood.AuthService/ListAuths"
+	AuthService_DisableAuth_FullMethodName              = "/ihavefood.AuthService/DisableAuth"
+	AuthService_EnableAuth_FullMethodName               = "/ihavefood.AuthService/EnableAuth"
+	AuthService_UpdateRole_FullMethodName               = "/ihavefood.AuthService/UpdateRole"
+	AuthService_DeleteAuth_FullMethodName               = "/ihavefood.AuthService/DeleteAuth"
+	AuthService_UpgradeGuest_FullMethodName             = "/ihavefood.AuthService/UpgradeGuest"
+	AuthService_MergeGuest_FullMethodName               = "/ihavefood.AuthService/MergeGuest"
+	AuthService_ChangePassword_FullMethodName           = "/ihavefood.AuthService/ChangePassword"
+	AuthService_DeleteAccount_FullMethodName            = "/ihavefood.AuthService/DeleteAccount"
+	AuthService_CheckSession_FullMethodName             = "/ihavefood.AuthService/CheckSession"
+	AuthService_CreateAPIKey_FullMethodName             = "/ihavefood.AuthService/CreateAPIKey"
+	AuthService_ListAPIKeys_FullMethodName              = "/ihavefood.AuthService/ListAPIKeys"
+	AuthService_RevokeAPIKey_FullMethodName             = "/ihavefood.AuthService/RevokeAPIKey"
+	AuthService_VerifyAPIKey_FullMethodName             = "/ihavefood.AuthService/VerifyAPIKey"
+	AuthService_ListContacts_FullMethodName             = "/ihavefood.AuthService/ListContacts"
+	AuthService_ExportAuthData_FullMethodName           = "/ihavefood.AuthService/ExportAuthData"
+	AuthService_ListSecurityEvents_FullMethodName       = "/ihavefood.AuthService/ListSecurityEvents"
+	AuthService_SubmitRiderDocuments_FullMethodName     = "/ihavefood.AuthService/SubmitRiderDocuments"
+	AuthService_GetRiderApplication_FullMethodName      = "/ihavefood.AuthService/GetRiderApplication"
+	AuthService_ListRiderApplications_FullMethodName    = "/ihavefood.AuthService/ListRiderApplications"
+	AuthService_ApproveRiderApplication_FullMethodName  = "/ihavefood.AuthService/ApproveRiderApplication"
+	AuthService_RejectRiderApplication_FullMethodName   = "/ihavefood.AuthService/RejectRiderApplication"
+	AuthService_ListPermissions_FullMethodName          = "/ihavefood.AuthService/ListPermissions"
+	AuthService_ListAccessRoles_FullMethodName          = "/ihavefood.AuthService/ListAccessRoles"
+	AuthService_PutAccessRole_FullMethodName            = "/ihavefood.AuthService/PutAccessRole"
+	AuthService_DeleteAccessRole_FullMethodName         = "/ihavefood.AuthService/DeleteAccessRole"
+	AuthService_GetAuthPermissions_FullMethodName       = "/ihavefood.AuthService/GetAuthPermissions"
+	AuthService_AssignAccessRole_FullMethodName         = "/ihavefood.AuthService/AssignAccessRole"
+	AuthService_UnassignAccessRole_FullMethodName       = "/ihavefood.AuthService/UnassignAccessRole"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -34,10 +74,131 @@ const (
 type AuthServiceClient interface {
 	// Create a new auth credential (customer, merchant, rider) using one endpoint.
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
-	// Login sign in as customer, rider
+	// Login sign in as customer, rider, merchant
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	UpdatePhoneNumber(ctx context.Context, in *UpdatePhoneNumberRequest, opts ...grpc.CallOption) (*UpdatePhoneNumberResponse, error)
+	// StartPhoneLogin sends a one-time login code by SMS to the phone number
+	// of an account. The response is the same whether or not an account has
+	// the number. With allow_guest the code is sent to any number, so that a
+	// guest can check out.
+	StartPhoneLogin(ctx context.Context, in *StartPhoneLoginRequest, opts ...grpc.CallOption) (*StartPhoneLoginResponse, error)
+	// CompletePhoneLogin logs in with the code sent by StartPhoneLogin. With
+	// allow_guest a number without an account logs in as a new guest
+	// customer, who can browse and place and track orders only.
+	CompletePhoneLogin(ctx context.Context, in *CompletePhoneLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// StartSocialLogin begins an OpenID Connect login with the provider, e.g.
+	// "google" or "line". The client redirects the user to authorization_url
+	// and keeps state to compare with the one returned to the redirect URL.
+	// The api-gateway also binds state to the browser with an HttpOnly cookie.
+	StartSocialLogin(ctx context.Context, in *StartSocialLoginRequest, opts ...grpc.CallOption) (*StartSocialLoginResponse, error)
+	// CompleteSocialLogin exchanges the authorization code returned to the
+	// redirect URL and logs in. The provider identity is linked to an existing
+	// account with the same verified email, or a new customer is created.
+	// It must be called from the browser that started the login.
+	CompleteSocialLogin(ctx context.Context, in *CompleteSocialLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// VerifySecondFactor completes a login that returned second_factor_required
+	// using a TOTP code or an unused recovery code.
+	VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// BeginTOTPEnrollment generates a TOTP secret for the account. Signed-in
+	// users call the /api binding, accounts that must enrol during login pass
+	// the second_factor_token from Login.
+	BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentRequest, opts ...grpc.CallOption) (*BeginTOTPEnrollmentResponse, error)
+	// ConfirmTOTPEnrollment enables TOTP after verifying a code from the
+	// authenticator app and returns one-time recovery codes. When called with
+	// a second_factor_token it also completes the login.
+	ConfirmTOTPEnrollment(ctx context.Context, in *ConfirmTOTPEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTOTPEnrollmentResponse, error)
+	// RequestEmailChange verifies the current password and sends a code to the
+	// new email address. The email changes once the code is confirmed.
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	// ConfirmEmailChange changes the email with the code sent to the new
+	// address and publishes "sync.<role>.email.updated".
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	// RequestPhoneNumberChange verifies the current password and sends a code
+	// to the new phone number. The number changes once the code is confirmed.
+	RequestPhoneNumberChange(ctx context.Context, in *RequestPhoneNumberChangeRequest, opts ...grpc.CallOption) (*RequestPhoneNumberChangeResponse, error)
+	// ConfirmPhoneNumberChange changes the phone number with the code sent to
+	// the new number and publishes "sync.<role>.phone_number.updated". Other
+	// services only keep a copy of the phone number.
+	ConfirmPhoneNumberChange(ctx context.Context, in *ConfirmPhoneNumberChangeRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	// CreateAdmin creates an admin account with the built-in "admin" access
+	// role. It requires the "admins:write" permission.
 	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	// ListAuths searches credentials for admins, newest first.
+	ListAuths(ctx context.Context, in *ListAuthsRequest, opts ...grpc.CallOption) (*ListAuthsResponse, error)
+	// DisableAuth prevents the account from logging in.
+	DisableAuth(ctx context.Context, in *DisableAuthRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	EnableAuth(ctx context.Context, in *EnableAuthRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	// UpdateRole changes the role of the account. Granting or revoking admin
+	// roles requires the "admins:write" permission.
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	DeleteAuth(ctx context.Context, in *DeleteAuthRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpgradeGuest turns the calling guest into a full customer account with
+	// an email and password. The account keeps its ID, so its orders and
+	// addresses stay. A new access token is issued.
+	UpgradeGuest(ctx context.Context, in *UpgradeGuestRequest, opts ...grpc.CallOption) (*AuthCredentials, error)
+	// MergeGuest moves the calling guest into an existing customer account
+	// after verifying its password, and logs in as that account. The guest is
+	// deleted and "sync.customer.merged" moves its orders and addresses.
+	MergeGuest(ctx context.Context, in *MergeGuestRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// ChangePassword replaces the password after verifying the current one.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteAccount deletes the caller's account after verifying the password
+	// or a recent login. Sessions are revoked and the other services anonymise personal data.
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CheckSession reports whether a token issued at issue_time is still
+	// valid. The api-gateway calls it to reject revoked sessions.
+	CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error)
+	// CreateAPIKey issues an API key for a point of sale of the merchant. The
+	// key is only returned here, auth keeps a hash of it.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey stops the key from working. Revoked keys are still listed.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	// VerifyAPIKey returns the account and scopes of a valid key and records
+	// its use. The api-gateway calls it for requests with an "X-API-Key"
+	// header.
+	VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyRequest, opts ...grpc.CallOption) (*VerifyAPIKeyResponse, error)
+	// ListContacts lists the email and phone number of every account with the
+	// role, newest first. Services call it to reconcile their copies.
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// ExportAuthData returns what auth holds about an account, for personal
+	// data exports made by customerservice, the only caller allowed. Secrets
+	// are never included.
+	ExportAuthData(ctx context.Context, in *ExportAuthDataRequest, opts ...grpc.CallOption) (*AuthDataExport, error)
+	// ListSecurityEvents shows security events of an account, newest first.
+	// Callers with "security_events:read" can list events of every account.
+	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error)
+	// SubmitRiderDocuments submits the documents of a rider for review. A
+	// rejected rider can submit again.
+	SubmitRiderDocuments(ctx context.Context, in *SubmitRiderDocumentsRequest, opts ...grpc.CallOption) (*RiderApplication, error)
+	// GetRiderApplication returns the onboarding state of a rider. Admins can
+	// get the application of every rider.
+	GetRiderApplication(ctx context.Context, in *GetRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error)
+	// ListRiderApplications lists rider applications for admins, newest
+	// first.
+	ListRiderApplications(ctx context.Context, in *ListRiderApplicationsRequest, opts ...grpc.CallOption) (*ListRiderApplicationsResponse, error)
+	// ApproveRiderApplication approves a pending rider with submitted
+	// documents. The rider is then created in delivery through
+	// "sync.rider.created", and "sync.rider.approval.updated" is published.
+	ApproveRiderApplication(ctx context.Context, in *ApproveRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error)
+	// RejectRiderApplication rejects a pending rider and publishes
+	// "sync.rider.approval.updated".
+	RejectRiderApplication(ctx context.Context, in *RejectRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error)
+	// ListPermissions lists the permissions access roles can be made of.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	ListAccessRoles(ctx context.Context, in *ListAccessRolesRequest, opts ...grpc.CallOption) (*ListAccessRolesResponse, error)
+	// PutAccessRole creates an access role or replaces its permissions.
+	// Sessions of the accounts holding the role are revoked so their next
+	// token carries the new permissions. Built-in roles cannot be changed.
+	PutAccessRole(ctx context.Context, in *PutAccessRoleRequest, opts ...grpc.CallOption) (*AccessRole, error)
+	// DeleteAccessRole deletes an access role that no account holds.
+	DeleteAccessRole(ctx context.Context, in *DeleteAccessRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetAuthPermissions returns the access roles of an account and the
+	// permissions they add up to.
+	GetAuthPermissions(ctx context.Context, in *GetAuthPermissionsRequest, opts ...grpc.CallOption) (*AuthPermissions, error)
+	// AssignAccessRole gives an access role to an admin account. Callers can
+	// only assign roles whose permissions they hold themselves.
+	AssignAccessRole(ctx context.Context, in *AssignAccessRoleRequest, opts ...grpc.CallOption) (*AuthPermissions, error)
+	UnassignAccessRole(ctx context.Context, in *UnassignAccessRoleRequest, opts ...grpc.CallOption) (*AuthPermissions, error)
 }
 
 type authServiceClient struct {
@@ -68,10 +229,110 @@ func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ..
 	return out, nil
 }
 
-func (c *authServiceClient) UpdatePhoneNumber(ctx context.Context, in *UpdatePhoneNumberRequest, opts ...grpc.CallOption) (*UpdatePhoneNumberResponse, error) {
+func (c *authServiceClient) StartPhoneLogin(ctx context.Context, in *StartPhoneLoginRequest, opts ...grpc.CallOption) (*StartPhoneLoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdatePhoneNumberResponse)
-	err := c.cc.Invoke(ctx, AuthService_UpdatePhoneNumber_FullMethodName, in, out, cOpts...)
+	out := new(StartPhoneLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartPhoneLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompletePhoneLogin(ctx context.Context, in *CompletePhoneLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompletePhoneLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) StartSocialLogin(ctx context.Context, in *StartSocialLoginRequest, opts ...grpc.CallOption) (*StartSocialLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSocialLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartSocialLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompleteSocialLogin(ctx context.Context, in *CompleteSocialLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompleteSocialLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifySecondFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentRequest, opts ...grpc.CallOption) (*BeginTOTPEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTOTPEnrollmentResponse)
+	err := c.cc.Invoke(ctx, AuthService_BeginTOTPEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmTOTPEnrollment(ctx context.Context, in *ConfirmTOTPEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTOTPEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPEnrollmentResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmTOTPEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPhoneNumberChange(ctx context.Context, in *RequestPhoneNumberChangeRequest, opts ...grpc.CallOption) (*RequestPhoneNumberChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPhoneNumberChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestPhoneNumberChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmPhoneNumberChange(ctx context.Context, in *ConfirmPhoneNumberChangeRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmPhoneNumberChange_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,133 +346,1363 @@ func (c *authServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *authServiceClient) ListAuths(ctx context.Context, in *ListAuthsRequest, opts ...grpc.CallOption) (*ListAuthsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListAuths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableAuth(ctx context.Context, in *DisableAuthRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_DisableAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EnableAuth(ctx context.Context, in *EnableAuthRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_EnableAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAuth(ctx context.Context, in *DeleteAuthRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpgradeGuest(ctx context.Context, in *UpgradeGuestRequest, opts ...grpc.CallOption) (*AuthCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCredentials)
+	err := c.cc.Invoke(ctx, AuthService_UpgradeGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) MergeGuest(ctx context.Context, in *MergeGuestRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_MergeGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_CheckSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyRequest, opts ...grpc.CallOption) (*VerifyAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExportAuthData(ctx context.Context, in *ExportAuthDataRequest, opts ...grpc.CallOption) (*AuthDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthDataExport)
+	err := c.cc.Invoke(ctx, AuthService_ExportAuthData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecurityEventsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListSecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SubmitRiderDocuments(ctx context.Context, in *SubmitRiderDocumentsRequest, opts ...grpc.CallOption) (*RiderApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RiderApplication)
+	err := c.cc.Invoke(ctx, AuthService_SubmitRiderDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetRiderApplication(ctx context.Context, in *GetRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RiderApplication)
+	err := c.cc.Invoke(ctx, AuthService_GetRiderApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListRiderApplications(ctx context.Context, in *ListRiderApplicationsRequest, opts ...grpc.CallOption) (*ListRiderApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiderApplicationsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListRiderApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ApproveRiderApplication(ctx context.Context, in *ApproveRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RiderApplication)
+	err := c.cc.Invoke(ctx, AuthService_ApproveRiderApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RejectRiderApplication(ctx context.Context, in *RejectRiderApplicationRequest, opts ...grpc.CallOption) (*RiderApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RiderApplication)
+	err := c.cc.Invoke(ctx, AuthService_RejectRiderApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListAccessRoles(ctx context.Context, in *ListAccessRolesRequest, opts ...grpc.CallOption) (*ListAccessRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRolesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListAccessRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) PutAccessRole(ctx context.Context, in *PutAccessRoleRequest, opts ...grpc.CallOption) (*AccessRole, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessRole)
+	err := c.cc.Invoke(ctx, AuthService_PutAccessRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAccessRole(ctx context.Context, in *DeleteAccessRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAccessRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetAuthPermissions(ctx context.Context, in *GetAuthPermissionsRequest, opts ...grpc.CallOption) (*AuthPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthPermissions)
+	err := c.cc.Invoke(ctx, AuthService_GetAuthPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AssignAccessRole(ctx context.Context, in *AssignAccessRoleRequest, opts ...grpc.CallOption) (*AuthPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthPermissions)
+	err := c.cc.Invoke(ctx, AuthService_AssignAccessRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnassignAccessRole(ctx context.Context, in *UnassignAccessRoleRequest, opts ...grpc.CallOption) (*AuthPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthPermissions)
+	err := c.cc.Invoke(ctx, AuthService_UnassignAccessRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// ---------------------AUTH SERVICE------------------------------
+// Manages auth credentials
+type AuthServiceServer interface {
+	// Create a new auth credential (customer, merchant, rider) using one endpoint.
+	Register(context.Context, *RegisterRequest) (*AuthCredentials, error)
+	// Login sign in as customer, rider, merchant
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// StartPhoneLogin sends a one-time login code by SMS to the phone number
+	// of an account. The response is the same whether or not an account has
+	// the number. With allow_guest the code is sent to any number, so that a
+	// guest can check out.
+	StartPhoneLogin(context.Context, *StartPhoneLoginRequest) (*StartPhoneLoginResponse, error)
+	// CompletePhoneLogin logs in with the code sent by StartPhoneLogin. With
+	// allow_guest a number without an account logs in as a new guest
+	// customer, who can browse and place and track orders only.
+	CompletePhoneLogin(context.Context, *CompletePhoneLoginRequest) (*LoginResponse, error)
+	// StartSocialLogin begins an OpenID Connect login with the provider, e.g.
+	// "google" or "line". The client redirects the user to authorization_url
+	// and keeps state to compare with the one returned to the redirect URL.
+	// The api-gateway also binds state to the browser with an HttpOnly cookie.
+	StartSocialLogin(context.Context, *StartSocialLoginRequest) (*StartSocialLoginResponse, error)
+	// CompleteSocialLogin exchanges the authorization code returned to the
+	// redirect URL and logs in. The provider identity is linked to an existing
+	// account with the same verified email, or a new customer is created.
+	// It must be called from the browser that started the login.
+	CompleteSocialLogin(context.Context, *CompleteSocialLoginRequest) (*LoginResponse, error)
+	// VerifySecondFactor completes a login that returned second_factor_required
+	// using a TOTP code or an unused recovery code.
+	VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*LoginResponse, error)
+	// BeginTOTPEnrollment generates a TOTP secret for the account. Signed-in
+	// users call the /api binding, accounts that must enrol during login pass
+	// the second_factor_token from Login.
+	BeginTOTPEnrollment(context.Context, *BeginTOTPEnrollmentRequest) (*BeginTOTPEnrollmentResponse, error)
+	// ConfirmTOTPEnrollment enables TOTP after verifying a code from the
+	// authenticator app and returns one-time recovery codes. When called with
+	// a second_factor_token it also completes the login.
+	ConfirmTOTPEnrollment(context.Context, *ConfirmTOTPEnrollmentRequest) (*ConfirmTOTPEnrollmentResponse, error)
+	// RequestEmailChange verifies the current password and sends a code to the
+	// new email address. The email changes once the code is confirmed.
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	// ConfirmEmailChange changes the email with the code sent to the new
+	// address and publishes "sync.<role>.email.updated".
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*AuthCredentials, error)
+	// RequestPhoneNumberChange verifies the current password and sends a code
+	// to the new phone number. The number changes once the code is confirmed.
+	RequestPhoneNumberChange(context.Context, *RequestPhoneNumberChangeRequest) (*RequestPhoneNumberChangeResponse, error)
+	// ConfirmPhoneNumberChange changes the phone number with the code sent to
+	// the new number and publishes "sync.<role>.phone_number.updated". Other
+	// services only keep a copy of the phone number.
+	ConfirmPhoneNumberChange(context.Context, *ConfirmPhoneNumberChangeRequest) (*AuthCredentials, error)
+	// CreateAdmin creates an admin account with the built-in "admin" access
+	// role. It requires the "admins:write" permission.
+	CreateAdmin(context.Context, *CreateAdminRequest) (*AuthCredentials, error)
+	// ListAuths searches credentials for admins, newest first.
+	ListAuths(context.Context, *ListAuthsRequest) (*ListAuthsResponse, error)
+	// DisableAuth prevents the account from logging in.
+	DisableAuth(context.Context, *DisableAuthRequest) (*AuthCredentials, error)
+	EnableAuth(context.Context, *EnableAuthRequest) (*AuthCredentials, error)
+	// UpdateRole changes the role of the account. Granting or revoking admin
+	// roles requires the "admins:write" permission.
+	UpdateRole(context.Context, *UpdateRoleRequest) (*AuthCredentials, error)
+	DeleteAuth(context.Context, *DeleteAuthRequest) (*emptypb.Empty, error)
+	// UpgradeGuest turns the calling guest into a full customer account with
+	// an email and password. The account keeps its ID, so its orders and
+	// addresses stay. A new access token is issued.
+	UpgradeGuest(context.Context, *UpgradeGuestRequest) (*AuthCredentials, error)
+	// MergeGuest moves the calling guest into an existing customer account
+	// after verifying its password, and logs in as that account. The guest is
+	// deleted and "sync.customer.merged" moves its orders and addresses.
+	MergeGuest(context.Context, *MergeGuestRequest) (*LoginResponse, error)
+	// ChangePassword replaces the password after verifying the current one.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// DeleteAccount deletes the caller's account after verifying the password
+	// or a recent login. Sessions are revoked and the other services anonymise personal data.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
+	// CheckSession reports whether a token issued at issue_time is still
+	// valid. The api-gateway calls it to reject revoked sessions.
+	CheckSession(context.Context, *CheckSessionRequest) (*CheckSessionResponse, error)
+	// CreateAPIKey issues an API key for a point of sale of the merchant. The
+	// key is only returned here, auth keeps a hash of it.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey stops the key from working. Revoked keys are still listed.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error)
+	// VerifyAPIKey returns the account and scopes of a valid key and records
+	// its use. The api-gateway calls it for requests with an "X-API-Key"
+	// header.
+	VerifyAPIKey(context.Context, *VerifyAPIKeyRequest) (*VerifyAPIKeyResponse, error)
+	// ListContacts lists the email and phone number of every account with the
+	// role, newest first. Services call it to reconcile their copies.
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	// ExportAuthData returns what auth holds about an account, for personal
+	// data exports made by customerservice, the only caller allowed. Secrets
+	// are never included.
+	ExportAuthData(context.Context, *ExportAuthDataRequest) (*AuthDataExport, error)
+	// ListSecurityEvents shows security events of an account, newest first.
+	// Callers with "security_events:read" can list events of every account.
+	ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error)
+	// SubmitRiderDocuments submits the documents of a rider for review. A
+	// rejected rider can submit again.
+	SubmitRiderDocuments(context.Context, *SubmitRiderDocumentsRequest) (*RiderApplication, error)
+	// GetRiderApplication returns the onboarding state of a rider. Admins can
+	// get the application of every rider.
+	GetRiderApplication(context.Context, *GetRiderApplicationRequest) (*RiderApplication, error)
+	// ListRiderApplications lists rider applications for admins, newest
+	// first.
+	ListRiderApplications(context.Context, *ListRiderApplicationsRequest) (*ListRiderApplicationsResponse, error)
+	// ApproveRiderApplication approves a pending rider with submitted
+	// documents. The rider is then created in delivery through
+	// "sync.rider.created", and "sync.rider.approval.updated" is published.
+	ApproveRiderApplication(context.Context, *ApproveRiderApplicationRequest) (*RiderApplication, error)
+	// RejectRiderApplication rejects a pending rider and publishes
+	// "sync.rider.approval.updated".
+	RejectRiderApplication(context.Context, *RejectRiderApplicationRequest) (*RiderApplication, error)
+	// ListPermissions lists the permissions access roles can be made of.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	ListAccessRoles(context.Context, *ListAccessRolesRequest) (*ListAccessRolesResponse, error)
+	// PutAccessRole creates an access role or replaces its permissions.
+	// Sessions of the accounts holding the role are revoked so their next
+	// token carries the new permissions. Built-in roles cannot be changed.
+	PutAccessRole(context.Context, *PutAccessRoleRequest) (*AccessRole, error)
+	// DeleteAccessRole deletes an access role that no account holds.
+	DeleteAccessRole(context.Context, *DeleteAccessRoleRequest) (*emptypb.Empty, error)
+	// GetAuthPermissions returns the access roles of an account and the
+	// permissions they add up to.
+	GetAuthPermissions(context.Context, *GetAuthPermissionsRequest) (*AuthPermissions, error)
+	// AssignAccessRole gives an access role to an admin account. Callers can
+	// only assign roles whose permissions they hold themselves.
+	AssignAccessRole(context.Context, *AssignAccessRoleRequest) (*AuthPermissions, error)
+	UnassignAccessRole(context.Context, *UnassignAccessRoleRequest) (*AuthPermissions, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) Register(context.Context, *RegisterRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) StartPhoneLogin(context.Context, *StartPhoneLoginRequest) (*StartPhoneLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPhoneLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CompletePhoneLogin(context.Context, *CompletePhoneLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePhoneLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) StartSocialLogin(context.Context, *StartSocialLoginRequest) (*StartSocialLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSocialLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CompleteSocialLogin(context.Context, *CompleteSocialLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSocialLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecondFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) BeginTOTPEnrollment(context.Context, *BeginTOTPEnrollmentRequest) (*BeginTOTPEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTOTPEnrollment not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmTOTPEnrollment(context.Context, *ConfirmTOTPEnrollmentRequest) (*ConfirmTOTPEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTPEnrollment not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestPhoneNumberChange(context.Context, *RequestPhoneNumberChangeRequest) (*RequestPhoneNumberChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPhoneNumberChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmPhoneNumberChange(context.Context, *ConfirmPhoneNumberChangeRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPhoneNumberChange not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateAdmin(context.Context, *CreateAdminRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAdmin not implemented")
+}
+func (UnimplementedAuthServiceServer) ListAuths(context.Context, *ListAuthsRequest) (*ListAuthsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuths not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableAuth(context.Context, *DisableAuthRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAuth not implemented")
+}
+func (UnimplementedAuthServiceServer) EnableAuth(context.Context, *EnableAuthRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAuth not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAuth(context.Context, *DeleteAuthRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuth not implemented")
+}
+func (UnimplementedAuthServiceServer) UpgradeGuest(context.Context, *UpgradeGuestRequest) (*AuthCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeGuest not implemented")
+}
+func (UnimplementedAuthServiceServer) MergeGuest(context.Context, *MergeGuestRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuest not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) CheckSession(context.Context, *CheckSessionRequest) (*CheckSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSession not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAuthServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyAPIKey(context.Context, *VerifyAPIKeyRequest) (*VerifyAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAPIKey not implemented")
+}
+func (UnimplementedAuthServiceServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedAuthServiceServer) ExportAuthData(context.Context, *ExportAuthDataRequest) (*AuthDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuthData not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityEvents not implemented")
+}
+func (UnimplementedAuthServiceServer) SubmitRiderDocuments(context.Context, *SubmitRiderDocumentsRequest) (*RiderApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRiderDocuments not implemented")
+}
+func (UnimplementedAuthServiceServer) GetRiderApplication(context.Context, *GetRiderApplicationRequest) (*RiderApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiderApplication not implemented")
+}
+func (UnimplementedAuthServiceServer) ListRiderApplications(context.Context, *ListRiderApplicationsRequest) (*ListRiderApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiderApplications not implemented")
+}
+func (UnimplementedAuthServiceServer) ApproveRiderApplication(context.Context, *ApproveRiderApplicationRequest) (*RiderApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRiderApplication not implemented")
+}
+func (UnimplementedAuthServiceServer) RejectRiderApplication(context.Context, *RejectRiderApplicationRequest) (*RiderApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRiderApplication not implemented")
+}
+func (UnimplementedAuthServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedAuthServiceServer) ListAccessRoles(context.Context, *ListAccessRolesRequest) (*ListAccessRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRoles not implemented")
+}
+func (UnimplementedAuthServiceServer) PutAccessRole(context.Context, *PutAccessRoleRequest) (*AccessRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAccessRole not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAccessRole(context.Context, *DeleteAccessRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccessRole not implemented")
+}
+func (UnimplementedAuthServiceServer) GetAuthPermissions(context.Context, *GetAuthPermissionsRequest) (*AuthPermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthPermissions not implemented")
+}
+func (UnimplementedAuthServiceServer) AssignAccessRole(context.Context, *AssignAccessRoleRequest) (*AuthPermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignAccessRole not implemented")
+}
+func (UnimplementedAuthServiceServer) UnassignAccessRole(context.Context, *UnassignAccessRoleRequest) (*AuthPermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignAccessRole not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_StartPhoneLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPhoneLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartPhoneLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartPhoneLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartPhoneLogin(ctx, req.(*StartPhoneLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompletePhoneLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePhoneLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompletePhoneLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompletePhoneLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompletePhoneLogin(ctx, req.(*CompletePhoneLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_StartSocialLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSocialLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartSocialLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartSocialLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartSocialLogin(ctx, req.(*StartSocialLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompleteSocialLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSocialLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompleteSocialLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompleteSocialLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompleteSocialLogin(ctx, req.(*CompleteSocialLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifySecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifySecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifySecondFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifySecondFactor(ctx, req.(*VerifySecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTOTPEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BeginTOTPEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginTOTPEnrollment(ctx, req.(*BeginTOTPEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmTOTPEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmTOTPEnrollment(ctx, req.(*ConfirmTOTPEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPhoneNumberChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPhoneNumberChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPhoneNumberChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestPhoneNumberChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPhoneNumberChange(ctx, req.(*RequestPhoneNumberChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmPhoneNumberChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPhoneNumberChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmPhoneNumberChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmPhoneNumberChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmPhoneNumberChange(ctx, req.(*ConfirmPhoneNumberChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListAuths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListAuths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListAuths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListAuths(ctx, req.(*ListAuthsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableAuth(ctx, req.(*DisableAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EnableAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnableAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnableAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnableAuth(ctx, req.(*EnableAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAuth(ctx, req.(*DeleteAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpgradeGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpgradeGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpgradeGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpgradeGuest(ctx, req.(*UpgradeGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_MergeGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).MergeGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_MergeGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).MergeGuest(ctx, req.(*MergeGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CheckSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CheckSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CheckSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CheckSession(ctx, req.(*CheckSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyAPIKey(ctx, req.(*VerifyAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExportAuthData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuthDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExportAuthData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExportAuthData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExportAuthData(ctx, req.(*ExportAuthDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AuthServiceServer is the server API for AuthService service.
-// All implementations must embed UnimplementedAuthServiceServer
-// for forward compatibility.
-//
-// ---------------------AUTH SERVICE------------------------------
-// Manages auth credentials
-type AuthServiceServer interface {
-	// Create a new auth credential (customer, merchant, rider) using one endpoint.
-	Register(context.Context, *RegisterRequest) (*AuthCredentials, error)
-	// Login sign in as customer, rider
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	UpdatePhoneNumber(context.Context, *UpdatePhoneNumberRequest) (*UpdatePhoneNumberResponse, error)
-	CreateAdmin(context.Context, *CreateAdminRequest) (*AuthCredentials, error)
-	mustEmbedUnimplementedAuthServiceServer()
+func _AuthService_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSecurityEvents(ctx, req.(*ListSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAuthServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAuthServiceServer struct{}
+func _AuthService_SubmitRiderDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRiderDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SubmitRiderDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SubmitRiderDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SubmitRiderDocuments(ctx, req.(*SubmitRiderDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedAuthServiceServer) Register(context.Context, *RegisterRequest) (*AuthCredentials, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func _AuthService_GetRiderApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiderApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetRiderApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetRiderApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetRiderApplication(ctx, req.(*GetRiderApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _AuthService_ListRiderApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiderApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListRiderApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListRiderApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListRiderApplications(ctx, req.(*ListRiderApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthServiceServer) UpdatePhoneNumber(context.Context, *UpdatePhoneNumberRequest) (*UpdatePhoneNumberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePhoneNumber not implemented")
+
+func _AuthService_ApproveRiderApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRiderApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ApproveRiderApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ApproveRiderApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ApproveRiderApplication(ctx, req.(*ApproveRiderApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthServiceServer) CreateAdmin(context.Context, *CreateAdminRequest) (*AuthCredentials, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAdmin not implemented")
+
+func _AuthService_RejectRiderApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRiderApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RejectRiderApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RejectRiderApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RejectRiderApplication(ctx, req.(*RejectRiderApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
-func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AuthServiceServer will
-// result in compilation errors.
-type UnsafeAuthServiceServer interface {
-	mustEmbedUnimplementedAuthServiceServer()
+func _AuthService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
-	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AuthService_ListAccessRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AuthService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListAccessRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListAccessRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListAccessRoles(ctx, req.(*ListAccessRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _AuthService_PutAccessRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAccessRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).Register(ctx, in)
+		return srv.(AuthServiceServer).PutAccessRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_Register_FullMethodName,
+		FullMethod: AuthService_PutAccessRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(AuthServiceServer).PutAccessRole(ctx, req.(*PutAccessRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _AuthService_DeleteAccessRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).Login(ctx, in)
+		return srv.(AuthServiceServer).DeleteAccessRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_Login_FullMethodName,
+		FullMethod: AuthService_DeleteAccessRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(AuthServiceServer).DeleteAccessRole(ctx, req.(*DeleteAccessRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_UpdatePhoneNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePhoneNumberRequest)
+func _AuthService_GetAuthPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).UpdatePhoneNumber(ctx, in)
+		return srv.(AuthServiceServer).GetAuthPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_UpdatePhoneNumber_FullMethodName,
+		FullMethod: AuthService_GetAuthPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).UpdatePhoneNumber(ctx, req.(*UpdatePhoneNumberRequest))
+		return srv.(AuthServiceServer).GetAuthPermissions(ctx, req.(*GetAuthPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAdminRequest)
+func _AuthService_AssignAccessRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAccessRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).CreateAdmin(ctx, in)
+		return srv.(AuthServiceServer).AssignAccessRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_CreateAdmin_FullMethodName,
+		FullMethod: AuthService_AssignAccessRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+		return srv.(AuthServiceServer).AssignAccessRole(ctx, req.(*AssignAccessRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnassignAccessRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignAccessRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnassignAccessRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnassignAccessRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnassignAccessRole(ctx, req.(*UnassignAccessRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -232,13 +1723,169 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AuthService_Login_Handler,
 		},
 		{
-			MethodName: "UpdatePhoneNumber",
-			Handler:    _AuthService_UpdatePhoneNumber_Handler,
+			MethodName: "StartPhoneLogin",
+			Handler:    _AuthService_StartPhoneLogin_Handler,
+		},
+		{
+			MethodName: "CompletePhoneLogin",
+			Handler:    _AuthService_CompletePhoneLogin_Handler,
+		},
+		{
+			MethodName: "StartSocialLogin",
+			Handler:    _AuthService_StartSocialLogin_Handler,
+		},
+		{
+			MethodName: "CompleteSocialLogin",
+			Handler:    _AuthService_CompleteSocialLogin_Handler,
+		},
+		{
+			MethodName: "VerifySecondFactor",
+			Handler:    _AuthService_VerifySecondFactor_Handler,
+		},
+		{
+			MethodName: "BeginTOTPEnrollment",
+			Handler:    _AuthService_BeginTOTPEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTPEnrollment",
+			Handler:    _AuthService_ConfirmTOTPEnrollment_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _AuthService_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _AuthService_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "RequestPhoneNumberChange",
+			Handler:    _AuthService_RequestPhoneNumberChange_Handler,
+		},
+		{
+			MethodName: "ConfirmPhoneNumberChange",
+			Handler:    _AuthService_ConfirmPhoneNumberChange_Handler,
 		},
 		{
 			MethodName: "CreateAdmin",
 			Handler:    _AuthService_CreateAdmin_Handler,
 		},
+		{
+			MethodName: "ListAuths",
+			Handler:    _AuthService_ListAuths_Handler,
+		},
+		{
+			MethodName: "DisableAuth",
+			Handler:    _AuthService_DisableAuth_Handler,
+		},
+		{
+			MethodName: "EnableAuth",
+			Handler:    _AuthService_EnableAuth_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _AuthService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteAuth",
+			Handler:    _AuthService_DeleteAuth_Handler,
+		},
+		{
+			MethodName: "UpgradeGuest",
+			Handler:    _AuthService_UpgradeGuest_Handler,
+		},
+		{
+			MethodName: "MergeGuest",
+			Handler:    _AuthService_MergeGuest_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AuthService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CheckSession",
+			Handler:    _AuthService_CheckSession_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AuthService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AuthService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AuthService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "VerifyAPIKey",
+			Handler:    _AuthService_VerifyAPIKey_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _AuthService_ListContacts_Handler,
+		},
+		{
+			MethodName: "ExportAuthData",
+			Handler:    _AuthService_ExportAuthData_Handler,
+		},
+		{
+			MethodName: "ListSecurityEvents",
+			Handler:    _AuthService_ListSecurityEvents_Handler,
+		},
+		{
+			MethodName: "SubmitRiderDocuments",
+			Handler:    _AuthService_SubmitRiderDocuments_Handler,
+		},
+		{
+			MethodName: "GetRiderApplication",
+			Handler:    _AuthService_GetRiderApplication_Handler,
+		},
+		{
+			MethodName: "ListRiderApplications",
+			Handler:    _AuthService_ListRiderApplications_Handler,
+		},
+		{
+			MethodName: "ApproveRiderApplication",
+			Handler:    _AuthService_ApproveRiderApplication_Handler,
+		},
+		{
+			MethodName: "RejectRiderApplication",
+			Handler:    _AuthService_RejectRiderApplication_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _AuthService_ListPermissions_Handler,
+		},
+		{
+			MethodName: "ListAccessRoles",
+			Handler:    _AuthService_ListAccessRoles_Handler,
+		},
+		{
+			MethodName: "PutAccessRole",
+			Handler:    _AuthService_PutAccessRole_Handler,
+		},
+		{
+			MethodName: "DeleteAccessRole",
+			Handler:    _AuthService_DeleteAccessRole_Handler,
+		},
+		{
+			MethodName: "GetAuthPermissions",
+			Handler:    _AuthService_GetAuthPermissions_Handler,
+		},
+		{
+			MethodName: "AssignAccessRole",
+			Handler:    _AuthService_AssignAccessRole_Handler,
+		},
+		{
+			MethodName: "UnassignAccessRole",
+			Handler:    _AuthService_UnassignAccessRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authservice.proto",
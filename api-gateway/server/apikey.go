@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/api-gateway/genproto"
+)
+
+// headerAPIKey carries the API key of a merchant point of sale. Requests with
+// a key are mapped to the merchant like a token, and can only reach the
+// routes of the key's scopes.
+const headerAPIKey = "X-API-Key"
+
+// apiKeyCacheTTL bounds how long a revoked key can still be used.
+const (
+	apiKeyCacheTTL     = 30 * time.Second
+	apiKeyCachePruneAt = 10000
+)
+
+var (
+	apiKeys *apiKeyChecker
+
+	ErrAPIKeyInvalid = errors.New("api key is invalid")
+)
+
+// apiKeyScopeRoutes are the routes an API key can call, by the scope they
+// need. authservice only grants the scopes listed here.
+var apiKeyScopeRoutes = map[string][]string{
+	"menu:read": {
+		"GET /api/merchants/{merchant_id}",
+	},
+	"menu:write": {
+		"POST /api/merchants/{merchant_id}/menu",
+		"PATCH /api/merchants/{merchant_id}/menu/items/{item_id}",
+	},
+}
+
+type apiKeyPrincipal struct {
+	AuthID string
+	Role   pb.Roles
+	Scopes []string
+}
+
+type apiKeyContextKey struct{}
+
+// apiKeyChecker asks the auth service for the merchant and scopes of an API
+// key. Results are cached by the hash of the key.
+type apiKeyChecker struct {
+	client pb.AuthServiceClient
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey
+}
+
+type cachedAPIKey struct {
+	principal *apiKeyPrincipal
+	expires   time.Time
+}
+
+func newAPIKeyChecker(client pb.AuthServiceClient) *apiKeyChecker {
+	return &apiKeyChecker{
+		client: client,
+		cache:  make(map[string]cachedAPIKey),
+	}
+}
+
+// verify returns ErrAPIKeyInvalid for unknown, expired or revoked keys.
+func (c *apiKeyChecker) verify(ctx context.Context, key string) (*apiKeyPrincipal, error) {
+
+	sum := sha256.Sum256([]byte(key))
+	cacheKey := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.cache[cacheKey]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		if cached.principal == nil {
+			return nil, ErrAPIKeyInvalid
+		}
+		return cached.principal, nil
+	}
+
+	var principal *apiKeyPrincipal
+	resp, err := c.client.VerifyAPIKey(ctx, &pb.VerifyAPIKeyRequest{Key: key})
+	if err != nil {
+		if status.Code(err) != codes.Unauthenticated {
+			return nil, err
+		}
+	} else {
+		principal = &apiKeyPrincipal{
+			AuthID: resp.AuthId,
+			Role:   resp.Role,
+			Scopes: resp.Scopes,
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.cache) >= apiKeyCachePruneAt {
+		for k, v := range c.cache {
+			if now.After(v.expires) {
+				delete(c.cache, k)
+			}
+		}
+	}
+	c.cache[cacheKey] = cachedAPIKey{principal: principal, expires: now.Add(apiKeyCacheTTL)}
+
+	if principal == nil {
+		return nil, ErrAPIKeyInvalid
+	}
+	return principal, nil
+}
+
+// newAPIKeyRouter only lets requests through to next when the API key has
+// the scope of the route and the route is of the key's merchant.
+func newAPIKeyRouter(next http.Handler) http.Handler {
+
+	mux := http.NewServeMux()
+	for scope, routes := range apiKeyScopeRoutes {
+		for _, route := range routes {
+			mux.Handle(route, requireScope(scope, next))
+		}
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Access denied: route is not available to api keys", http.StatusForbidden)
+	})
+
+	return mux
+}
+
+func requireScope(scope string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		principal, ok := r.Context().Value(apiKeyContextKey{}).(*apiKeyPrincipal)
+		if !ok || !slices.Contains(principal.Scopes, scope) {
+			http.Error(w, "Access denied: api key requires scope "+scope, http.StatusForbidden)
+			return
+		}
+
+		if id := r.PathValue("merchant_id"); id != "" && id != principal.AuthID {
+			http.Error(w, "Access denied: api key belongs to another merchant", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
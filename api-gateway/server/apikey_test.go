@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/api-gateway/genproto"
+)
+
+// fakeAuthClient knows the API keys in keys. The methods it does not
+// implement panic through the nil AuthServiceClient.
+type fakeAuthClient struct {
+	pb.AuthServiceClient
+
+	keys  map[string]*pb.VerifyAPIKeyResponse
+	calls int
+}
+
+func (c *fakeAuthClient) VerifyAPIKey(ctx context.Context, in *pb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*pb.VerifyAPIKeyResponse, error) {
+	c.calls++
+	resp, ok := c.keys[in.Key]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return resp, nil
+}
+
+// fakeMerchantClient records the methods the gateway routed to.
+type fakeMerchantClient struct {
+	pb.MerchantServiceClient
+
+	called []string
+}
+
+func (c *fakeMerchantClient) GetMerchant(ctx context.Context, in *pb.GetMerchantRequest, opts ...grpc.CallOption) (*pb.Merchant, error) {
+	c.called = append(c.called, "GetMerchant")
+	return &pb.Merchant{}, nil
+}
+
+func (c *fakeMerchantClient) CreateMenu(ctx context.Context, in *pb.CreateMenuRequest, opts ...grpc.CallOption) (*pb.CreateMenuResponse, error) {
+	c.called = append(c.called, "CreateMenu")
+	return &pb.CreateMenuResponse{}, nil
+}
+
+func (c *fakeMerchantClient) UpdateMenuItem(ctx context.Context, in *pb.UpdateMenuItemRequest, opts ...grpc.CallOption) (*pb.MenuItem, error) {
+	c.called = append(c.called, "UpdateMenuItem")
+	return &pb.MenuItem{}, nil
+}
+
+// withAPIKeys points the API key checker at client for the test.
+func withAPIKeys(t *testing.T, client pb.AuthServiceClient) {
+	t.Helper()
+
+	prev := apiKeys
+	apiKeys = newAPIKeyChecker(client)
+	t.Cleanup(func() { apiKeys = prev })
+}
+
+// routePath fills the wildcards of a route pattern.
+func routePath(route, merchantID string) (method, path string) {
+	method, path, _ = strings.Cut(route, " ")
+	path = strings.ReplaceAll(path, "{merchant_id}", merchantID)
+	path = strings.ReplaceAll(path, "{item_id}", "item-1")
+	return method, path
+}
+
+func TestAPIKeyRoutes(t *testing.T) {
+
+	const merchantID = "merchant-1"
+	client := &fakeAuthClient{keys: map[string]*pb.VerifyAPIKeyResponse{
+		"ihf_read": {AuthId: merchantID, Role: pb.Roles_ROLES_MERCHANT, Scopes: []string{"menu:read"}},
+	}}
+	withAPIKeys(t, client)
+
+	var reached *http.Request
+	handler := auth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = r
+	}))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		key    string
+		want   int
+	}{
+		{"scope of the route", "GET", "/api/merchants/" + merchantID, "ihf_read", http.StatusOK},
+		{"scope not granted", "POST", "/api/merchants/" + merchantID + "/menu", "ihf_read", http.StatusForbidden},
+		{"another merchant", "GET", "/api/merchants/merchant-2", "ihf_read", http.StatusForbidden},
+		{"route without scope", "GET", "/api/customers/" + merchantID, "ihf_read", http.StatusForbidden},
+		{"admin route", "GET", "/api/admin/auths", "ihf_read", http.StatusForbidden},
+		{"unknown key", "GET", "/api/merchants/" + merchantID, "ihf_unknown", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reached = nil
+
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			r.Header.Set(headerAPIKey, tt.key)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Fatalf("%s %s: got %d, want %d", tt.method, tt.path, w.Code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				if reached != nil {
+					t.Error("refused request reached the services")
+				}
+				return
+			}
+			if reached == nil {
+				t.Fatal("allowed request did not reach the services")
+			}
+			if got := reached.Header.Get(headerAuthID); got != merchantID {
+				t.Errorf("auth id header %q, want %q", got, merchantID)
+			}
+			if got := reached.Header.Get(headerAuthScopes); got != "menu:read" {
+				t.Errorf("auth scopes header %q, want %q", got, "menu:read")
+			}
+		})
+	}
+
+	// The results of the auth service are cached, unknown keys too.
+	if client.calls != 2 {
+		t.Errorf("verified keys %d times, want 2", client.calls)
+	}
+}
+
+// Every route of a scope is served by the gateway, so no scope grants access
+// to a route that does not exist.
+func TestAPIKeyScopeRoutesExist(t *testing.T) {
+
+	merchants := &fakeMerchantClient{}
+	gwmux := runtime.NewServeMux()
+	if err := pb.RegisterMerchantServiceHandlerClient(context.Background(), gwmux, merchants); err != nil {
+		t.Fatal(err)
+	}
+
+	for scope, routes := range apiKeyScopeRoutes {
+		if len(routes) == 0 {
+			t.Errorf("scope %s has no routes", scope)
+		}
+		for _, route := range routes {
+			method, path := routePath(route, "merchant-1")
+			r := httptest.NewRequest(method, path, strings.NewReader("{}"))
+			w := httptest.NewRecorder()
+
+			before := len(merchants.called)
+			gwmux.ServeHTTP(w, r)
+			if w.Code != http.StatusOK || len(merchants.called) == before {
+				t.Errorf("route %q of scope %s is not served: got %d", route, scope, w.Code)
+			}
+		}
+	}
+}
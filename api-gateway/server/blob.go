@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"google.golang.org/api/idtoken"
+)
+
+// blobHeaders are the request headers forwarded for a blob. Cookies and
+// keys of the client stay at the gateway.
+var blobHeaders = []string{
+	"Range",
+	"If-Range",
+	"If-None-Match",
+	"If-Modified-Since",
+}
+
+// blobProxy serves the blobs of customerservice at uri, which only accepts
+// requests with an ID token.
+func blobProxy(ctx context.Context, uri string) (http.Handler, error) {
+
+	target, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	client, err := idtoken.NewClient(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlobProxy(target, client.Transport), nil
+}
+
+// newBlobProxy forwards blob requests to target with transport.
+func newBlobProxy(target *url.URL, transport http.RoundTripper) *httputil.ReverseProxy {
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.Out.Header = make(http.Header)
+			for _, name := range blobHeaders {
+				if v := r.In.Header.Values(name); len(v) > 0 {
+					r.Out.Header[name] = v
+				}
+			}
+		},
+		Transport: transport,
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestBlobProxy(t *testing.T) {
+
+	var got *http.Request
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		io.WriteString(w, "picture")
+	}))
+	t.Cleanup(service.Close)
+
+	target, err := url.Parse(service.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := newBlobProxy(target, http.DefaultTransport)
+
+	r := httptest.NewRequest("GET", "/blobs/customers/1/small.jpg", nil)
+	r.AddCookie(&http.Cookie{Name: "access-token", Value: "token"})
+	r.Header.Set(headerAPIKey, "ihf_key")
+	r.Header.Set("If-None-Match", `"etag"`)
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK || w.Body.String() != "picture" {
+		t.Fatalf("got %d %q, want 200 %q", w.Code, w.Body.String(), "picture")
+	}
+	if got.URL.Path != "/blobs/customers/1/small.jpg" {
+		t.Errorf("forwarded path %q", got.URL.Path)
+	}
+	if got.Header.Get("Cookie") != "" || got.Header.Get(headerAPIKey) != "" {
+		t.Error("credentials of the client were forwarded")
+	}
+	if got.Header.Get("If-None-Match") != `"etag"` {
+		t.Errorf("If-None-Match %q, want %q", got.Header.Get("If-None-Match"), `"etag"`)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"net/http"
+)
+
+// guestRoutes are the routes a guest token can call: browsing, addresses and
+// placing and tracking orders, and turning the guest into a full account or
+// deleting it.
+var guestRoutes = []string{
+	"GET /api/merchants",
+	"GET /api/merchants/{merchant_id}",
+	"GET /api/coupons",
+	"GET /api/coupons/{code}",
+	"GET /api/deliveries/fee",
+	"GET /api/deliveries/delivery-estimate",
+	"GET /api/customers/{customer_id}",
+	"POST /api/customers/{customer_id}/address",
+	"PATCH /api/customers/{customer_id}/addresses/{address_id}",
+	"DELETE /api/customers/{customer_id}/addresses/{address_id}",
+	"GET /api/addresses/suggestions",
+	"GET /api/orders/{customer_id}",
+	"POST /api/orders/place_order",
+	"POST /api/auth/{auth_id}/upgrade",
+	"POST /api/auth/{auth_id}/merge",
+	"POST /api/auth/{auth_id}/delete",
+}
+
+// newGuestRouter only lets guest requests through to next on guestRoutes,
+// and only for the guest's own account.
+func newGuestRouter(next http.Handler) http.Handler {
+
+	mux := http.NewServeMux()
+	for _, route := range guestRoutes {
+		mux.Handle(route, requireOwnAccount(next))
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Access denied: register or log in to use this route", http.StatusForbidden)
+	})
+
+	return mux
+}
+
+func requireOwnAccount(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		subject := r.Header.Get(headerAuthID)
+		for _, name := range []string{"customer_id", "auth_id"} {
+			if id := r.PathValue(name); id != "" && id != subject {
+				http.Error(w, "Access denied: route belongs to another account", http.StatusForbidden)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
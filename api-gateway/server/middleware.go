@@ -1,13 +1,14 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
-
+	"strconv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -20,8 +21,33 @@ var (
 	ErrTokenInvalid = errors.New("token is invalid")
 )
 
+// The caller identity is forwarded to backend services as gRPC metadata.
+// grpc-gateway maps "Grpc-Metadata-<Key>" headers to "<key>" metadata,
+// so services read it back from "auth-id" and "auth-role". Token callers
+// also get "auth-time", the Unix time their token was issued at, callers
+// using an API key get "auth-scopes", a space separated list of scopes, and
+// admins get "auth-permissions", the permissions of their token.
+const (
+	headerAuthID          = "Grpc-Metadata-Auth-Id"
+	headerAuthRole        = "Grpc-Metadata-Auth-Role"
+	headerAuthTime        = "Grpc-Metadata-Auth-Time"
+	headerAuthScopes      = "Grpc-Metadata-Auth-Scopes"
+	headerAuthPermissions = "Grpc-Metadata-Auth-Permissions"
+)
+
+// The state of a social login is kept in an HttpOnly cookie of the browser
+// that started it and forwarded as "social-login-state" metadata, so
+// authservice only completes the login in that browser.
+const (
+	cookieSocialLoginState = "social-login-state"
+	headerSocialLoginState = "Grpc-Metadata-Social-Login-State"
+)
+
 type GatewayClaims struct {
-	Role pb.Roles `json:"role"`
+	Role        pb.Roles `json:"role"`
+	Permissions []string `json:"perms,omitempty"`
+	// Guest tokens can only reach guestRoutes.
+	Guest bool `json:"guest,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -34,8 +60,18 @@ func LoadSigningkey() {
 }
 
 func auth(next http.Handler) http.Handler {
+
+	keyRouter := newAPIKeyRouter(next)
+	adminRouter := newAdminRouter(next)
+	guestRouter := newGuestRouter(next)
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
+		if key := r.Header.Get(headerAPIKey); key != "" {
+			authAPIKey(w, r, key, keyRouter)
+			return
+		}
+
 		cookie, err := r.Cookie("access-token")
 		if err != nil {
 			slog.Error("unable to read cookie", "err", err)
@@ -55,15 +91,76 @@ func auth(next http.Handler) http.Handler {
 			return
 		}
 
+		claims, ok := token.Claims.(*GatewayClaims)
+		if !ok {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		active, err := sessions.active(r.Context(), claims)
+		if err != nil {
+			slog.Error("check session", "err", err)
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		if !active {
+			http.Error(w, "session revoked", http.StatusUnauthorized)
+			return
+		}
+
+		r.Header.Set(headerAuthID, claims.Subject)
+		r.Header.Set(headerAuthRole, claims.Role.String())
+		r.Header.Set(headerAuthTime, strconv.FormatInt(claims.IssuedAt.Unix(), 10))
+		if len(claims.Permissions) > 0 {
+			r.Header.Set(headerAuthPermissions, strings.Join(claims.Permissions, " "))
+		}
+
+		if claims.Guest {
+			guestRouter.ServeHTTP(w, r)
+			return
+		}
+
 		// check permission for resource under /admin
-		if strings.HasPrefix(r.URL.Path, "/api/admin/") {
-			claims, ok := token.Claims.(*GatewayClaims)
-			if !ok || claims.Role != pb.Roles_ROLES_ADMIN {
-				http.Error(w, "Access denied: You do not have the required permissions", http.StatusForbidden)
-				return
-			}
+		adminRouter.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), claimsContextKey{}, claims)))
+	})
+}
+
+// authAPIKey maps the API key to its merchant, the same as a token of the
+// merchant, and passes the request to the scoped routes.
+func authAPIKey(w http.ResponseWriter, r *http.Request, key string, next http.Handler) {
+
+	principal, err := apiKeys.verify(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, ErrAPIKeyInvalid) {
+			http.Error(w, "invalid api key", http.StatusUnauthorized)
+			return
 		}
+		slog.Error("verify api key", "err", err)
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	r.Header.Set(headerAuthID, principal.AuthID)
+	r.Header.Set(headerAuthRole, principal.Role.String())
+	r.Header.Set(headerAuthScopes, strings.Join(principal.Scopes, " "))
 
+	next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, principal)))
+}
+
+// stripIdentity removes caller identity headers sent by the client, so only
+// the identity set by auth after verifying the token reaches the services.
+// The social login state is only taken from its cookie.
+func stripIdentity(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Del(headerAuthID)
+		r.Header.Del(headerAuthRole)
+		r.Header.Del(headerAuthTime)
+		r.Header.Del(headerAuthScopes)
+		r.Header.Del(headerAuthPermissions)
+		r.Header.Del(headerSocialLoginState)
+		if cookie, err := r.Cookie(cookieSocialLoginState); err == nil {
+			r.Header.Set(headerSocialLoginState, cookie.Value)
+		}
 		next.ServeHTTP(w, r)
 	})
 }
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+)
+
+// adminRoutePermissions are the permissions needed for admin routes of
+// services that do not check permissions themselves. Every other admin route
+// needs a token with at least one permission, and the service behind it
+// checks the specific one.
+var adminRoutePermissions = map[string]string{
+	"GET /api/admin/customers": "customers:read",
+	"POST /api/admin/coupons":  "coupons:write",
+}
+
+type claimsContextKey struct{}
+
+// newAdminRouter only lets requests under /api/admin/ through to next when
+// the token carries the permissions of the route. Other requests go through.
+func newAdminRouter(next http.Handler) http.Handler {
+
+	mux := http.NewServeMux()
+	for route, permission := range adminRoutePermissions {
+		mux.Handle(route, requirePermission(permission, next))
+	}
+	mux.Handle("/api/admin/", requirePermission("", next))
+	mux.Handle("/", next)
+
+	return mux
+}
+
+// requirePermission checks the permissions of the token. An empty permission
+// accepts any.
+func requirePermission(permission string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		claims, ok := r.Context().Value(claimsContextKey{}).(*GatewayClaims)
+		if !ok || len(claims.Permissions) == 0 ||
+			(permission != "" && !slices.Contains(claims.Permissions, permission)) {
+			http.Error(w, "Access denied: You do not have the required permissions", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+
+	pb "github.com/pongsathonn/ihavefood/api-gateway/genproto"
+)
+
+// CheckSession treats every token as active.
+func (c *fakeAuthClient) CheckSession(ctx context.Context, in *pb.CheckSessionRequest, opts ...grpc.CallOption) (*pb.CheckSessionResponse, error) {
+	return &pb.CheckSessionResponse{Active: true}, nil
+}
+
+// withSigningKey sets the key tokens are signed with for the test.
+func withSigningKey(t *testing.T) {
+	t.Helper()
+
+	prevKey, prevSessions := signingKey, sessions
+	signingKey = []byte("test-signing-key")
+	sessions = newSessionChecker(&fakeAuthClient{})
+	t.Cleanup(func() { signingKey, sessions = prevKey, prevSessions })
+}
+
+// tokenCookie returns the access token cookie of an account with the claims.
+func tokenCookie(t *testing.T, subject string, role pb.Roles, permissions ...string) *http.Cookie {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &GatewayClaims{
+		Role:        role,
+		Permissions: permissions,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(signingKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Cookie{Name: "access-token", Value: token}
+}
+
+func TestAdminRoutePermissions(t *testing.T) {
+
+	withSigningKey(t)
+
+	var reached *http.Request
+	handler := auth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = r
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		role        pb.Roles
+		permissions []string
+		want        int
+	}{
+		{"mapped route", "GET", "/api/admin/customers", pb.Roles_ROLES_ADMIN, []string{"customers:read"}, http.StatusOK},
+		{"mapped route without its permission", "GET", "/api/admin/customers", pb.Roles_ROLES_ADMIN, []string{"accounts:read"}, http.StatusForbidden},
+		{"coupon route", "POST", "/api/admin/coupons", pb.Roles_ROLES_ADMIN, []string{"coupons:write"}, http.StatusOK},
+		{"coupon route with read permission", "POST", "/api/admin/coupons", pb.Roles_ROLES_ADMIN, []string{"customers:read"}, http.StatusForbidden},
+		{"service checked route", "GET", "/api/admin/auths", pb.Roles_ROLES_ADMIN, []string{"accounts:read"}, http.StatusOK},
+		{"admin without permissions", "GET", "/api/admin/auths", pb.Roles_ROLES_ADMIN, nil, http.StatusForbidden},
+		{"super admin role without permissions", "GET", "/api/admin/customers", pb.Roles_ROLES_SUPER_ADMIN, nil, http.StatusForbidden},
+		{"customer", "GET", "/api/admin/customers", pb.Roles_ROLES_CUSTOMER, nil, http.StatusForbidden},
+		{"route outside admin", "GET", "/api/merchants", pb.Roles_ROLES_CUSTOMER, nil, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reached = nil
+
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			r.AddCookie(tokenCookie(t, "account-1", tt.role, tt.permissions...))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Fatalf("%s %s: got %d, want %d", tt.method, tt.path, w.Code, tt.want)
+			}
+			if (reached != nil) != (tt.want == http.StatusOK) {
+				t.Fatalf("request reached the services: %v", reached != nil)
+			}
+		})
+	}
+}
+
+// The services get the permissions of the token, for the routes they check
+// themselves.
+func TestAdminPermissionsForwarded(t *testing.T) {
+
+	withSigningKey(t)
+
+	var got string
+	handler := auth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(headerAuthPermissions)
+	}))
+
+	r := httptest.NewRequest("GET", "/api/admin/auths", nil)
+	r.AddCookie(tokenCookie(t, "account-1", pb.Roles_ROLES_ADMIN, "accounts:read", "roles:read"))
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if got != "accounts:read roles:read" {
+		t.Errorf("auth permissions header %q, want %q", got, "accounts:read roles:read")
+	}
+}
@@ -85,26 +85,7 @@ func newGateway() http.Handler {
 			log.Fatalf("%s is not set", env)
 		}
 
-		// NOTE: audience must include scheme
-		tokenSource, err := idtoken.NewTokenSource(ctx, uri)
-		if err != nil {
-			log.Fatalf("idtoken.NewTokenSource failed: %v", err)
-		}
-
-		parsedURL, err := url.Parse(uri)
-		if err != nil {
-			log.Fatalf("Failed to parse uri: %v", err)
-		}
-
-		opts := []grpc.DialOption{
-			grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")),
-			grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokenSource}),
-		}
-
-		host := parsedURL.Host
-		if parsedURL.Port() == "" {
-			host = host + ":443"
-		}
+		host, opts := dialOptions(ctx, uri)
 
 		conn, err := grpc.NewClient(host, opts...)
 		if err != nil {
@@ -125,12 +106,59 @@ func newGateway() http.Handler {
 	return mux
 }
 
+// maxCallSendMsgSize is the largest request sent to a service, the
+// MaxRecvMsgSize of customerservice for picture uploads. The base64 content
+// of the JSON body is decoded before, so it is the size of the picture.
+const maxCallSendMsgSize = 6 << 20
+
+// dialOptions returns the host and the options to call a Cloud Run service
+// at uri with an ID token.
+func dialOptions(ctx context.Context, uri string) (string, []grpc.DialOption) {
+
+	// NOTE: audience must include scheme
+	tokenSource, err := idtoken.NewTokenSource(ctx, uri)
+	if err != nil {
+		log.Fatalf("idtoken.NewTokenSource failed: %v", err)
+	}
+
+	parsedURL, err := url.Parse(uri)
+	if err != nil {
+		log.Fatalf("Failed to parse uri: %v", err)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")),
+		grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokenSource}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxCallSendMsgSize)),
+	}
+
+	host := parsedURL.Host
+	if parsedURL.Port() == "" {
+		host = host + ":443"
+	}
+
+	return host, opts
+}
+
 func setCookie(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
 	md, ok := runtime.ServerMetadataFromContext(ctx)
 	if !ok {
 		return nil
 	}
 
+	if states := md.HeaderMD.Get(cookieSocialLoginState); len(states) > 0 {
+		// Lives as long as the state in authservice.
+		http.SetCookie(w, &http.Cookie{
+			Name:     cookieSocialLoginState,
+			Value:    states[0],
+			MaxAge:   int((10 * time.Minute).Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteNoneMode,
+			Path:     "/auth/social/",
+		})
+	}
+
 	tokens := md.HeaderMD.Get("access-token")
 	exps := md.HeaderMD.Get("exp")
 
@@ -166,7 +194,22 @@ func Run() error {
 
 	gwmux := newGateway()
 
+	host, opts := dialOptions(context.Background(), os.Getenv("AUTH_URI"))
+	conn, err := grpc.NewClient(host, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	sessions = newSessionChecker(pb.NewAuthServiceClient(conn))
+	apiKeys = newAPIKeyChecker(pb.NewAuthServiceClient(conn))
+
+	blobs, err := blobProxy(context.Background(), os.Getenv("CUSTOMER_URI"))
+	if err != nil {
+		return err
+	}
+
 	router := http.NewServeMux()
+	router.Handle("GET /blobs/", blobs)
 	router.Handle("/api/admin/", auth(gwmux))
 	router.Handle("/api/", auth(gwmux))
 	router.Handle("/", gwmux)
@@ -178,7 +221,7 @@ func Run() error {
 
 	s := &http.Server{
 		Addr:    ":" + port,
-		Handler: prettierJSON(cors(router)),
+		Handler: prettierJSON(cors(stripIdentity(router))),
 	}
 
 	if err := s.ListenAndServe(); err != nil {
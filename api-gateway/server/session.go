@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/api-gateway/genproto"
+)
+
+// sessionCacheTTL bounds how long a revoked token can still be used. Checking
+// every request against the auth service would double the latency.
+const (
+	sessionCacheTTL     = 30 * time.Second
+	sessionCachePruneAt = 10000
+)
+
+var sessions *sessionChecker
+
+// sessionChecker asks the auth service whether a token has been revoked,
+// which happens when the account is disabled, deleted or changes role.
+type sessionChecker struct {
+	client pb.AuthServiceClient
+
+	mu    sync.Mutex
+	cache map[string]cachedSession
+}
+
+type cachedSession struct {
+	active  bool
+	expires time.Time
+}
+
+func newSessionChecker(client pb.AuthServiceClient) *sessionChecker {
+	return &sessionChecker{
+		client: client,
+		cache:  make(map[string]cachedSession),
+	}
+}
+
+func (c *sessionChecker) active(ctx context.Context, claims *GatewayClaims) (bool, error) {
+
+	if claims.IssuedAt == nil {
+		return false, nil
+	}
+
+	key := claims.Subject + ":" + claims.IssuedAt.String()
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.active, nil
+	}
+
+	resp, err := c.client.CheckSession(ctx, &pb.CheckSessionRequest{
+		AuthId:    claims.Subject,
+		IssueTime: timestamppb.New(claims.IssuedAt.Time),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.cache) >= sessionCachePruneAt {
+		for k, v := range c.cache {
+			if now.After(v.expires) {
+				delete(c.cache, k)
+			}
+		}
+	}
+	c.cache[key] = cachedSession{active: resp.Active, expires: now.Add(sessionCacheTTL)}
+
+	return resp.Active, nil
+}
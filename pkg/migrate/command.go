@@ -0,0 +1,82 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// OnStart applies the pending migrations before the service serves, unless
+// MIGRATE_ON_START is "false" and migrations run as a separate step.
+func OnStart(m *Migrator) error {
+
+	if os.Getenv("MIGRATE_ON_START") == "false" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	_, err := m.Up(ctx)
+	return err
+}
+
+// Run runs the migrate command of the program with its arguments, "up" when
+// there are none.
+func Run(m *Migrator, program string, args []string) error {
+
+	ctx := context.Background()
+
+	if len(args) == 0 {
+		args = []string{"up"}
+	}
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migrations\n", n)
+
+	case args[0] == "status" && len(args) == 1:
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, s := range statuses {
+			applied := "pending"
+			switch {
+			case s.Baseline:
+				applied = "baseline " + s.ApplyTime.Format(time.DateTime)
+			case s.Applied:
+				applied = s.ApplyTime.Format(time.DateTime)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+
+	case args[0] == "baseline" && len(args) == 2:
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		n, err := m.Baseline(ctx, version)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("recorded %d migrations as applied\n", n)
+
+	default:
+		return errors.New("usage: " + program + " migrate [up | status | baseline VERSION]")
+	}
+
+	return nil
+}
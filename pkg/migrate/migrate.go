@@ -0,0 +1,373 @@
+// Package migrate applies the schema migrations of a service.
+//
+// The schema is changed only by migrations, the SQL files of
+// supabase/migrations embedded in the binary of the service. A file named
+// "<version>_<name>.sql" is applied once, in version order, in a transaction
+// that also records it in schema_versions with the SHA-256 of the file. An
+// applied file must not change afterwards, a different checksum stops the
+// migration. A session advisory lock keeps replicas starting together from
+// applying the same migration twice.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type migration struct {
+	Version  int64
+	Name     string
+	SQL      string
+	Checksum string
+}
+
+// Status is a migration of the binary and whether it is applied.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	ApplyTime *time.Time
+	// Baseline migrations were recorded without being run.
+	Baseline bool
+}
+
+type appliedMigration struct {
+	Version   int64
+	Checksum  string
+	ApplyTime time.Time
+	Baseline  bool
+}
+
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []*migration
+	// lockName is hashed into the advisory lock key, e.g.
+	// "authservice.schema_versions".
+	lockName string
+}
+
+// New reads the migrations at the root of fsys. It fails on files not named
+// "<version>_<name>.sql" and on duplicate versions. Services sharing a
+// database must use different lock names.
+func New(pool *pgxpool.Pool, fsys fs.FS, lockName string) (*Migrator, error) {
+
+	migrations, err := loadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		pool:       pool,
+		migrations: migrations,
+		lockName:   lockName,
+	}, nil
+}
+
+// Up applies the pending migrations and returns how many were applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+
+	applied := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+
+		done, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+
+			start := time.Now()
+			if err := applyMigration(ctx, conn, mig); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			applied++
+
+			slog.Info("applied migration", "version", mig.Version, "name", mig.Name,
+				"took", time.Since(start).Round(time.Millisecond))
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Baseline records the migrations up to version as applied without running
+// them, for databases created before migrations were tracked. Their tables
+// must be owned by the service user for later migrations to alter them.
+func (m *Migrator) Baseline(ctx context.Context, version int64) (int, error) {
+
+	if !slices.ContainsFunc(m.migrations, func(mig *migration) bool { return mig.Version == version }) {
+		return 0, fmt.Errorf("unknown migration version %d", version)
+	}
+
+	recorded := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+
+		done, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			if _, err := conn.Exec(ctx, `
+				INSERT INTO schema_versions(
+					version,
+					name,
+					checksum,
+					baseline
+				)VALUES(
+					$1,$2,$3,TRUE
+				)
+			`,
+				mig.Version,
+				mig.Name,
+				mig.Checksum,
+			); err != nil {
+				return err
+			}
+			recorded++
+		}
+
+		return nil
+	})
+
+	return recorded, err
+}
+
+// Status lists the migrations of the binary, oldest first.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+
+	var statuses []*Status
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+
+		done, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			status := &Status{
+				Version: mig.Version,
+				Name:    mig.Name,
+			}
+			if applied, ok := done[mig.Version]; ok {
+				status.Applied = true
+				status.ApplyTime = &applied.ApplyTime
+				status.Baseline = applied.Baseline
+			}
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// withLock runs fn on one connection holding the migration advisory lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext($1))`, m.lockName); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// The lock is released with the session anyway, so a failure here
+		// only delays other replicas.
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, m.lockName); err != nil {
+			slog.Error("release migration lock", "err", err)
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_versions (
+			version BIGINT,
+			name VARCHAR(255) NOT NULL,
+			checksum VARCHAR(64) NOT NULL,
+			baseline BOOLEAN NOT NULL DEFAULT FALSE,
+			apply_time TIMESTAMP NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (version)
+		)
+	`); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+// verify returns the applied migrations by version. It fails when an applied
+// file has changed since. Versions unknown to the binary are only logged, an
+// older replica may still run while a newer one has migrated.
+func (m *Migrator) verify(ctx context.Context, conn *pgxpool.Conn) (map[int64]*appliedMigration, error) {
+
+	rows, err := conn.Query(ctx, `
+		SELECT
+			version,
+			checksum,
+			apply_time,
+			baseline
+		FROM
+			schema_versions
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*appliedMigration, error) {
+		var a appliedMigration
+		err := row.Scan(&a.Version, &a.Checksum, &a.ApplyTime, &a.Baseline)
+		return &a, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(map[int64]*appliedMigration, len(applied))
+	for _, a := range applied {
+		done[a.Version] = a
+	}
+
+	for _, mig := range m.migrations {
+		a, ok := done[mig.Version]
+		if ok && a.Checksum != mig.Checksum {
+			return nil, fmt.Errorf("migration %d_%s was changed after it was applied", mig.Version, mig.Name)
+		}
+	}
+
+	for version := range done {
+		if !slices.ContainsFunc(m.migrations, func(mig *migration) bool { return mig.Version == version }) {
+			slog.Warn("database has a migration unknown to this binary", "version", version)
+		}
+	}
+
+	return done, nil
+}
+
+func applyMigration(ctx context.Context, conn *pgxpool.Conn, mig *migration) error {
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Without arguments the file is sent as is, so it can hold several
+	// statements.
+	if _, err := tx.Exec(ctx, mig.SQL); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO schema_versions(
+			version,
+			name,
+			checksum
+		)VALUES(
+			$1,$2,$3
+		)
+	`,
+		mig.Version,
+		mig.Name,
+		mig.Checksum,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func loadMigrations(fsys fs.FS) ([]*migration, error) {
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*migration
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		version, name, err := parseMigrationName(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(b)
+
+		migrations = append(migrations, &migration{
+			Version:  version,
+			Name:     name,
+			SQL:      string(b),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	slices.SortFunc(migrations, func(a, b *migration) int {
+		switch {
+		case a.Version < b.Version:
+			return -1
+		case a.Version > b.Version:
+			return 1
+		}
+		return 0
+	})
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migrations[i].Version)
+		}
+	}
+
+	return migrations, nil
+}
+
+// parseMigrationName splits "20261018120000_add_column.sql" into its version
+// and name.
+func parseMigrationName(filename string) (int64, string, error) {
+
+	base := strings.TrimSuffix(filename, ".sql")
+	rawVersion, name, ok := strings.Cut(base, "_")
+	if !ok || name == "" {
+		return 0, "", fmt.Errorf("migration %q must be named <version>_<name>.sql", filename)
+	}
+
+	version, err := strconv.ParseInt(rawVersion, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, "", fmt.Errorf("migration %q must start with a numeric version", filename)
+	}
+
+	return version, name, nil
+}
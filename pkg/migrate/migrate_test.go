@@ -0,0 +1,50 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"20261018120000_add_column.sql": {Data: []byte("ALTER TABLE t ADD COLUMN c INT;")},
+		"20260101000000_create_t.sql":   {Data: []byte("CREATE TABLE t (id INT);")},
+		"migrations.go":                 {Data: []byte("package migrations")},
+		"20261018130000_create_idx.sql": {Data: []byte("CREATE INDEX ON t (c);")},
+	}
+
+	migs, err := loadMigrations(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []int64{20260101000000, 20261018120000, 20261018130000}
+	if len(migs) != len(want) {
+		t.Fatalf("got %d migrations, want %d", len(migs), len(want))
+	}
+	for i, mig := range migs {
+		if mig.Version != want[i] {
+			t.Errorf("migration %d version = %d, want %d", i, mig.Version, want[i])
+		}
+		if len(mig.Checksum) != 64 {
+			t.Errorf("migration %d checksum = %q", i, mig.Checksum)
+		}
+	}
+	if migs[1].Name != "add_column" {
+		t.Errorf("name = %q, want add_column", migs[1].Name)
+	}
+
+	for name, fsys := range map[string]fstest.MapFS{
+		"no version": {"create_t.sql": {}},
+		"no name":    {"20260101000000.sql": {}},
+		"duplicate": {
+			"20260101000000_a.sql": {},
+			"20260101000000_b.sql": {},
+		},
+	} {
+		if _, err := loadMigrations(fsys); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// ListAuths searches credentials by email or phone number prefix.
+func (x *AuthService) ListAuths(ctx context.Context, in *pb.ListAuthsRequest) (*pb.ListAuthsResponse, error) {
+
+	if _, err := requirePermission(ctx, permAccountsRead); err != nil {
+		return nil, err
+	}
+
+	filter := &dbAuthFilter{
+		Query:        strings.TrimSpace(in.Query),
+		Role:         dbRoles(in.Role),
+		DisabledOnly: in.DisabledOnly,
+		Limit:        int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 50
+	case filter.Limit > 200:
+		filter.Limit = 200
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	auths, err := x.store.ListAuths(ctx, filter)
+	if err != nil {
+		slog.Error("storage list auths", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListAuthsResponse{}
+	for _, auth := range auths {
+		resp.Auths = append(resp.Auths, toPbAuth(auth))
+	}
+
+	if len(auths) == filter.Limit {
+		last := auths[len(auths)-1]
+		resp.NextPageToken = encodeCursor(last.CreateTime, last.ID)
+	}
+
+	return resp, nil
+}
+
+// DisableAuth prevents the account from logging in and publishes
+// "sync.<role>.disabled".
+func (x *AuthService) DisableAuth(ctx context.Context, in *pb.DisableAuthRequest) (*pb.AuthCredentials, error) {
+	return x.updateDisabled(ctx, in.AuthId, true)
+}
+
+// EnableAuth allows a disabled account to log in again and publishes
+// "sync.<role>.enabled".
+func (x *AuthService) EnableAuth(ctx context.Context, in *pb.EnableAuthRequest) (*pb.AuthCredentials, error) {
+	return x.updateDisabled(ctx, in.AuthId, false)
+}
+
+func (x *AuthService) updateDisabled(ctx context.Context, rawID string, disabled bool) (*pb.AuthCredentials, error) {
+
+	authID, _, err := x.authorizeAccountChange(ctx, rawID, pb.Roles_ROLES_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	auth, err := x.store.UpdateDisabledTx(ctx, tx, authID, disabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage update disabled", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	action := "enabled"
+	if disabled {
+		action = "disabled"
+	}
+
+	if err := x.publishSync(ctx, syncRoutingKey(pb.Roles(auth.Role), action), &pb.SyncAccountStatusUpdated{
+		AuthId:     auth.ID,
+		Role:       pb.Roles(auth.Role),
+		Disabled:   auth.Disabled,
+		UpdateTime: timestamppb.New(auth.UpdateTime),
+	}); err != nil {
+		slog.Error("publish account status", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbAuth(auth), nil
+}
+
+// UpdateRole changes the role of the account and publishes
+// "sync.auth.role.updated". The service owning the new role is asked to
+// create its record through the usual creation event, riders once approved.
+func (x *AuthService) UpdateRole(ctx context.Context, in *pb.UpdateRoleRequest) (*pb.AuthCredentials, error) {
+
+	if _, ok := pb.Roles_name[int32(in.Role)]; !ok || in.Role == pb.Roles_ROLES_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	authID, target, err := x.authorizeAccountChange(ctx, in.AuthId, in.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRole := pb.Roles(target.Role)
+	if oldRole == in.Role {
+		return toPbAuth(target), nil
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	auth, err := x.store.UpdateRoleTx(ctx, tx, authID, dbRoles(in.Role))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage update role", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if isAdmin(oldRole) || isAdmin(in.Role) {
+		if err := x.store.SetAccessRolesTx(ctx, tx, auth.ID, defaultAccessRoles(in.Role)); err != nil {
+			slog.Error("storage set access roles", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if err := x.publishSync(ctx, "sync.auth.role.updated", &pb.SyncAccountRoleUpdated{
+		AuthId:     auth.ID,
+		Email:      auth.Email,
+		OldRole:    oldRole,
+		NewRole:    in.Role,
+		UpdateTime: timestamppb.New(auth.UpdateTime),
+	}); err != nil {
+		slog.Error("publish role update", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if !isAdmin(in.Role) {
+		if err := x.startOnboarding(ctx, tx, in.Role, auth); err != nil {
+			slog.Error("start onboarding", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbAuth(auth), nil
+}
+
+// DeleteAuth deletes the account and publishes "sync.<role>.deleted".
+func (x *AuthService) DeleteAuth(ctx context.Context, in *pb.DeleteAuthRequest) (*emptypb.Empty, error) {
+
+	authID, target, err := x.authorizeAccountChange(ctx, in.AuthId, pb.Roles_ROLES_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.deleteAccount(ctx, authID, target); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// authorizeAccountChange loads the account an admin wants to change. Admins
+// cannot change their own account, and changing admin accounts or granting
+// an admin role through newRole also requires "admins:write".
+func (x *AuthService) authorizeAccountChange(ctx context.Context, authID string, newRole pb.Roles) (uuid.UUID, *dbAuthCredentials, error) {
+
+	callerID, err := requirePermission(ctx, permAccountsWrite)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	id, err := uuid.Parse(authID)
+	if err != nil {
+		return uuid.Nil, nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	if id.String() == callerID {
+		return uuid.Nil, nil, status.Error(codes.FailedPrecondition, "cannot change your own account")
+	}
+
+	target, err := x.store.GetAuth(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return uuid.Nil, nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if (isAdmin(pb.Roles(target.Role)) || isAdmin(newRole)) &&
+		!hasPermission(ctx, permAdminsWrite) {
+		return uuid.Nil, nil, status.Errorf(codes.PermissionDenied, "permission %s required to manage admin accounts", permAdminsWrite)
+	}
+
+	return id, target, nil
+}
+
+// syncRoutingKey returns "sync.<role>.<action>", e.g. "sync.customer.deleted".
+func syncRoutingKey(role pb.Roles, action string) string {
+	name := strings.ToLower(strings.TrimPrefix(role.String(), "ROLES_"))
+	return fmt.Sprintf("sync.%s.%s", name, action)
+}
+
+func (x *AuthService) publishSync(ctx context.Context, routingKey string, msg proto.Message) error {
+
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return x.rabbitmq.publish(ctx, routingKey, amqp.Publishing{
+		Type: "ihavefood." + string(msg.ProtoReflect().Descriptor().Name()),
+		Body: body,
+	})
+}
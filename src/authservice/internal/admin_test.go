@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+func (s *fakeStore) ListAuths(ctx context.Context, filter *dbAuthFilter) ([]*dbAuthCredentials, error) {
+	var auths []*dbAuthCredentials
+	for _, auth := range s.auths {
+		auths = append(auths, auth)
+	}
+	return auths, nil
+}
+
+// adminContext is the context of an admin holding the permissions.
+func adminContext(authID string, permissions string) context.Context {
+	return callerContext(authID, pb.Roles_ROLES_ADMIN, "auth-permissions", permissions)
+}
+
+func TestListAuths(t *testing.T) {
+
+	x := &AuthService{store: newFakeStore(testAuth(t, "Secret!Pass1"))}
+	adminID := uuid.NewString()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"allowed", adminContext(adminID, "accounts:read"), codes.OK},
+		{"other permission", adminContext(adminID, "accounts:write roles:read"), codes.PermissionDenied},
+		{"customer", callerContext(adminID, pb.Roles_ROLES_CUSTOMER), codes.PermissionDenied},
+		{"no identity", context.Background(), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := x.ListAuths(tt.ctx, &pb.ListAuthsRequest{})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ListAuths() = %v, want %v", got, tt.want)
+			}
+			if err == nil && len(resp.Auths) != 1 {
+				t.Errorf("ListAuths() returned %d accounts, want 1", len(resp.Auths))
+			}
+		})
+	}
+}
+
+func TestAuthorizeAccountChange(t *testing.T) {
+
+	customer := testAuth(t, "Secret!Pass1")
+	admin := testAuth(t, "Secret!Pass1")
+	admin.Role = dbRoles(pb.Roles_ROLES_ADMIN)
+	x := &AuthService{store: newFakeStore(customer, admin)}
+
+	callerID := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		target  string
+		newRole pb.Roles
+		want    codes.Code
+	}{
+		{"allowed", adminContext(callerID, "accounts:write"), customer.ID, pb.Roles_ROLES_UNSPECIFIED, codes.OK},
+		{"allowed role change", adminContext(callerID, "accounts:write"), customer.ID, pb.Roles_ROLES_MERCHANT, codes.OK},
+		{"read only", adminContext(callerID, "accounts:read"), customer.ID, pb.Roles_ROLES_UNSPECIFIED, codes.PermissionDenied},
+		{"customer", callerContext(callerID, pb.Roles_ROLES_CUSTOMER), customer.ID, pb.Roles_ROLES_UNSPECIFIED, codes.PermissionDenied},
+		{"no identity", context.Background(), customer.ID, pb.Roles_ROLES_UNSPECIFIED, codes.Unauthenticated},
+		{"self", adminContext(customer.ID, "accounts:write admins:write"), customer.ID, pb.Roles_ROLES_UNSPECIFIED, codes.FailedPrecondition},
+		{"admin account", adminContext(callerID, "accounts:write"), admin.ID, pb.Roles_ROLES_UNSPECIFIED, codes.PermissionDenied},
+		{"admin account by super admin", adminContext(callerID, "accounts:write admins:write"), admin.ID, pb.Roles_ROLES_UNSPECIFIED, codes.OK},
+		{"grant admin", adminContext(callerID, "accounts:write"), customer.ID, pb.Roles_ROLES_ADMIN, codes.PermissionDenied},
+		{"grant super admin", adminContext(callerID, "accounts:write"), customer.ID, pb.Roles_ROLES_SUPER_ADMIN, codes.PermissionDenied},
+		{"grant super admin by super admin", adminContext(callerID, "accounts:write admins:write"), customer.ID, pb.Roles_ROLES_SUPER_ADMIN, codes.OK},
+		{"unknown account", adminContext(callerID, "accounts:write"), uuid.NewString(), pb.Roles_ROLES_UNSPECIFIED, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := x.authorizeAccountChange(tt.ctx, tt.target, tt.newRole)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("authorizeAccountChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// The account changes are refused before the store is written.
+func TestAccountChangesRefused(t *testing.T) {
+
+	customer := testAuth(t, "Secret!Pass1")
+	x := &AuthService{store: newFakeStore(customer)}
+
+	changes := map[string]func(ctx context.Context, authID string) error{
+		"disable": func(ctx context.Context, authID string) error {
+			_, err := x.DisableAuth(ctx, &pb.DisableAuthRequest{AuthId: authID})
+			return err
+		},
+		"enable": func(ctx context.Context, authID string) error {
+			_, err := x.EnableAuth(ctx, &pb.EnableAuthRequest{AuthId: authID})
+			return err
+		},
+		"update role": func(ctx context.Context, authID string) error {
+			_, err := x.UpdateRole(ctx, &pb.UpdateRoleRequest{AuthId: authID, Role: pb.Roles_ROLES_SUPER_ADMIN})
+			return err
+		},
+		"delete": func(ctx context.Context, authID string) error {
+			_, err := x.DeleteAuth(ctx, &pb.DeleteAuthRequest{AuthId: authID})
+			return err
+		},
+	}
+
+	for name, change := range changes {
+		if got := status.Code(change(adminContext(uuid.NewString(), "accounts:read"), customer.ID)); got != codes.PermissionDenied {
+			t.Errorf("%s without accounts:write: got %v, want PermissionDenied", name, got)
+		}
+		if got := status.Code(change(adminContext(customer.ID, "accounts:write admins:write"), customer.ID)); got != codes.FailedPrecondition {
+			t.Errorf("%s of own account: got %v, want FailedPrecondition", name, got)
+		}
+	}
+
+	// Only holders of admins:write make super admins.
+	_, err := x.UpdateRole(adminContext(uuid.NewString(), "accounts:write"),
+		&pb.UpdateRoleRequest{AuthId: customer.ID, Role: pb.Roles_ROLES_SUPER_ADMIN})
+	if got := status.Code(err); got != codes.PermissionDenied {
+		t.Errorf("grant super admin without admins:write: got %v, want PermissionDenied", got)
+	}
+}
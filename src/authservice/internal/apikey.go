@@ -0,0 +1,270 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// API keys let the point of sale of a merchant call the API without a person
+// logging in. A key looks like "ihf_<prefix>_<secret>"; the prefix is shown
+// in listings and only a SHA-256 hash of the whole key is stored, which is
+// enough since keys are random.
+const (
+	apiKeyTag           = "ihf"
+	apiKeyPrefixBytes   = 6
+	apiKeyDefaultTTL    = 90 * 24 * time.Hour
+	apiKeyMaxTTL        = 365 * 24 * time.Hour
+	maxActiveAPIKeys    = 10
+	maxAPIKeyNameLength = 100
+)
+
+// apiKeyScopes are the scopes a key can be granted. The api-gateway maps
+// each scope to the routes it allows, so a scope is only added here once it
+// has routes. Orders have none yet since merchants receive them through events.
+var apiKeyScopes = []string{
+	"menu:read",
+	"menu:write",
+}
+
+// CreateAPIKey issues an API key for the calling merchant.
+func (x *AuthService) CreateAPIKey(ctx context.Context, in *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+
+	authID, err := x.apiKeyOwner(ctx, in.AuthId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(in.Name)
+	if name == "" || len(name) > maxAPIKeyNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be 1 to %d characters", maxAPIKeyNameLength)
+	}
+
+	scopes, err := normalizeScopes(in.Scopes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create api key: %v", err)
+	}
+
+	now := time.Now()
+	expireTime := now.Add(apiKeyDefaultTTL)
+	if in.ExpireTime != nil {
+		expireTime = in.ExpireTime.AsTime()
+		if !expireTime.After(now) || expireTime.After(now.Add(apiKeyMaxTTL)) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"expire time must be within %d days from now", int(apiKeyMaxTTL.Hours()/24))
+		}
+	}
+
+	keys, err := x.store.ListAPIKeys(ctx, authID)
+	if err != nil {
+		slog.Error("storage list api keys", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	active := 0
+	for _, key := range keys {
+		if key.RevokeTime == nil && key.ExpireTime.After(now) {
+			active++
+		}
+	}
+	if active >= maxActiveAPIKeys {
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"an account can have at most %d active api keys", maxActiveAPIKeys)
+	}
+
+	prefix, rawKey, err := newAPIKey()
+	if err != nil {
+		slog.Error("generate api key", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	key, err := x.store.CreateAPIKey(ctx, &dbNewAPIKey{
+		AuthID:     authID.String(),
+		Name:       name,
+		Prefix:     prefix,
+		KeyHash:    hashAPIKey(rawKey),
+		Scopes:     scopes,
+		ExpireTime: expireTime,
+	})
+	if err != nil {
+		slog.Error("storage create api key", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &key.AuthID, SecurityEvent_API_KEY_CREATED)
+
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: toPbAPIKey(key),
+		Key:    rawKey,
+	}, nil
+}
+
+// ListAPIKeys lists the API keys of the calling merchant, newest first.
+func (x *AuthService) ListAPIKeys(ctx context.Context, in *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+
+	authID, err := x.apiKeyOwner(ctx, in.AuthId)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := x.store.ListAPIKeys(ctx, authID)
+	if err != nil {
+		slog.Error("storage list api keys", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListAPIKeysResponse{}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toPbAPIKey(key))
+	}
+
+	return resp, nil
+}
+
+// RevokeAPIKey revokes an API key of the calling merchant.
+func (x *AuthService) RevokeAPIKey(ctx context.Context, in *pb.RevokeAPIKeyRequest) (*pb.APIKey, error) {
+
+	authID, err := x.apiKeyOwner(ctx, in.AuthId)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := uuid.Parse(in.KeyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid key id")
+	}
+
+	key, err := x.store.RevokeAPIKey(ctx, authID, keyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "api key not found or already revoked")
+		}
+		slog.Error("storage revoke api key", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &key.AuthID, SecurityEvent_API_KEY_REVOKED)
+
+	return toPbAPIKey(key), nil
+}
+
+// VerifyAPIKey returns the merchant and scopes of a valid API key.
+func (x *AuthService) VerifyAPIKey(ctx context.Context, in *pb.VerifyAPIKeyRequest) (*pb.VerifyAPIKeyResponse, error) {
+
+	if !strings.HasPrefix(in.Key, apiKeyTag+"_") {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+
+	principal, err := x.store.UseAPIKey(ctx, hashAPIKey(in.Key), Roles_MERCHANT)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		slog.Error("storage use api key", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.VerifyAPIKeyResponse{
+		KeyId:  principal.KeyID,
+		AuthId: principal.AuthID,
+		Role:   pb.Roles(principal.Role),
+		Scopes: principal.Scopes,
+	}, nil
+}
+
+// apiKeyOwner checks that the caller is the merchant owning the keys. Keys
+// cannot manage keys, the gateway only lets them reach the scoped routes.
+func (x *AuthService) apiKeyOwner(ctx context.Context, rawID string) (uuid.UUID, error) {
+
+	callerID, role, ok := callerFromContext(ctx)
+	if !ok || callerID != rawID {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "cannot manage api keys of another account")
+	}
+	if role != pb.Roles_ROLES_MERCHANT {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "only merchants can have api keys")
+	}
+
+	authID, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	return authID, nil
+}
+
+// normalizeScopes sorts the scopes and removes duplicates. It fails on
+// unknown scopes.
+func normalizeScopes(scopes []string) ([]string, error) {
+
+	var normalized []string
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !slices.Contains(apiKeyScopes, scope) {
+			return nil, fmt.Errorf("unknown scope %q, must be one of %s", scope, strings.Join(apiKeyScopes, ", "))
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+// newAPIKey returns a random key and the prefix identifying it.
+func newAPIKey() (prefix, key string, err error) {
+
+	b := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	prefix = apiKeyTag + "_" + hex.EncodeToString(b)
+
+	secret, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	return prefix, prefix + "_" + secret, nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func toPbAPIKey(key *dbAPIKey) *pb.APIKey {
+	pbKey := &pb.APIKey{
+		KeyId:      key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpireTime: timestamppb.New(key.ExpireTime),
+		CreateTime: timestamppb.New(key.CreateTime),
+	}
+	if key.LastUsedTime != nil {
+		pbKey.LastUsedTime = timestamppb.New(*key.LastUsedTime)
+	}
+	if key.RevokeTime != nil {
+		pbKey.RevokeTime = timestamppb.New(*key.RevokeTime)
+	}
+	return pbKey
+}
@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+func (s *fakeStore) ListAPIKeys(ctx context.Context, authID uuid.UUID) ([]*dbAPIKey, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) CreateAPIKey(ctx context.Context, newKey *dbNewAPIKey) (*dbAPIKey, error) {
+	s.apiKeys = append(s.apiKeys, newKey)
+	return &dbAPIKey{
+		ID:         uuid.NewString(),
+		AuthID:     newKey.AuthID,
+		Name:       newKey.Name,
+		Prefix:     newKey.Prefix,
+		Scopes:     newKey.Scopes,
+		ExpireTime: newKey.ExpireTime,
+		CreateTime: time.Now(),
+	}, nil
+}
+
+// UseAPIKey knows the keys created through the fake store.
+func (s *fakeStore) UseAPIKey(ctx context.Context, keyHash string, role dbRoles) (*dbAPIKeyPrincipal, error) {
+	for _, key := range s.apiKeys {
+		if key.KeyHash == keyHash {
+			return &dbAPIKeyPrincipal{AuthID: key.AuthID, Role: role, Scopes: key.Scopes}, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func TestCreateAPIKeyStoresHash(t *testing.T) {
+
+	store := newFakeStore()
+	x := &AuthService{store: store}
+	merchantID := uuid.NewString()
+
+	resp, err := x.CreateAPIKey(callerContext(merchantID, pb.Roles_ROLES_MERCHANT), &pb.CreateAPIKeyRequest{
+		AuthId: merchantID,
+		Name:   "point of sale",
+		Scopes: []string{"menu:write", " MENU:READ", "menu:write"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.apiKeys) != 1 {
+		t.Fatalf("stored %d keys, want 1", len(store.apiKeys))
+	}
+	stored := store.apiKeys[0]
+	if stored.KeyHash != hashAPIKey(resp.Key) {
+		t.Error("stored key hash is not the hash of the returned key")
+	}
+	_, secret, _ := strings.Cut(strings.TrimPrefix(resp.Key, stored.Prefix), "_")
+	if secret == "" || !strings.HasPrefix(resp.Key, stored.Prefix+"_") {
+		t.Fatalf("key %q does not start with its prefix %q", resp.Key, stored.Prefix)
+	}
+	for _, field := range []string{stored.Name, stored.Prefix, stored.KeyHash} {
+		if strings.Contains(field, secret) {
+			t.Errorf("the secret of the key is stored in %q", field)
+		}
+	}
+	if got := strings.Join(resp.ApiKey.Scopes, " "); got != "menu:read menu:write" {
+		t.Errorf("scopes %q, want %q", got, "menu:read menu:write")
+	}
+
+	verified, err := x.VerifyAPIKey(context.Background(), &pb.VerifyAPIKeyRequest{Key: resp.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.AuthId != merchantID {
+		t.Errorf("verified key of %s, want %s", verified.AuthId, merchantID)
+	}
+}
+
+func TestCreateAPIKeyRefused(t *testing.T) {
+
+	x := &AuthService{store: newFakeStore()}
+	merchantID := uuid.NewString()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		in   *pb.CreateAPIKeyRequest
+		want codes.Code
+	}{
+		{"unknown scope", callerContext(merchantID, pb.Roles_ROLES_MERCHANT),
+			&pb.CreateAPIKeyRequest{AuthId: merchantID, Name: "pos", Scopes: []string{"menu:read", "orders:read"}}, codes.InvalidArgument},
+		{"no scope", callerContext(merchantID, pb.Roles_ROLES_MERCHANT),
+			&pb.CreateAPIKeyRequest{AuthId: merchantID, Name: "pos"}, codes.InvalidArgument},
+		{"another merchant", callerContext(uuid.NewString(), pb.Roles_ROLES_MERCHANT),
+			&pb.CreateAPIKeyRequest{AuthId: merchantID, Name: "pos", Scopes: []string{"menu:read"}}, codes.PermissionDenied},
+		{"customer", callerContext(merchantID, pb.Roles_ROLES_CUSTOMER),
+			&pb.CreateAPIKeyRequest{AuthId: merchantID, Name: "pos", Scopes: []string{"menu:read"}}, codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := x.CreateAPIKey(tt.ctx, tt.in); status.Code(err) != tt.want {
+				t.Errorf("CreateAPIKey() = %v, want %v", status.Code(err), tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyAPIKeyInvalid(t *testing.T) {
+
+	x := &AuthService{store: newFakeStore()}
+
+	for _, key := range []string{"", "ihf_unknown_key", "bearer-token"} {
+		if _, err := x.VerifyAPIKey(context.Background(), &pb.VerifyAPIKeyRequest{Key: key}); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("VerifyAPIKey(%q) = %v, want Unauthenticated", key, status.Code(err))
+		}
+	}
+}
+
+// Revoked and expired keys, and keys of disabled accounts, are not usable.
+func TestUseAPIKey(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	merchant := testCredentials(t, s, Roles_MERCHANT)
+	merchantID := uuid.MustParse(merchant.ID)
+
+	createKey := func(expireTime time.Time) (*dbAPIKey, string) {
+		t.Helper()
+		prefix, rawKey, err := newAPIKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := s.CreateAPIKey(ctx, &dbNewAPIKey{
+			AuthID:     merchant.ID,
+			Name:       "point of sale",
+			Prefix:     prefix,
+			KeyHash:    hashAPIKey(rawKey),
+			Scopes:     []string{"menu:read"},
+			ExpireTime: expireTime,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key, rawKey
+	}
+
+	key, rawKey := createKey(time.Now().Add(time.Hour))
+	principal, err := s.UseAPIKey(ctx, hashAPIKey(rawKey), Roles_MERCHANT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if principal.AuthID != merchant.ID || principal.KeyID != key.ID {
+		t.Errorf("used key %s of %s, want %s of %s", principal.KeyID, principal.AuthID, key.ID, merchant.ID)
+	}
+	if _, err := s.UseAPIKey(ctx, hashAPIKey(rawKey), Roles_CUSTOMER); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("use with another role: got %v, want pgx.ErrNoRows", err)
+	}
+	if _, err := s.UseAPIKey(ctx, rawKey, Roles_MERCHANT); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("use by the raw key: got %v, want pgx.ErrNoRows", err)
+	}
+
+	if _, err := s.RevokeAPIKey(ctx, merchantID, uuid.MustParse(key.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.UseAPIKey(ctx, hashAPIKey(rawKey), Roles_MERCHANT); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("use a revoked key: got %v, want pgx.ErrNoRows", err)
+	}
+	if _, err := s.RevokeAPIKey(ctx, merchantID, uuid.MustParse(key.ID)); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("revoke again: got %v, want pgx.ErrNoRows", err)
+	}
+
+	_, expiredKey := createKey(time.Now().Add(-time.Minute))
+	if _, err := s.UseAPIKey(ctx, hashAPIKey(expiredKey), Roles_MERCHANT); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("use an expired key: got %v, want pgx.ErrNoRows", err)
+	}
+}
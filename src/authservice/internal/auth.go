@@ -13,12 +13,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
@@ -28,6 +28,7 @@ import (
 var (
 	signingKey   []byte
 	ErrDuplicate = errors.New("duplicate key")
+	ErrInUse     = errors.New("still referenced")
 )
 
 func LoadSigningKey() {
@@ -40,12 +41,68 @@ func LoadSigningKey() {
 
 type AuthStorer interface {
 	Begin(ctx context.Context) (pgx.Tx, error)
-	ListAuths(context.Context) ([]*dbAuthCredentials, error)
+	ListAuths(ctx context.Context, filter *dbAuthFilter) ([]*dbAuthCredentials, error)
 	GetAuth(ctx context.Context, authID uuid.UUID) (*dbAuthCredentials, error)
 	GetAuthByIdentifier(ctx context.Context, iden string) (*dbAuthCredentials, error)
 	Create(ctx context.Context, newAuth *dbNewAuthCredentials) (*dbAuthCredentials, error)
 	CreateTx(ctx context.Context, tx pgx.Tx, newAuth *dbNewAuthCredentials) (*dbAuthCredentials, error)
 	Delete(ctx context.Context, authID uuid.UUID) error
+	SoftDeleteTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID) error
+	IsSessionActive(ctx context.Context, authID uuid.UUID, issueTime time.Time) (bool, error)
+	UpdateDisabledTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, disabled bool) (*dbAuthCredentials, error)
+	UpdateRoleTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, role dbRoles) (*dbAuthCredentials, error)
+	UpdatePassword(ctx context.Context, authID uuid.UUID, hashedPass, algorithm string) error
+	UpdateEmailTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, email string) (*dbAuthCredentials, error)
+	SaveEmailChange(ctx context.Context, change *dbEmailChange, ttl, resendInterval time.Duration) (bool, error)
+	UseEmailChange(ctx context.Context, authID uuid.UUID, codeHash string, maxAttempts int) (*dbEmailChange, error)
+	UpdatePhoneNumberTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, phoneNumber string) (*dbAuthCredentials, error)
+	SavePhoneNumberChange(ctx context.Context, change *dbPhoneNumberChange, ttl, resendInterval time.Duration) (bool, error)
+	UsePhoneNumberChange(ctx context.Context, authID uuid.UUID, codeHash string, maxAttempts int) (*dbPhoneNumberChange, error)
+	CreateGuestTx(ctx context.Context, tx pgx.Tx, phoneNumber string) (*dbAuthCredentials, error)
+	UpgradeGuestTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, email, hashedPass, algorithm string) (*dbAuthCredentials, error)
+
+	GetLoginThrottle(ctx context.Context, key string) (*dbLoginThrottle, error)
+	RecordLoginFailure(ctx context.Context, key string, maxFailures int, lockFor, window time.Duration) (*dbLoginThrottle, error)
+	ResetLoginThrottle(ctx context.Context, key string) error
+	CreateSecurityEvent(ctx context.Context, event *dbNewSecurityEvent) error
+	ListSecurityEvents(ctx context.Context, filter *dbSecurityEventFilter) ([]*dbSecurityEvent, error)
+
+	GetTOTPFactor(ctx context.Context, authID uuid.UUID) (*dbTOTPFactor, error)
+	SaveTOTPSecret(ctx context.Context, authID uuid.UUID, secret string) error
+	ConfirmTOTPFactor(ctx context.Context, authID uuid.UUID, step int64, codeHashes []string) error
+	UseTOTPStep(ctx context.Context, authID uuid.UUID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, authID uuid.UUID, codeHash string) (bool, error)
+
+	CreateSocialLoginState(ctx context.Context, state *dbSocialLoginState, ttl time.Duration) error
+	ConsumeSocialLoginState(ctx context.Context, state, provider string) (*dbSocialLoginState, error)
+	GetSocialIdentity(ctx context.Context, provider, subject string) (*dbSocialIdentity, error)
+	ListSocialIdentities(ctx context.Context, authID uuid.UUID) ([]*dbSocialIdentity, error)
+	LinkSocialIdentityTx(ctx context.Context, tx pgx.Tx, identity *dbSocialIdentity) error
+
+	GetPhoneLoginQuota(ctx context.Context, phoneNumber string, window time.Duration) (*dbPhoneLoginQuota, error)
+	CreatePhoneLoginCode(ctx context.Context, phoneNumber, codeHash string, ttl time.Duration) error
+	UsePhoneLoginCode(ctx context.Context, phoneNumber, codeHash string, maxAttempts int) (bool, error)
+
+	CreateAPIKey(ctx context.Context, newKey *dbNewAPIKey) (*dbAPIKey, error)
+	ListAPIKeys(ctx context.Context, authID uuid.UUID) ([]*dbAPIKey, error)
+	RevokeAPIKey(ctx context.Context, authID, keyID uuid.UUID) (*dbAPIKey, error)
+	UseAPIKey(ctx context.Context, keyHash string, role dbRoles) (*dbAPIKeyPrincipal, error)
+
+	CreateRiderApplicationTx(ctx context.Context, tx pgx.Tx, authID string) (*dbRiderApplication, error)
+	GetRiderApplication(ctx context.Context, authID uuid.UUID) (*dbRiderApplication, error)
+	ListRiderApplications(ctx context.Context, filter *dbRiderApplicationFilter) ([]*dbRiderApplication, error)
+	SubmitRiderDocuments(ctx context.Context, authID uuid.UUID, docs *dbRiderDocuments) (*dbRiderApplication, error)
+	ReviewRiderApplicationTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, status dbRiderApplicationStatus, reviewerID, reason string) (*dbRiderApplication, error)
+
+	ListPermissions(ctx context.Context) ([]*dbPermission, error)
+	ListAccessRoles(ctx context.Context) ([]*dbAccessRole, error)
+	GetAccessRole(ctx context.Context, name string) (*dbAccessRole, error)
+	PutAccessRole(ctx context.Context, role *dbAccessRole) (*dbAccessRole, error)
+	DeleteAccessRole(ctx context.Context, name string) error
+	GetAuthPermissions(ctx context.Context, authID uuid.UUID) (*dbAuthPermissions, error)
+	AssignAccessRole(ctx context.Context, authID uuid.UUID, name, grantedBy string) (bool, error)
+	UnassignAccessRole(ctx context.Context, authID uuid.UUID, name string) (bool, error)
+	SetAccessRolesTx(ctx context.Context, tx pgx.Tx, authID string, names []string) error
 }
 
 type AuthService struct {
@@ -53,12 +110,20 @@ type AuthService struct {
 
 	store    AuthStorer
 	rabbitmq *rabbitMQ
+	sms      SMSSender
+	mail     EmailSender
+	// services authenticates the services calling the RPCs without an
+	// HTTP route.
+	services *ServiceAuthenticator
 }
 
-func NewAuthService(store AuthStorer, rabbitmq *rabbitMQ) *AuthService {
+func NewAuthService(store AuthStorer, rabbitmq *rabbitMQ, sms SMSSender, mail EmailSender, services *ServiceAuthenticator) *AuthService {
 	return &AuthService{
 		store:    store,
 		rabbitmq: rabbitmq,
+		sms:      sms,
+		mail:     mail,
+		services: services,
 	}
 }
 
@@ -74,7 +139,7 @@ func (x *AuthService) Register(ctx context.Context, in *pb.RegisterRequest) (*pb
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	hashPass, err := hashPassword(in.Password)
+	hashPass, algorithm, err := hashPassword(in.Password)
 	if err != nil {
 		slog.Error("hashing password", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
@@ -88,10 +153,11 @@ func (x *AuthService) Register(ctx context.Context, in *pb.RegisterRequest) (*pb
 	defer tx.Rollback(ctx)
 
 	auth, err := x.store.CreateTx(ctx, tx, &dbNewAuthCredentials{
-		Email:       in.Email,
-		HashedPass:  string(hashPass),
-		Role:        dbRoles(in.Role),
-		PhoneNumber: nil,
+		Email:             in.Email,
+		HashedPass:        hashPass,
+		PasswordAlgorithm: algorithm,
+		Role:              dbRoles(in.Role),
+		PhoneNumber:       nil,
 	})
 	if err != nil {
 		if errors.Is(err, ErrDuplicate) {
@@ -102,8 +168,8 @@ func (x *AuthService) Register(ctx context.Context, in *pb.RegisterRequest) (*pb
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	if err := x.dispatchCreation(ctx, in.Role, auth); err != nil {
-		slog.Error("dispatch creation", "err", err)
+	if err := x.startOnboarding(ctx, tx, in.Role, auth); err != nil {
+		slog.Error("start onboarding", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
@@ -113,22 +179,13 @@ func (x *AuthService) Register(ctx context.Context, in *pb.RegisterRequest) (*pb
 
 	}
 
-	phone := ""
-	if auth.PhoneNumber != nil {
-		phone = *auth.PhoneNumber
-	}
-
-	return &pb.AuthCredentials{
-		Id:         auth.ID,
-		Email:      auth.Email,
-		Phone:      phone,
-		Role:       pb.Roles(auth.Role),
-		CreateTime: timestamppb.New(auth.CreateTime),
-		UpdateTime: timestamppb.New(auth.UpdateTime),
-	}, nil
+	return toPbAuth(auth), nil
 }
 
-// Login handles auth login. It verifies the provided credentials, generates a JWT token on success,
+// Login handles auth login. It verifies the provided credentials, generates a JWT token on success.
+// Repeated failures delay and then temporarily lock further attempts of the account and client IP.
+// Accounts with two-factor authentication, and admins who must enrol, get a second factor token
+// instead of the JWT.
 func (x *AuthService) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
 
 	if err := ValidateStruct(in); err != nil {
@@ -140,84 +197,470 @@ func (x *AuthService) Login(ctx context.Context, in *pb.LoginRequest) (*pb.Login
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
+	if ip := clientFromContext(ctx).IP; ip != "" {
+		if err := x.checkLoginThrottle(ctx, ipThrottleKey(ip)); err != nil {
+			return nil, err
+		}
+	}
+
 	auth, err := x.store.GetAuthByIdentifier(ctx, in.Identifier)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			verifyDummyPassword(in.Password)
+			x.recordLoginFailure(ctx, nil)
 			return nil, status.Error(codes.Unauthenticated, "incorrect credentials")
 		}
 		slog.Error("storage get auth by identifier", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(auth.HashedPass), []byte(in.Password)); err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			slog.Error("bcrypt error mismatch")
-			return nil, status.Error(codes.Unauthenticated, "incorrect credentials")
-		}
-		slog.Error("bcrypt verification failed unexpectedly", "err", err)
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		return nil, err
+	}
+
+	match, rehash, err := verifyPassword(auth, in.Password)
+	if err != nil {
+		slog.Error("password verification failed unexpectedly", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
+	if !match {
+		x.recordLoginFailure(ctx, &auth.ID)
+		return nil, status.Error(codes.Unauthenticated, "incorrect credentials")
+	}
+
+	if rehash {
+		x.rehashPassword(ctx, auth, in.Password)
+	}
+
+	if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		slog.Error("storage reset login throttle", "err", err)
+	}
+
+	return x.completeLogin(ctx, auth)
+}
+
+// completeLogin logs in an account whose first factor has been verified. It
+// issues the access token, or a second factor token when the account has
+// two-factor authentication or must enrol.
+func (x *AuthService) completeLogin(ctx context.Context, auth *dbAuthCredentials) (*pb.LoginResponse, error) {
+
+	if auth.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	role := pb.Roles(auth.Role)
 
-	token, exp, err := x.createNewToken(auth.ID, pb.Roles(auth.Role))
+	enabled, err := x.secondFactorEnabled(ctx, auth.ID)
 	if err != nil {
-		slog.Error("create new token", "err", err)
+		slog.Error("storage get totp factor", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if enabled || requiresSecondFactor(role) {
+		token, err := createSecondFactorToken(auth.ID, role)
+		if err != nil {
+			slog.Error("create second factor token", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		return &pb.LoginResponse{
+			SecondFactorRequired: enabled,
+			EnrollmentRequired:   !enabled,
+			SecondFactorToken:    token,
+		}, nil
+	}
+
+	if err := x.issueAccessToken(ctx, auth.ID, role, auth.Guest); err != nil {
+		slog.Error("issue access token", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	grpc.SendHeader(ctx, metadata.Pairs(
+	return &pb.LoginResponse{}, nil
+}
+
+// issueAccessToken completes a login. It sends the access token in the
+// "access-token" header, which the api-gateway turns into a cookie.
+func (x *AuthService) issueAccessToken(ctx context.Context, authID string, role pb.Roles, guest bool) error {
+
+	// Only admin accounts hold access roles.
+	var permissions []string
+	if isAdmin(role) {
+		id, err := uuid.Parse(authID)
+		if err != nil {
+			return err
+		}
+		perms, err := x.store.GetAuthPermissions(ctx, id)
+		if err != nil {
+			return err
+		}
+		permissions = perms.Permissions
+	}
+
+	token, exp, err := x.createNewToken(authID, &AuthClaims{
+		Role:        role,
+		Permissions: permissions,
+		Guest:       guest,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs(
 		"access-token", token,
 		"exp", strconv.FormatInt(exp.Unix(), 10),
-	))
+	)); err != nil {
+		return err
+	}
 
-	return &pb.LoginResponse{}, nil
+	x.recordSecurityEvent(ctx, &authID, SecurityEvent_LOGIN_SUCCESS)
+	return nil
 }
 
-// CreateAdmin ignore input validation like password.
+// ChangePassword verifies the current password of the caller and replaces it.
+// Accounts without a password set one after a recent login instead.
+func (x *AuthService) ChangePassword(ctx context.Context, in *pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to change password: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok || callerID != in.AuthId {
+		return nil, status.Error(codes.PermissionDenied, "cannot change password of another account")
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.verifyCurrentPassword(ctx, auth, in.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	hashPass, algorithm, err := hashPassword(in.NewPassword)
+	if err != nil {
+		slog.Error("hashing password", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.store.UpdatePassword(ctx, authID, hashPass, algorithm); err != nil {
+		slog.Error("storage update password", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &auth.ID, SecurityEvent_PASSWORD_CHANGED)
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteAccount deletes the caller's account after verifying the password,
+// or without one when the caller logged in within reauthWindow. Admin
+// accounts are deleted by a super admin through DeleteAuth.
+func (x *AuthService) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest) (*emptypb.Empty, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to delete account: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	callerID, role, ok := callerFromContext(ctx)
+	if !ok || callerID != in.AuthId {
+		return nil, status.Error(codes.PermissionDenied, "cannot delete another account")
+	}
+
+	if isAdmin(role) {
+		return nil, status.Error(codes.FailedPrecondition, "admin accounts can only be deleted by a super admin")
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Accounts created by social login have a password the customer does not
+	// know, they can log in again instead.
+	if in.Password == "" && hasPassword(auth) {
+		if !recentlyAuthenticated(ctx) {
+			return nil, status.Errorf(codes.Unauthenticated, "password is required, or log in again within %v to delete the account", reauthWindow)
+		}
+	} else if err := x.verifyCurrentPassword(ctx, auth, in.Password); err != nil {
+		return nil, err
+	}
+
+	if err := x.deleteAccount(ctx, authID, auth); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// verifyCurrentPassword checks the password the caller gave to confirm a
+// sensitive change. Accounts without a password, such as guests, have nothing
+// to check and must have logged in within reauthWindow instead.
+func (x *AuthService) verifyCurrentPassword(ctx context.Context, auth *dbAuthCredentials, password string) error {
+
+	if !hasPassword(auth) {
+		if !recentlyAuthenticated(ctx) {
+			return status.Errorf(codes.Unauthenticated, "log in again within %v to make this change", reauthWindow)
+		}
+		return nil
+	}
+
+	// The password is guessed under the same throttle as logins, so a stolen
+	// session does not open an unthrottled way to find it.
+	if ip := clientFromContext(ctx).IP; ip != "" {
+		if err := x.checkLoginThrottle(ctx, ipThrottleKey(ip)); err != nil {
+			return err
+		}
+	}
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		return err
+	}
+
+	match, _, err := verifyPassword(auth, password)
+	if err != nil {
+		slog.Error("password verification failed unexpectedly", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+	if !match {
+		x.recordLoginFailure(ctx, &auth.ID)
+		return status.Error(codes.Unauthenticated, "incorrect credentials")
+	}
+
+	if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		slog.Error("storage reset login throttle", "err", err)
+	}
+
+	return nil
+}
+
+// deleteAccount soft-deletes the credential, which revokes its sessions, and
+// publishes "sync.<role>.deleted" so that the other services anonymise the
+// personal data they hold.
+func (x *AuthService) deleteAccount(ctx context.Context, authID uuid.UUID, auth *dbAuthCredentials) error {
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := x.store.SoftDeleteTx(ctx, tx, authID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage soft delete auth", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	role := pb.Roles(auth.Role)
+	if err := x.publishSync(ctx, syncRoutingKey(role, "deleted"), &pb.SyncAccountDeleted{
+		AuthId:     auth.ID,
+		Role:       role,
+		DeleteTime: timestamppb.New(time.Now()),
+	}); err != nil {
+		slog.Error("publish account deletion", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &auth.ID, SecurityEvent_ACCOUNT_DELETED)
+	return nil
+}
+
+// CheckSession reports whether a token is still valid. Tokens are revoked
+// when the account is disabled, deleted or its role changes.
+func (x *AuthService) CheckSession(ctx context.Context, in *pb.CheckSessionRequest) (*pb.CheckSessionResponse, error) {
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	if in.IssueTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "issue_time is required")
+	}
+
+	active, err := x.store.IsSessionActive(ctx, authID, in.IssueTime.AsTime())
+	if err != nil {
+		slog.Error("storage is session active", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.CheckSessionResponse{Active: active}, nil
+}
+
+// ListSecurityEvents lists security events newest first. Callers with
+// "security_events:read" may list every account, other callers only their own.
+func (x *AuthService) ListSecurityEvents(ctx context.Context, in *pb.ListSecurityEventsRequest) (*pb.ListSecurityEventsResponse, error) {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	if !hasPermission(ctx, permSecurityEventsRead) && in.AuthId != callerID {
+		return nil, status.Error(codes.PermissionDenied, "cannot list security events of another account")
+	}
+
+	filter := &dbSecurityEventFilter{
+		EventType: dbSecurityEventType(in.Type),
+		Limit:     int(in.PageSize),
+	}
+
+	if in.AuthId != "" {
+		if _, err := uuid.Parse(in.AuthId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+		}
+		filter.AuthID = &in.AuthId
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 50
+	case filter.Limit > 200:
+		filter.Limit = 200
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	events, err := x.store.ListSecurityEvents(ctx, filter)
+	if err != nil {
+		slog.Error("storage list security events", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListSecurityEventsResponse{}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.SecurityEvent{
+			EventId:    event.ID,
+			AuthId:     safeDeref(event.AuthID),
+			Type:       pb.SecurityEventType(event.EventType),
+			IpAddress:  safeDeref(event.IPAddress),
+			UserAgent:  safeDeref(event.UserAgent),
+			CreateTime: timestamppb.New(event.CreateTime),
+		})
+	}
+
+	if len(events) == filter.Limit {
+		last := events[len(events)-1]
+		resp.NextPageToken = encodeCursor(last.CreateTime, last.ID)
+	}
+
+	return resp, nil
+}
+
+// CreateAdmin ignore input validation like password. The admin gets the
+// built-in "admin" access role and is published as "sync.admin.created";
+// callers need "admins:write".
 func (x *AuthService) CreateAdmin(ctx context.Context, in *pb.CreateAdminRequest) (*pb.AuthCredentials, error) {
 
-	hashPass, err := hashPassword(in.Password)
+	callerID, err := requirePermission(ctx, permAdminsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	hashPass, algorithm, err := hashPassword(in.Password)
 	if err != nil {
 		slog.Error("hashing password", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	auth, err := x.store.Create(ctx, &dbNewAuthCredentials{
-		Email:       in.Email,
-		HashedPass:  string(hashPass),
-		Role:        dbRoles(pb.Roles_ROLES_ADMIN),
-		PhoneNumber: nil,
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	auth, err := x.store.CreateTx(ctx, tx, &dbNewAuthCredentials{
+		Email:             in.Email,
+		HashedPass:        hashPass,
+		PasswordAlgorithm: algorithm,
+		Role:              dbRoles(pb.Roles_ROLES_ADMIN),
+		PhoneNumber:       nil,
 	})
 	if err != nil {
 		if errors.Is(err, ErrDuplicate) {
 			slog.Error("database unique_violation:", "err", err)
 			return nil, status.Error(codes.AlreadyExists, "email or phone number already exists")
 		}
+		slog.Error("storage create admin", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	phone := ""
-	if auth.PhoneNumber != nil {
-		phone = *auth.PhoneNumber
+	if err := x.store.SetAccessRolesTx(ctx, tx, auth.ID, defaultAccessRoles(pb.Roles_ROLES_ADMIN)); err != nil {
+		slog.Error("storage set access roles", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	return &pb.AuthCredentials{
-		Id:         auth.ID,
+	if err := x.publishSync(ctx, syncRoutingKey(pb.Roles_ROLES_ADMIN, "created"), &pb.SyncAdminCreated{
+		AdminId:    auth.ID,
 		Email:      auth.Email,
-		Phone:      phone,
-		Role:       pb.Roles(auth.Role),
+		Role:       pb.Roles_ROLES_ADMIN,
 		CreateTime: timestamppb.New(auth.CreateTime),
-		UpdateTime: timestamppb.New(auth.UpdateTime),
-	}, nil
+		CreatedBy:  callerID,
+	}); err != nil {
+		slog.Error("publish admin creation", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
 
+	return toPbAuth(auth), nil
 }
 
 func (x *AuthService) dispatchCreation(ctx context.Context, role pb.Roles, auth *dbAuthCredentials) error {
 	switch role {
 	case pb.Roles_ROLES_CUSTOMER:
 		body, err := proto.Marshal(&pb.SyncCustomerCreated{
-			CustomerId: auth.ID,
-			Email:      auth.Email,
-			CreateTime: timestamppb.New(time.Now()),
+			CustomerId:  auth.ID,
+			Email:       auth.Email,
+			CreateTime:  timestamppb.New(time.Now()),
+			PhoneNumber: safeDeref(auth.PhoneNumber),
+			Guest:       auth.Guest,
 		})
 		if err != nil {
 			return err
@@ -247,6 +690,24 @@ func (x *AuthService) dispatchCreation(ctx context.Context, role pb.Roles, auth
 			return fmt.Errorf("failed to create rider: %v", err)
 		}
 
+	case pb.Roles_ROLES_MERCHANT:
+		body, err := proto.Marshal(&pb.SyncMerchantCreated{
+			MerchantId: auth.ID,
+			Email:      auth.Email,
+			CreateTime: timestamppb.New(time.Now()),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = x.rabbitmq.publish(ctx, "sync.merchant.created", amqp.Publishing{
+			Type: "ihavefood.SyncMerchantCreated",
+			Body: body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create merchant: %v", err)
+		}
+
 	default:
 		return errors.New("invalid role")
 	}
@@ -271,20 +732,18 @@ func (x *AuthService) dispatchCreation(ctx context.Context, role pb.Roles, auth
 // }
 
 // createNewToken generates a new JWT token specific roles with an expiration time from the current time.
-func (x *AuthService) createNewToken(id string, role pb.Roles) (signedToken string, expiration time.Time, err error) {
+// The registered claims are filled in on claims.
+func (x *AuthService) createNewToken(id string, claims *AuthClaims) (signedToken string, expiration time.Time, err error) {
 
 	day := 24 * time.Hour
 	now := time.Now()
 	exp := now.Add(30 * day)
 
-	claims := &AuthClaims{
-		Role: role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject:   id,
-			Issuer:    "auth service",
-			IssuedAt:  jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(exp),
-		},
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Subject:   id,
+		Issuer:    "auth service",
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(exp),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -297,16 +756,45 @@ func (x *AuthService) createNewToken(id string, role pb.Roles) (signedToken stri
 	return ss, exp, nil
 }
 
-func hashPassword(password string) ([]byte, error) {
-	hashedPass, err := bcrypt.GenerateFromPassword(
-		[]byte(password),
-		bcrypt.DefaultCost,
-	)
+func toPbAuth(auth *dbAuthCredentials) *pb.AuthCredentials {
+	return &pb.AuthCredentials{
+		Id:         auth.ID,
+		Email:      auth.Email,
+		Phone:      safeDeref(auth.PhoneNumber),
+		Role:       pb.Roles(auth.Role),
+		CreateTime: timestamppb.New(auth.CreateTime),
+		UpdateTime: timestamppb.New(auth.UpdateTime),
+		Disabled:   auth.Disabled,
+		Guest:      auth.Guest,
+	}
+}
+
+func safeDeref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// rehashPassword replaces the hash of a verified password with one from the
+// default hasher. Failing to do so is logged and does not fail the login.
+func (x *AuthService) rehashPassword(ctx context.Context, auth *dbAuthCredentials, password string) {
+
+	authID, err := uuid.Parse(auth.ID)
 	if err != nil {
-		return nil, err
+		slog.Error("parse auth id", "err", err)
+		return
 	}
-	return hashedPass, nil
 
+	hashPass, algorithm, err := hashPassword(password)
+	if err != nil {
+		slog.Error("hashing password", "err", err)
+		return
+	}
+
+	if err := x.store.UpdatePassword(ctx, authID, hashPass, algorithm); err != nil {
+		slog.Error("storage update password", "err", err)
+	}
 }
 
 // func statusErrInfo(c codes.Code, msg string, reason pb.Reason, meta map[string]string) error {
@@ -0,0 +1,154 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// fakeStore keeps accounts, throttles, security events and API keys in
+// memory. The methods it does not implement panic through the nil AuthStorer.
+type fakeStore struct {
+	AuthStorer
+
+	auths     map[string]*dbAuthCredentials
+	throttles map[string]*dbLoginThrottle
+	events    []dbSecurityEventType
+	apiKeys   []*dbNewAPIKey
+}
+
+func newFakeStore(auths ...*dbAuthCredentials) *fakeStore {
+	s := &fakeStore{
+		auths:     make(map[string]*dbAuthCredentials),
+		throttles: make(map[string]*dbLoginThrottle),
+	}
+	for _, auth := range auths {
+		s.auths[auth.ID] = auth
+	}
+	return s
+}
+
+func (s *fakeStore) GetAuth(ctx context.Context, authID uuid.UUID) (*dbAuthCredentials, error) {
+	auth, ok := s.auths[authID.String()]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	copied := *auth
+	return &copied, nil
+}
+
+func (s *fakeStore) UpdatePassword(ctx context.Context, authID uuid.UUID, hashedPass, algorithm string) error {
+	s.auths[authID.String()].HashedPass = hashedPass
+	s.auths[authID.String()].PasswordAlgorithm = algorithm
+	return nil
+}
+
+func (s *fakeStore) GetLoginThrottle(ctx context.Context, key string) (*dbLoginThrottle, error) {
+	if throttle, ok := s.throttles[key]; ok {
+		copied := *throttle
+		return &copied, nil
+	}
+	return &dbLoginThrottle{}, nil
+}
+
+// RecordLoginFailure counts the failure as just made.
+func (s *fakeStore) RecordLoginFailure(ctx context.Context, key string, maxFailures int, lockFor, window time.Duration) (*dbLoginThrottle, error) {
+	throttle, ok := s.throttles[key]
+	if !ok {
+		throttle = &dbLoginThrottle{}
+		s.throttles[key] = throttle
+	}
+	throttle.FailedCount++
+	if throttle.FailedCount >= maxFailures {
+		throttle.LockedFor = lockFor
+	}
+	copied := *throttle
+	return &copied, nil
+}
+
+func (s *fakeStore) ResetLoginThrottle(ctx context.Context, key string) error {
+	delete(s.throttles, key)
+	return nil
+}
+
+func (s *fakeStore) CreateSecurityEvent(ctx context.Context, event *dbNewSecurityEvent) error {
+	s.events = append(s.events, event.EventType)
+	return nil
+}
+
+// callerContext is the context of a request forwarded by the api-gateway.
+func callerContext(authID string, role pb.Roles, pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(append([]string{"auth-id", authID, "auth-role", role.String()}, pairs...)...))
+}
+
+// testAuth returns a customer account with the password.
+func testAuth(t *testing.T, password string) *dbAuthCredentials {
+	t.Helper()
+
+	hash, algorithm, err := hashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dbAuthCredentials{
+		ID:                uuid.NewString(),
+		Email:             "customer@example.com",
+		HashedPass:        hash,
+		PasswordAlgorithm: algorithm,
+		Role:              dbRoles(pb.Roles_ROLES_CUSTOMER),
+	}
+}
+
+func TestChangePasswordIsThrottled(t *testing.T) {
+
+	auth := testAuth(t, "Secret!Pass1")
+	store := newFakeStore(auth)
+	x := &AuthService{store: store}
+	ctx := callerContext(auth.ID, pb.Roles_ROLES_CUSTOMER)
+
+	change := func(current string) error {
+		_, err := x.ChangePassword(ctx, &pb.ChangePasswordRequest{
+			AuthId:          auth.ID,
+			CurrentPassword: current,
+			NewPassword:     "Other!Pass2",
+		})
+		return err
+	}
+
+	if got := status.Code(change("Wrong!Pass1")); got != codes.Unauthenticated {
+		t.Fatalf("wrong password: got %v, want Unauthenticated", got)
+	}
+	if store.throttles[accountThrottleKey(auth.ID)] == nil {
+		t.Fatal("wrong password: the failure of the account was not recorded")
+	}
+	if len(store.events) == 0 || store.events[0] != SecurityEvent_LOGIN_FAILURE {
+		t.Errorf("wrong password: security events %v, want a login failure", store.events)
+	}
+
+	// Right after a failure the account is in its delay, even for the right
+	// password.
+	if got := status.Code(change("Secret!Pass1")); got != codes.ResourceExhausted {
+		t.Fatalf("right password right after a failure: got %v, want ResourceExhausted", got)
+	}
+
+	store.throttles[accountThrottleKey(auth.ID)].SinceLastFailure = time.Hour
+	if err := change("Secret!Pass1"); err != nil {
+		t.Fatalf("right password after the delay: %v", err)
+	}
+	if store.throttles[accountThrottleKey(auth.ID)] != nil {
+		t.Error("right password: the throttle of the account was not reset")
+	}
+
+	store.throttles[accountThrottleKey(auth.ID)] = &dbLoginThrottle{FailedCount: maxAccountFailures, LockedFor: time.Hour}
+	if got := status.Code(change("Other!Pass2")); got != codes.ResourceExhausted {
+		t.Errorf("locked account: got %v, want ResourceExhausted", got)
+	}
+}
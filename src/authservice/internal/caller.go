@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// reauthWindow is how long after logging in a caller counts as having just
+// authenticated.
+const reauthWindow = 5 * time.Minute
+
+// callerFromContext returns the auth ID and role of the caller. The api-gateway
+// forwards them as "auth-id" and "auth-role" metadata after verifying the token.
+func callerFromContext(ctx context.Context) (authID string, role pb.Roles, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	ids, roles := md.Get("auth-id"), md.Get("auth-role")
+	if len(ids) == 0 || ids[0] == "" || len(roles) == 0 {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	return ids[0], pb.Roles(pb.Roles_value[roles[0]]), true
+}
+
+// authTimeFromContext returns when the token of the caller was issued, which
+// the api-gateway forwards as "auth-time" metadata in Unix seconds.
+func authTimeFromContext(ctx context.Context) (time.Time, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	values := md.Get("auth-time")
+	if len(values) == 0 {
+		return time.Time{}, false
+	}
+
+	sec, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(sec, 0), true
+}
+
+// recentlyAuthenticated reports whether the caller logged in within
+// reauthWindow, which stands in for the password of sensitive changes.
+func recentlyAuthenticated(ctx context.Context) bool {
+	authTime, ok := authTimeFromContext(ctx)
+	return ok && time.Since(authTime) <= reauthWindow
+}
+
+// isAdmin reports whether the role is one of the admin roles, which are
+// authorized by permissions and have no record in other services.
+func isAdmin(role pb.Roles) bool {
+	return role == pb.Roles_ROLES_ADMIN || role == pb.Roles_ROLES_SUPER_ADMIN
+}
+
+// permissionsFromContext returns the effective permissions of the caller,
+// forwarded by the api-gateway from the token as space separated
+// "auth-permissions" metadata.
+func permissionsFromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get("auth-permissions")
+	if len(values) == 0 {
+		return nil
+	}
+
+	return strings.Fields(values[0])
+}
+
+// hasPermission reports whether the caller holds the permission.
+func hasPermission(ctx context.Context, permission string) bool {
+	return slices.Contains(permissionsFromContext(ctx), permission)
+}
+
+// requirePermission returns the caller if it holds the permission.
+func requirePermission(ctx context.Context, permission string) (string, error) {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	if !hasPermission(ctx, permission) {
+		return "", status.Errorf(codes.PermissionDenied, "permission %s required", permission)
+	}
+
+	return callerID, nil
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+// Purposes of one-time codes. A code hashed for one purpose does not match
+// the same code of another purpose.
+const (
+	phoneLoginPurpose        = "phone-login"
+	emailChangePurpose       = "email-change"
+	phoneNumberChangePurpose = "phone-number-change"
+)
+
+// newNumericCode returns a random code of the given number of digits.
+func newNumericCode(digits int) (string, error) {
+
+	max := big.NewInt(1)
+	for range digits {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+// hashOneTimeCode returns the stored form of a code sent to subject, e.g. a
+// phone number. Codes are short, so they are keyed with a secret derived from
+// the JWT signing key to keep a leaked table from revealing them.
+func hashOneTimeCode(purpose, subject, code string) string {
+
+	key := hmac.New(sha256.New, signingKey)
+	key.Write([]byte(purpose))
+
+	mac := hmac.New(sha256.New, key.Sum(nil))
+	mac.Write([]byte(subject + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// RequestPhoneNumberChange verifies the current password of the caller and
+// sends a code to the new number to prove the caller owns it. Phone login and
+// guest merges trust the number, so it only changes once the code is
+// confirmed.
+func (x *AuthService) RequestPhoneNumberChange(ctx context.Context, in *pb.RequestPhoneNumberChangeRequest) (*pb.RequestPhoneNumberChangeResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to change phone number: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	_, auth, err := x.contactChangeAccount(ctx, in.AuthId, "phone number")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.verifyCurrentPassword(ctx, auth, in.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	if in.NewPhone == safeDeref(auth.PhoneNumber) {
+		return nil, status.Error(codes.InvalidArgument, "new phone number is the same as the current phone number")
+	}
+
+	if _, err := x.store.GetAuthByIdentifier(ctx, in.NewPhone); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "phone number already exists")
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	code, err := newNumericCode(phoneCodeDigits)
+	if err != nil {
+		slog.Error("generate phone code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	now := time.Now()
+	saved, err := x.store.SavePhoneNumberChange(ctx, &dbPhoneNumberChange{
+		AuthID:         auth.ID,
+		NewPhoneNumber: in.NewPhone,
+		CodeHash:       hashOneTimeCode(phoneNumberChangePurpose, auth.ID, code),
+	}, phoneCodeTTL, phoneCodeResendInterval)
+	if err != nil {
+		slog.Error("storage save phone number change", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !saved {
+		return nil, status.Error(codes.ResourceExhausted, "a code was sent recently, try again later")
+	}
+
+	msg := fmt.Sprintf("Your IHAVEFOOD verification code is %s. It expires in %d minutes.",
+		code, int(phoneCodeTTL.Minutes()))
+	if err := x.sms.Send(ctx, in.NewPhone, msg); err != nil {
+		slog.Error("send sms", "err", err)
+		return nil, status.Error(codes.Unavailable, "failed to send code")
+	}
+
+	x.recordSecurityEvent(ctx, &auth.ID, SecurityEvent_PHONE_CODE_SENT)
+
+	return &pb.RequestPhoneNumberChangeResponse{
+		ExpireTime: timestamppb.New(now.Add(phoneCodeTTL)),
+	}, nil
+}
+
+// ConfirmPhoneNumberChange changes the phone number of the caller with the
+// code sent by RequestPhoneNumberChange. Auth is the source of truth of
+// contact fields, the other services only apply
+// "sync.<role>.phone_number.updated". The previous number is told about the
+// change.
+func (x *AuthService) ConfirmPhoneNumberChange(ctx context.Context, in *pb.ConfirmPhoneNumberChangeRequest) (*pb.AuthCredentials, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to change phone number: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	authID, auth, err := x.contactChangeAccount(ctx, in.AuthId, "phone number")
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := x.store.UsePhoneNumberChange(ctx, authID,
+		hashOneTimeCode(phoneNumberChangePurpose, auth.ID, in.Code), maxPhoneCodeAttempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			x.recordAccountFailure(ctx, auth.ID)
+			return nil, status.Error(codes.Unauthenticated, "incorrect or expired code")
+		}
+		slog.Error("storage use phone number change", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	updated, err := x.store.UpdatePhoneNumberTx(ctx, tx, authID, change.NewPhoneNumber)
+	if err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.AlreadyExists, "phone number already exists")
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage update phone number", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Admins have no record in other services.
+	role := pb.Roles(updated.Role)
+	if !isAdmin(role) {
+		if err := x.publishSync(ctx, syncRoutingKey(role, "phone_number.updated"), &pb.SyncPhoneNumberUpdated{
+			AuthId:      updated.ID,
+			Role:        role,
+			PhoneNumber: safeDeref(updated.PhoneNumber),
+			UpdateTime:  timestamppb.New(updated.UpdateTime),
+		}); err != nil {
+			slog.Error("publish phone number update", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &updated.ID, SecurityEvent_PHONE_NUMBER_CHANGED)
+
+	if previous := safeDeref(auth.PhoneNumber); previous != "" {
+		msg := "The phone number of your IHAVEFOOD account was changed. " +
+			"If you did not make this change, contact support."
+		if err := x.sms.Send(ctx, previous, msg); err != nil {
+			slog.Error("send sms", "err", err)
+		}
+	}
+
+	return toPbAuth(updated), nil
+}
+
+// ListContacts lists the email and phone number of accounts with the role so
+// that services keeping a copy can report drift.
+func (x *AuthService) ListContacts(ctx context.Context, in *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
+
+	if in.Role == pb.Roles_ROLES_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	filter := &dbAuthFilter{
+		Role:  dbRoles(in.Role),
+		Limit: int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 200
+	case filter.Limit > 1000:
+		filter.Limit = 1000
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	auths, err := x.store.ListAuths(ctx, filter)
+	if err != nil {
+		slog.Error("storage list auths", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListContactsResponse{}
+	for _, auth := range auths {
+		resp.Contacts = append(resp.Contacts, &pb.Contact{
+			AuthId:      auth.ID,
+			Email:       auth.Email,
+			PhoneNumber: safeDeref(auth.PhoneNumber),
+			UpdateTime:  timestamppb.New(auth.UpdateTime),
+		})
+	}
+
+	if len(auths) == filter.Limit {
+		last := auths[len(auths)-1]
+		resp.NextPageToken = encodeCursor(last.CreateTime, last.ID)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,200 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// Limits of email changes. Wrong codes also count toward the account lockout.
+const (
+	emailCodeDigits         = 6
+	emailCodeTTL            = 15 * time.Minute
+	emailCodeResendInterval = time.Minute
+	maxEmailCodeAttempts    = 5
+)
+
+// RequestEmailChange verifies the current password of the caller and sends a
+// code to the new address to prove the caller owns it.
+func (x *AuthService) RequestEmailChange(ctx context.Context, in *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to change email: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	_, auth, err := x.contactChangeAccount(ctx, in.AuthId, "email")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.verifyCurrentPassword(ctx, auth, in.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	newEmail := strings.TrimSpace(in.NewEmail)
+	if strings.EqualFold(newEmail, auth.Email) {
+		return nil, status.Error(codes.InvalidArgument, "new email is the same as the current email")
+	}
+
+	if _, err := x.store.GetAuthByIdentifier(ctx, newEmail); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "email already exists")
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	code, err := newNumericCode(emailCodeDigits)
+	if err != nil {
+		slog.Error("generate email code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	now := time.Now()
+	saved, err := x.store.SaveEmailChange(ctx, &dbEmailChange{
+		AuthID:   auth.ID,
+		NewEmail: newEmail,
+		CodeHash: hashOneTimeCode(emailChangePurpose, auth.ID, code),
+	}, emailCodeTTL, emailCodeResendInterval)
+	if err != nil {
+		slog.Error("storage save email change", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !saved {
+		return nil, status.Error(codes.ResourceExhausted, "a code was sent recently, try again later")
+	}
+
+	body := fmt.Sprintf("Your IHAVEFOOD verification code is %s. It expires in %d minutes.",
+		code, int(emailCodeTTL.Minutes()))
+	if err := x.mail.Send(ctx, newEmail, "Confirm your new email", body); err != nil {
+		slog.Error("send email", "err", err)
+		return nil, status.Error(codes.Unavailable, "failed to send code")
+	}
+
+	return &pb.RequestEmailChangeResponse{
+		ExpireTime: timestamppb.New(now.Add(emailCodeTTL)),
+	}, nil
+}
+
+// ConfirmEmailChange changes the email of the caller with the code sent by
+// RequestEmailChange. The previous address is told about the change.
+func (x *AuthService) ConfirmEmailChange(ctx context.Context, in *pb.ConfirmEmailChangeRequest) (*pb.AuthCredentials, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to change email: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	authID, auth, err := x.contactChangeAccount(ctx, in.AuthId, "email")
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := x.store.UseEmailChange(ctx, authID,
+		hashOneTimeCode(emailChangePurpose, auth.ID, in.Code), maxEmailCodeAttempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			x.recordAccountFailure(ctx, auth.ID)
+			return nil, status.Error(codes.Unauthenticated, "incorrect or expired code")
+		}
+		slog.Error("storage use email change", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	updated, err := x.store.UpdateEmailTx(ctx, tx, authID, change.NewEmail)
+	if err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.AlreadyExists, "email already exists")
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage update email", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Admins have no record in other services.
+	role := pb.Roles(updated.Role)
+	if !isAdmin(role) {
+		if err := x.publishSync(ctx, syncRoutingKey(role, "email.updated"), &pb.SyncEmailUpdated{
+			AuthId:     updated.ID,
+			Role:       role,
+			Email:      updated.Email,
+			UpdateTime: timestamppb.New(updated.UpdateTime),
+		}); err != nil {
+			slog.Error("publish email update", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	x.recordSecurityEvent(ctx, &updated.ID, SecurityEvent_EMAIL_CHANGED)
+
+	body := fmt.Sprintf("The email of your IHAVEFOOD account was changed to %s. "+
+		"If you did not make this change, contact support.", updated.Email)
+	if err := x.mail.Send(ctx, auth.Email, "Your email was changed", body); err != nil {
+		slog.Error("send email", "err", err)
+	}
+
+	return toPbAuth(updated), nil
+}
+
+// contactChangeAccount loads the account of the caller, who can only change
+// their own email or phone number.
+func (x *AuthService) contactChangeAccount(ctx context.Context, rawID, field string) (uuid.UUID, *dbAuthCredentials, error) {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok || callerID != rawID {
+		return uuid.Nil, nil, status.Errorf(codes.PermissionDenied, "cannot change %s of another account", field)
+	}
+
+	authID, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(rawID)); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return uuid.Nil, nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return authID, auth, nil
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// exportEventsPageSize is the page size used to read every security event
+// of the account.
+const exportEventsPageSize = 200
+
+// ExportAuthData returns the account metadata for a personal data export.
+// Password hashes, TOTP secrets and recovery codes are never included. Only
+// customerservice, which builds the exports, can call it.
+func (x *AuthService) ExportAuthData(ctx context.Context, in *pb.ExportAuthDataRequest) (*pb.AuthDataExport, error) {
+
+	if err := x.services.authorize(ctx, serviceCustomer); err != nil {
+		return nil, err
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	export := &pb.AuthDataExport{Credentials: toPbAuth(auth)}
+
+	factor, err := x.store.GetTOTPFactor(ctx, authID)
+	switch {
+	case err == nil:
+		export.TotpEnabled = factor.Confirmed
+	case !errors.Is(err, pgx.ErrNoRows):
+		slog.Error("storage get totp factor", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	identities, err := x.store.ListSocialIdentities(ctx, authID)
+	if err != nil {
+		slog.Error("storage list social identities", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	for _, identity := range identities {
+		export.SocialIdentities = append(export.SocialIdentities, &pb.LinkedSocialIdentity{
+			Provider: identity.Provider,
+			Email:    identity.Email,
+			LinkTime: timestamppb.New(identity.CreateTime),
+		})
+	}
+
+	filter := &dbSecurityEventFilter{
+		AuthID: &in.AuthId,
+		Limit:  exportEventsPageSize,
+	}
+	for {
+		events, err := x.store.ListSecurityEvents(ctx, filter)
+		if err != nil {
+			slog.Error("storage list security events", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		for _, event := range events {
+			export.SecurityEvents = append(export.SecurityEvents, &pb.SecurityEvent{
+				EventId:    event.ID,
+				AuthId:     safeDeref(event.AuthID),
+				Type:       pb.SecurityEventType(event.EventType),
+				IpAddress:  safeDeref(event.IPAddress),
+				UserAgent:  safeDeref(event.UserAgent),
+				CreateTime: timestamppb.New(event.CreateTime),
+			})
+		}
+
+		if len(events) < filter.Limit {
+			break
+		}
+		last := events[len(events)-1]
+		filter.BeforeTime = &last.CreateTime
+		filter.BeforeID = last.ID
+	}
+
+	return export, nil
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+func (s *fakeStore) GetTOTPFactor(ctx context.Context, authID uuid.UUID) (*dbTOTPFactor, error) {
+	return nil, pgx.ErrNoRows
+}
+
+func (s *fakeStore) ListSocialIdentities(ctx context.Context, authID uuid.UUID) ([]*dbSocialIdentity, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) ListSecurityEvents(ctx context.Context, filter *dbSecurityEventFilter) ([]*dbSecurityEvent, error) {
+	return nil, nil
+}
+
+const testCustomerAccount = "customerservice@ihavefood.iam.gserviceaccount.com"
+
+// testServices accepts the tokens "customer" and "other" in place of ID
+// tokens, of customerservice and of an unknown service account.
+func testServices() *ServiceAuthenticator {
+
+	a := NewServiceAuthenticator("https://authservice.example.com", map[string]string{
+		testCustomerAccount: serviceCustomer,
+	})
+	a.validate = func(ctx context.Context, token, audience string) (*idtoken.Payload, error) {
+		switch token {
+		case "customer":
+			return &idtoken.Payload{Claims: map[string]any{"email": testCustomerAccount, "email_verified": true}}, nil
+		case "other":
+			return &idtoken.Payload{Claims: map[string]any{"email": "other@ihavefood.iam.gserviceaccount.com", "email_verified": true}}, nil
+		}
+		return nil, errors.New("invalid token")
+	}
+	return a
+}
+
+// serviceContext is the context of a call by another service with the token.
+func serviceContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestExportAuthDataCallers(t *testing.T) {
+
+	customer := testAuth(t, "Secret!Pass1")
+
+	tests := []struct {
+		name     string
+		services *ServiceAuthenticator
+		ctx      context.Context
+		want     codes.Code
+	}{
+		{"customerservice", testServices(), serviceContext("customer"), codes.OK},
+		{"other service", testServices(), serviceContext("other"), codes.PermissionDenied},
+		{"forged token", testServices(), serviceContext("forged"), codes.PermissionDenied},
+		{"the customer", testServices(), callerContext(customer.ID, pb.Roles_ROLES_CUSTOMER), codes.PermissionDenied},
+		{"admin", testServices(), adminContext(uuid.NewString(), allPermissions), codes.PermissionDenied},
+		{"no identity", testServices(), context.Background(), codes.PermissionDenied},
+		{"not configured", NewServiceAuthenticator("", nil), serviceContext("customer"), codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := &AuthService{store: newFakeStore(customer), services: tt.services}
+			if tt.want != codes.OK {
+				// The store is never reached, the caller is refused first.
+				x.store = nil
+			}
+
+			export, err := x.ExportAuthData(tt.ctx, &pb.ExportAuthDataRequest{AuthId: customer.ID})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("ExportAuthData() = %v, want %v", got, tt.want)
+			}
+			if err == nil && export.Credentials.Id != customer.ID {
+				t.Errorf("exported account %s, want %s", export.Credentials.Id, customer.ID)
+			}
+		})
+	}
+}
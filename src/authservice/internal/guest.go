@@ -0,0 +1,256 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// Guests are customers known only by a phone number verified with a login
+// code, so that a first order does not need registration. The api-gateway
+// limits their tokens to browsing and placing and tracking orders. A guest
+// either upgrades in place by setting an email and password, or merges into
+// an existing customer account.
+
+// completeGuestLogin creates a guest customer for a phone number without an
+// account, after verifying the code sent to it.
+func (x *AuthService) completeGuestLogin(ctx context.Context, phoneNumber, code string) (*pb.LoginResponse, error) {
+
+	codeHash := hashOneTimeCode(phoneLoginPurpose, phoneNumber, code)
+	used, err := x.store.UsePhoneLoginCode(ctx, phoneNumber, codeHash, maxPhoneCodeAttempts)
+	if err != nil {
+		slog.Error("storage use phone login code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !used {
+		x.recordLoginFailure(ctx, nil)
+		return nil, status.Error(codes.Unauthenticated, "incorrect or expired code")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	guest, err := x.store.CreateGuestTx(ctx, tx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.Aborted, "phone number was registered meanwhile, log in again")
+		}
+		slog.Error("storage create guest", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.dispatchCreation(ctx, pb.Roles_ROLES_CUSTOMER, guest); err != nil {
+		slog.Error("dispatch creation", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return x.completeLogin(ctx, guest)
+}
+
+// UpgradeGuest sets the email and password of the calling guest. The
+// customer copy of the email is updated by "sync.customer.email.updated".
+func (x *AuthService) UpgradeGuest(ctx context.Context, in *pb.UpgradeGuestRequest) (*pb.AuthCredentials, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to upgrade guest: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	authID, err := guestFromContext(ctx, in.AuthId)
+	if err != nil {
+		return nil, err
+	}
+
+	hashPass, algorithm, err := hashPassword(in.Password)
+	if err != nil {
+		slog.Error("hashing password", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	auth, err := x.store.UpgradeGuestTx(ctx, tx, authID, in.Email, hashPass, algorithm)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "account is not a guest")
+		}
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.AlreadyExists, "email already exists")
+		}
+		slog.Error("storage upgrade guest", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.publishSync(ctx, syncRoutingKey(pb.Roles_ROLES_CUSTOMER, "email.updated"), &pb.SyncEmailUpdated{
+		AuthId:     auth.ID,
+		Role:       pb.Roles_ROLES_CUSTOMER,
+		Email:      auth.Email,
+		UpdateTime: timestamppb.New(auth.UpdateTime),
+	}); err != nil {
+		slog.Error("publish email update", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// The guest token stays limited, so the caller gets a full one.
+	if err := x.issueAccessToken(ctx, auth.ID, pb.Roles_ROLES_CUSTOMER, false); err != nil {
+		slog.Error("issue access token", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbAuth(auth), nil
+}
+
+// MergeGuest deletes the calling guest and publishes "sync.customer.merged"
+// so that customer and order move its addresses and orders to the account of
+// the identifier. The password of that account is checked like a login.
+func (x *AuthService) MergeGuest(ctx context.Context, in *pb.MergeGuestRequest) (*pb.LoginResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to merge guest: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	guestID, err := guestFromContext(ctx, in.AuthId)
+	if err != nil {
+		return nil, err
+	}
+
+	guest, err := x.store.GetAuth(ctx, guestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !guest.Guest {
+		return nil, status.Error(codes.FailedPrecondition, "account is not a guest")
+	}
+
+	if ip := clientFromContext(ctx).IP; ip != "" {
+		if err := x.checkLoginThrottle(ctx, ipThrottleKey(ip)); err != nil {
+			return nil, err
+		}
+	}
+
+	target, err := x.store.GetAuthByIdentifier(ctx, in.Identifier)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			verifyDummyPassword(in.Password)
+			x.recordLoginFailure(ctx, nil)
+			return nil, status.Error(codes.Unauthenticated, "incorrect credentials")
+		}
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(target.ID)); err != nil {
+		return nil, err
+	}
+
+	match, _, err := verifyPassword(target, in.Password)
+	if err != nil {
+		slog.Error("password verification failed unexpectedly", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !match {
+		x.recordLoginFailure(ctx, &target.ID)
+		return nil, status.Error(codes.Unauthenticated, "incorrect credentials")
+	}
+
+	if pb.Roles(target.Role) != pb.Roles_ROLES_CUSTOMER || target.Guest {
+		return nil, status.Error(codes.FailedPrecondition, "guests can only merge into a customer account")
+	}
+	if target.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := x.store.SoftDeleteTx(ctx, tx, guestID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage soft delete", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Not "sync.customer.deleted", which would anonymise the orders before
+	// they move.
+	if err := x.publishSync(ctx, "sync.customer.merged", &pb.SyncCustomerMerged{
+		SourceCustomerId: guest.ID,
+		TargetCustomerId: target.ID,
+		MergeTime:        timestamppb.New(time.Now()),
+	}); err != nil {
+		slog.Error("publish customer merge", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(target.ID)); err != nil {
+		slog.Error("storage reset login throttle", "err", err)
+	}
+
+	return x.completeLogin(ctx, target)
+}
+
+// guestFromContext checks that the caller is the account of rawID.
+func guestFromContext(ctx context.Context, rawID string) (uuid.UUID, error) {
+
+	callerID, role, ok := callerFromContext(ctx)
+	if !ok || callerID != rawID || role != pb.Roles_ROLES_CUSTOMER {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "cannot change another account")
+	}
+
+	authID, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	return authID, nil
+}
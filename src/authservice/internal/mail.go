@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+)
+
+// EmailSender delivers plain text emails.
+type EmailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+type logEmailSender struct{}
+
+// NewLogEmailSender returns a sender that writes emails to the log instead of
+// sending them. It is meant for local development.
+func NewLogEmailSender() EmailSender {
+	return logEmailSender{}
+}
+
+func (logEmailSender) Send(ctx context.Context, to, subject, body string) error {
+	slog.Info("email", "to", to, "subject", subject, "body", body)
+	return nil
+}
@@ -0,0 +1,418 @@
+package internal
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// jwksRefreshInterval limits how often the signing keys of a provider are
+// fetched again when an ID token is signed with an unknown key.
+const jwksRefreshInterval = time.Minute
+
+var errInvalidIDToken = errors.New("invalid id token")
+
+// socialProviders are the OpenID Connect providers enabled through
+// SOCIAL_LOGIN_PROVIDERS, keyed by name.
+var socialProviders = map[string]*oidcProvider{}
+
+// defaultIssuers are used when <NAME>_OIDC_ISSUER is not set.
+var defaultIssuers = map[string]string{
+	"google": "https://accounts.google.com",
+	"line":   "https://access.line.me",
+}
+
+// LoadSocialProviders reads the comma separated provider names in
+// SOCIAL_LOGIN_PROVIDERS and the settings of each provider from
+// <NAME>_OIDC_ISSUER, <NAME>_OIDC_CLIENT_ID, <NAME>_OIDC_CLIENT_SECRET,
+// <NAME>_OIDC_REDIRECT_URL and <NAME>_OIDC_TRUST_EMAIL. Any provider
+// supporting discovery can be added, including a local mock provider.
+func LoadSocialProviders() {
+
+	names := os.Getenv("SOCIAL_LOGIN_PROVIDERS")
+	if names == "" {
+		return
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := strings.ToUpper(name) + "_OIDC_"
+		p := &oidcProvider{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			// LINE only returns the email address the user verified with LINE
+			// and has no email_verified claim.
+			TrustEmail: name == "line",
+		}
+
+		if p.Issuer == "" {
+			p.Issuer = defaultIssuers[name]
+		}
+		if v := os.Getenv(prefix + "TRUST_EMAIL"); v != "" {
+			p.TrustEmail = v == "true"
+		}
+
+		if p.Issuer == "" || p.ClientID == "" || p.ClientSecret == "" || p.RedirectURL == "" {
+			log.Fatalf("missing %sISSUER, CLIENT_ID, CLIENT_SECRET or REDIRECT_URL environment variable", prefix)
+		}
+
+		socialProviders[name] = p
+	}
+}
+
+// oidcProvider logs users in with the authorization code flow and PKCE.
+type oidcProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// TrustEmail treats the email claim as verified when the provider does
+	// not send email_verified.
+	TrustEmail bool
+
+	// HTTPClient is used to talk to the provider, http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	keys          map[string]crypto.PublicKey
+	keysFetchTime time.Time
+}
+
+// oidcDiscovery is the part of the provider metadata at
+// /.well-known/openid-configuration that the login flow needs.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// socialIdentity is the user the provider authenticated.
+type socialIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type idTokenClaims struct {
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	jwt.RegisteredClaims
+}
+
+// flexibleBool accepts both true and "true", as some providers send boolean
+// claims as strings.
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// authCodeURL returns the URL of the provider login page. The PKCE challenge
+// is derived from verifier, and nonce is bound to the ID token.
+func (p *oidcProvider) authCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+
+	cfg, _, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+// exchange redeems the authorization code and returns the user identified by
+// the verified ID token.
+func (p *oidcProvider) exchange(ctx context.Context, code, verifier, nonce string) (*socialIdentity, error) {
+
+	cfg, _, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := cfg.Exchange(p.clientContext(ctx), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: missing from token response", errInvalidIDToken)
+	}
+
+	return p.verifyIDToken(ctx, rawIDToken, nonce)
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of
+// the ID token. Asymmetric tokens are verified with the provider keys, and
+// HS256 tokens, which LINE issues, with the client secret.
+func (p *oidcProvider) verifyIDToken(ctx context.Context, raw, nonce string) (*socialIdentity, error) {
+
+	_, disc, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	if _, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (any, error) {
+		if t.Method == jwt.SigningMethodHS256 {
+			return []byte(p.ClientSecret), nil
+		}
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, disc.JWKSURI, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "HS256"}),
+		jwt.WithAudience(p.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidIDToken, err)
+	}
+
+	// Google documents both the URL and the bare host name as its issuer.
+	if claims.Issuer != disc.Issuer && "https://"+claims.Issuer != disc.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", errInvalidIDToken, claims.Issuer)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", errInvalidIDToken)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", errInvalidIDToken)
+	}
+
+	return &socialIdentity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.Email != "" && (bool(claims.EmailVerified) || p.TrustEmail),
+	}, nil
+}
+
+// oauth2Config returns the client configuration, fetching the provider
+// metadata the first time.
+func (p *oidcProvider) oauth2Config(ctx context.Context) (*oauth2.Config, *oidcDiscovery, error) {
+
+	p.mu.Lock()
+	disc := p.discovery
+	p.mu.Unlock()
+
+	if disc == nil {
+		disc = &oidcDiscovery{}
+		wellKnown := strings.TrimSuffix(p.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := p.getJSON(ctx, wellKnown, disc); err != nil {
+			return nil, nil, fmt.Errorf("discover %s: %w", p.Name, err)
+		}
+		if disc.AuthorizationEndpoint == "" || disc.TokenEndpoint == "" || disc.JWKSURI == "" {
+			return nil, nil, fmt.Errorf("discover %s: incomplete provider metadata", p.Name)
+		}
+
+		p.mu.Lock()
+		p.discovery = disc
+		p.mu.Unlock()
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		RedirectURL:  p.RedirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  disc.AuthorizationEndpoint,
+			TokenURL: disc.TokenEndpoint,
+		},
+	}, disc, nil
+}
+
+// publicKey returns the provider key with the key ID. The key set is fetched
+// again when the key is unknown, as providers rotate their keys.
+func (p *oidcProvider) publicKey(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	stale := time.Since(p.keysFetchTime) > jwksRefreshInterval
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.keysFetchTime = time.Now()
+	p.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v any) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (p *oidcProvider) client() *http.Client {
+	if p.HTTPClient != nil {
+		return p.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// clientContext makes the oauth2 package use the provider HTTP client.
+func (p *oidcProvider) clientContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, p.client())
+}
+
+// jsonWebKey is a public key of a JWK set (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		var ecdhCurve ecdh.Curve
+		switch k.Crv {
+		case "P-256":
+			curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+		case "P-384":
+			curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+		case "P-521":
+			curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("invalid ec coordinates")
+		}
+
+		// ecdh rejects points that are not on the curve.
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdhCurve.NewPublicKey(point); err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// mockOIDCProvider is a minimal OpenID Connect provider issuing ID tokens
+// for a single authorization code.
+type mockOIDCProvider struct {
+	*httptest.Server
+
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+
+	// Set by the test before the code is exchanged.
+	code      string
+	challenge string
+	claims    jwt.MapClaims
+	method    jwt.SigningMethod
+	hmacKey   []byte
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mockOIDCProvider{rsaKey: rsaKey, ecKey: ecKey, method: jwt.SigningMethodRS256}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		b64 := base64.RawURLEncoding.EncodeToString
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+			{
+				"kty": "RSA", "kid": "rsa", "use": "sig",
+				"n": b64(rsaKey.N.Bytes()),
+				"e": b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC", "kid": "ec", "use": "sig", "crv": "P-256",
+				"x": b64(ecKey.X.FillBytes(make([]byte, 32))),
+				"y": b64(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != m.code ||
+			base64.RawURLEncoding.EncodeToString(sum[:]) != m.challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		token := jwt.NewWithClaims(m.method, m.claims)
+		var key any
+		switch m.method {
+		case jwt.SigningMethodRS256:
+			token.Header["kid"] = "rsa"
+			key = rsaKey
+		case jwt.SigningMethodES256:
+			token.Header["kid"] = "ec"
+			key = ecKey
+		default:
+			key = m.hmacKey
+		}
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Error(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// authorize follows the authorization URL as the provider would after the
+// user signs in and returns the state and nonce it was given.
+func (m *mockOIDCProvider) authorize(t *testing.T, authURL string) (state, nonce string) {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("code_challenge_method = %q, want S256", q.Get("code_challenge_method"))
+	}
+
+	m.code = "code-123"
+	m.challenge = q.Get("code_challenge")
+	return q.Get("state"), q.Get("nonce")
+}
+
+func (m *mockOIDCProvider) idClaims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            m.URL,
+		"aud":            "client-id",
+		"sub":            "user-1",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+	}
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		method     jwt.SigningMethod
+		trustEmail bool
+		modify     func(c jwt.MapClaims)
+		verifier   string
+		want       *socialIdentity
+		wantErr    error
+	}{
+		{
+			name:   "rs256",
+			method: jwt.SigningMethodRS256,
+			want:   &socialIdentity{Subject: "user-1", Email: "user@example.com", EmailVerified: true},
+		},
+		{
+			name:   "es256",
+			method: jwt.SigningMethodES256,
+			want:   &socialIdentity{Subject: "user-1", Email: "user@example.com", EmailVerified: true},
+		},
+		{
+			name:       "hs256 with trusted email",
+			method:     jwt.SigningMethodHS256,
+			trustEmail: true,
+			modify:     func(c jwt.MapClaims) { delete(c, "email_verified") },
+			want:       &socialIdentity{Subject: "user-1", Email: "user@example.com", EmailVerified: true},
+		},
+		{
+			name:   "unverified email",
+			method: jwt.SigningMethodRS256,
+			modify: func(c jwt.MapClaims) { c["email_verified"] = "false" },
+			want:   &socialIdentity{Subject: "user-1", Email: "user@example.com"},
+		},
+		{
+			name:    "nonce mismatch",
+			method:  jwt.SigningMethodRS256,
+			modify:  func(c jwt.MapClaims) { c["nonce"] = "other" },
+			wantErr: errInvalidIDToken,
+		},
+		{
+			name:    "wrong audience",
+			method:  jwt.SigningMethodRS256,
+			modify:  func(c jwt.MapClaims) { c["aud"] = "other-client" },
+			wantErr: errInvalidIDToken,
+		},
+		{
+			name:    "wrong issuer",
+			method:  jwt.SigningMethodRS256,
+			modify:  func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+			wantErr: errInvalidIDToken,
+		},
+		{
+			name:    "expired",
+			method:  jwt.SigningMethodRS256,
+			modify:  func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+			wantErr: errInvalidIDToken,
+		},
+		{
+			name:     "wrong verifier",
+			method:   jwt.SigningMethodRS256,
+			verifier: "wrong-verifier",
+			wantErr:  &oauth2.RetrieveError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mock := newMockOIDCProvider(t)
+			mock.method = tt.method
+			mock.hmacKey = []byte("client-secret")
+
+			p := &oidcProvider{
+				Name:         "mock",
+				Issuer:       mock.URL,
+				ClientID:     "client-id",
+				ClientSecret: "client-secret",
+				RedirectURL:  "http://localhost/callback",
+				TrustEmail:   tt.trustEmail,
+				HTTPClient:   mock.Client(),
+			}
+
+			ctx := context.Background()
+			verifier := oauth2.GenerateVerifier()
+
+			authURL, err := p.authCodeURL(ctx, "state-1", "nonce-1", verifier)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state, nonce := mock.authorize(t, authURL)
+			if state != "state-1" || nonce != "nonce-1" {
+				t.Fatalf("state, nonce = %q, %q", state, nonce)
+			}
+
+			mock.claims = mock.idClaims(nonce)
+			if tt.modify != nil {
+				tt.modify(mock.claims)
+			}
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+
+			got, err := p.exchange(ctx, mock.code, verifier, "nonce-1")
+
+			if tt.wantErr != nil {
+				var retrieveErr *oauth2.RetrieveError
+				if _, ok := tt.wantErr.(*oauth2.RetrieveError); ok {
+					if !errors.As(err, &retrieveErr) {
+						t.Fatalf("err = %v, want RetrieveError", err)
+					}
+					return
+				}
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != *tt.want {
+				t.Errorf("identity = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteSocialLoginRequiresBoundState(t *testing.T) {
+
+	socialProviders["mock"] = &oidcProvider{Name: "mock"}
+	t.Cleanup(func() { delete(socialProviders, "mock") })
+
+	x := &AuthService{}
+	in := &pb.CompleteSocialLoginRequest{Provider: "mock", State: "state-a", Code: "code"}
+
+	for name, ctx := range map[string]context.Context{
+		"no cookie":    context.Background(),
+		"other cookie": metadata.NewIncomingContext(context.Background(), metadata.Pairs(socialLoginStateKey, "state-b")),
+	} {
+		if _, err := x.CompleteSocialLogin(ctx, in); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: got %v, want Unauthenticated", name, err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordHasher hashes passwords with one algorithm. The ID of the hasher is
+// stored with each credential so that existing hashes can still be verified
+// after the default changes.
+type PasswordHasher interface {
+	// ID identifies the algorithm, e.g. "argon2id".
+	ID() string
+	Hash(password string) (string, error)
+	// Verify reports whether the password matches the hash.
+	Verify(hash, password string) (bool, error)
+	// NeedsRehash reports whether the hash was created with parameters other
+	// than the current ones.
+	NeedsRehash(hash string) bool
+}
+
+// defaultPasswordHasher hashes new passwords. Passwords hashed with another
+// hasher, or with older parameters, are rehashed on the next login.
+var defaultPasswordHasher PasswordHasher = argon2idHasher{
+	Memory:  19 * 1024,
+	Time:    2,
+	Threads: 1,
+	KeyLen:  32,
+	SaltLen: 16,
+}
+
+var passwordHashers = map[string]PasswordHasher{
+	"argon2id": defaultPasswordHasher,
+	"bcrypt":   bcryptHasher{Cost: bcrypt.DefaultCost},
+}
+
+var errUnknownPasswordAlgorithm = errors.New("unknown password algorithm")
+
+// hashPassword hashes the password with the default hasher and returns the
+// hash and the ID of the hasher.
+func hashPassword(password string) (hash, algorithm string, err error) {
+	hash, err = defaultPasswordHasher.Hash(password)
+	if err != nil {
+		return "", "", err
+	}
+	return hash, defaultPasswordHasher.ID(), nil
+}
+
+// verifyPassword reports whether the password matches the credential and
+// whether the hash should be replaced by one from the default hasher.
+// Accounts without a password, such as guests, match no password.
+func verifyPassword(auth *dbAuthCredentials, password string) (ok, rehash bool, err error) {
+
+	if !hasPassword(auth) {
+		verifyDummyPassword(password)
+		return false, false, nil
+	}
+
+	hasher, found := passwordHashers[auth.PasswordAlgorithm]
+	if !found {
+		return false, false, fmt.Errorf("%w %q", errUnknownPasswordAlgorithm, auth.PasswordAlgorithm)
+	}
+
+	ok, err = hasher.Verify(auth.HashedPass, password)
+	if err != nil || !ok {
+		return false, false, err
+	}
+
+	rehash = hasher.ID() != defaultPasswordHasher.ID() || hasher.NeedsRehash(auth.HashedPass)
+	return true, rehash, nil
+}
+
+// hasPassword reports whether the account has a password. Guests log in with
+// a phone code and have none.
+func hasPassword(auth *dbAuthCredentials) bool {
+	return auth.HashedPass != ""
+}
+
+// dummyPasswordHash is a hash of the default hasher matching no password
+// given to a login.
+var dummyPasswordHash = sync.OnceValues(func() (string, error) {
+	return defaultPasswordHasher.Hash("dummy-password-for-unknown-accounts")
+})
+
+// verifyDummyPassword verifies the password against dummyPasswordHash. Logins
+// of unknown identifiers call it so that they take as long as a mismatched
+// password, and the response time does not tell which accounts exist.
+func verifyDummyPassword(password string) {
+	hash, err := dummyPasswordHash()
+	if err != nil {
+		return
+	}
+	defaultPasswordHasher.Verify(hash, password)
+}
+
+// bcryptHasher only verifies passwords of credentials created before argon2id
+// became the default. bcrypt ignores input past 72 bytes.
+type bcryptHasher struct {
+	Cost int
+}
+
+func (h bcryptHasher) ID() string { return "bcrypt" }
+
+func (h bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h bcryptHasher) Verify(hash, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (h bcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.Cost
+}
+
+// argon2idHasher encodes hashes in the PHC string format used by the
+// reference implementation:
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+type argon2idHasher struct {
+	Memory  uint32
+	Time    uint32
+	Threads uint8
+	KeyLen  uint32
+	SaltLen uint32
+}
+
+type argon2idHash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (h argon2idHasher) ID() string { return "argon2id" }
+
+func (h argon2idHasher) Hash(password string) (string, error) {
+
+	salt := make([]byte, h.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.Time, h.Memory, h.Threads, h.KeyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.Memory, h.Time, h.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h argon2idHasher) Verify(hash, password string) (bool, error) {
+
+	parsed, err := parseArgon2idHash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(password), parsed.salt,
+		parsed.time, parsed.memory, parsed.threads, uint32(len(parsed.key)))
+
+	return subtle.ConstantTimeCompare(key, parsed.key) == 1, nil
+}
+
+func (h argon2idHasher) NeedsRehash(hash string) bool {
+	parsed, err := parseArgon2idHash(hash)
+	if err != nil {
+		return true
+	}
+	return parsed.memory != h.Memory ||
+		parsed.time != h.Time ||
+		parsed.threads != h.Threads ||
+		uint32(len(parsed.key)) != h.KeyLen ||
+		uint32(len(parsed.salt)) != h.SaltLen
+}
+
+func parseArgon2idHash(hash string) (*argon2idHash, error) {
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, errors.New("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	var parsed argon2idHash
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &parsed.memory, &parsed.time, &parsed.threads); err != nil {
+		return nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+
+	var err error
+	if parsed.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	if parsed.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	if len(parsed.key) == 0 {
+		return nil, errors.New("invalid argon2id key")
+	}
+
+	return &parsed, nil
+}
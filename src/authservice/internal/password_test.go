@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifyPassword(t *testing.T) {
+
+	argonHash, algorithm, err := hashPassword("Secret!Pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if algorithm != "argon2id" {
+		t.Fatalf("algorithm = %q, want argon2id", algorithm)
+	}
+
+	bcryptHash, err := bcryptHasher{Cost: bcrypt.MinCost}.Hash("Secret!Pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weaker := argon2idHasher{Memory: 1024, Time: 1, Threads: 1, KeyLen: 32, SaltLen: 16}
+	weakHash, err := weaker.Hash("Secret!Pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		auth       *dbAuthCredentials
+		password   string
+		wantMatch  bool
+		wantRehash bool
+	}{
+		{
+			name:      "argon2id",
+			auth:      &dbAuthCredentials{HashedPass: argonHash, PasswordAlgorithm: "argon2id"},
+			password:  "Secret!Pass",
+			wantMatch: true,
+		},
+		{
+			name:     "argon2id mismatch",
+			auth:     &dbAuthCredentials{HashedPass: argonHash, PasswordAlgorithm: "argon2id"},
+			password: "Other!Pass",
+		},
+		{
+			name:       "bcrypt is rehashed",
+			auth:       &dbAuthCredentials{HashedPass: bcryptHash, PasswordAlgorithm: "bcrypt"},
+			password:   "Secret!Pass",
+			wantMatch:  true,
+			wantRehash: true,
+		},
+		{
+			name:       "old parameters are rehashed",
+			auth:       &dbAuthCredentials{HashedPass: weakHash, PasswordAlgorithm: "argon2id"},
+			password:   "Secret!Pass",
+			wantMatch:  true,
+			wantRehash: true,
+		},
+		{
+			name:     "bcrypt mismatch is not rehashed",
+			auth:     &dbAuthCredentials{HashedPass: bcryptHash, PasswordAlgorithm: "bcrypt"},
+			password: "Other!Pass",
+		},
+		{
+			name:     "guest without password matches nothing",
+			auth:     &dbAuthCredentials{HashedPass: "", PasswordAlgorithm: "bcrypt"},
+			password: "",
+		},
+		{
+			name:     "guest without password rejects a guess",
+			auth:     &dbAuthCredentials{HashedPass: "", PasswordAlgorithm: "bcrypt"},
+			password: "Secret!Pass1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash, err := verifyPassword(tt.auth, tt.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if match != tt.wantMatch || rehash != tt.wantRehash {
+				t.Errorf("verifyPassword() = %v, %v, want %v, %v", match, rehash, tt.wantMatch, tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestVerifyPasswordUnknownAlgorithm(t *testing.T) {
+	_, _, err := verifyPassword(&dbAuthCredentials{HashedPass: "x", PasswordAlgorithm: "md5"}, "x")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestArgon2idLongPassword(t *testing.T) {
+
+	// bcrypt ignores input past 72 bytes, argon2id must not.
+	long := strings.Repeat("a", 100)
+
+	hash, _, err := hashPassword(long + "X")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match, _, err := verifyPassword(&dbAuthCredentials{HashedPass: hash, PasswordAlgorithm: "argon2id"}, long+"Y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match {
+		t.Error("passwords differing after 72 bytes matched")
+	}
+}
+
+func TestDummyPasswordHash(t *testing.T) {
+
+	hash, err := dummyPasswordHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unknown accounts must pay for a full verification of the default
+	// hasher, which needs a hash it can parse.
+	match, err := defaultPasswordHasher.Verify(hash, "Secret!Pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match {
+		t.Error("dummy hash matched a password")
+	}
+}
@@ -0,0 +1,334 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// Admin accounts are authorized by permissions instead of their role. An
+// account holds access roles, each a named set of permissions stored in the
+// database, and its token carries the union of them. New access roles such as
+// "support_agent" are only data; only new permissions need code to check them.
+const (
+	permAccountsRead            = "accounts:read"
+	permAccountsWrite           = "accounts:write"
+	permAdminsWrite             = "admins:write"
+	permRolesRead               = "roles:read"
+	permRolesWrite              = "roles:write"
+	permSecurityEventsRead      = "security_events:read"
+	permRiderApplicationsReview = "rider_applications:review"
+)
+
+const maxAccessRoleDescriptionLength = 255
+
+var accessRoleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// defaultAccessRoles are the built-in access roles an account gets when it
+// becomes an admin of the role. Other roles get none.
+func defaultAccessRoles(role pb.Roles) []string {
+	switch role {
+	case pb.Roles_ROLES_SUPER_ADMIN:
+		return []string{"super_admin"}
+	case pb.Roles_ROLES_ADMIN:
+		return []string{"admin"}
+	default:
+		return nil
+	}
+}
+
+func (x *AuthService) ListPermissions(ctx context.Context, in *pb.ListPermissionsRequest) (*pb.ListPermissionsResponse, error) {
+
+	if _, err := requirePermission(ctx, permRolesRead); err != nil {
+		return nil, err
+	}
+
+	permissions, err := x.store.ListPermissions(ctx)
+	if err != nil {
+		slog.Error("storage list permissions", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListPermissionsResponse{}
+	for _, permission := range permissions {
+		resp.Permissions = append(resp.Permissions, &pb.Permission{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	return resp, nil
+}
+
+func (x *AuthService) ListAccessRoles(ctx context.Context, in *pb.ListAccessRolesRequest) (*pb.ListAccessRolesResponse, error) {
+
+	if _, err := requirePermission(ctx, permRolesRead); err != nil {
+		return nil, err
+	}
+
+	roles, err := x.store.ListAccessRoles(ctx)
+	if err != nil {
+		slog.Error("storage list access roles", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListAccessRolesResponse{}
+	for _, role := range roles {
+		resp.AccessRoles = append(resp.AccessRoles, toPbAccessRole(role))
+	}
+
+	return resp, nil
+}
+
+// PutAccessRole creates or replaces an access role. Callers can only put
+// permissions they hold, so a role cannot be used to gain more.
+func (x *AuthService) PutAccessRole(ctx context.Context, in *pb.PutAccessRoleRequest) (*pb.AccessRole, error) {
+
+	if _, err := requirePermission(ctx, permRolesWrite); err != nil {
+		return nil, err
+	}
+
+	if !accessRoleNamePattern.MatchString(in.Name) {
+		return nil, status.Error(codes.InvalidArgument,
+			"failed to put access role: Name must be 2 to 50 lowercase letters, digits or underscores")
+	}
+
+	description := strings.TrimSpace(in.Description)
+	if len(description) > maxAccessRoleDescriptionLength {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"failed to put access role: Description must be at most %d characters", maxAccessRoleDescriptionLength)
+	}
+
+	known, err := x.store.ListPermissions(ctx)
+	if err != nil {
+		slog.Error("storage list permissions", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	permissions, err := normalizePermissions(in.Permissions, known)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to put access role: %v", err)
+	}
+
+	if err := checkGrantable(ctx, permissions); err != nil {
+		return nil, err
+	}
+
+	role, err := x.store.PutAccessRole(ctx, &dbAccessRole{
+		Name:        in.Name,
+		Description: description,
+		Permissions: permissions,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "built-in access roles cannot be changed")
+		}
+		slog.Error("storage put access role", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbAccessRole(role), nil
+}
+
+func (x *AuthService) DeleteAccessRole(ctx context.Context, in *pb.DeleteAccessRoleRequest) (*emptypb.Empty, error) {
+
+	if _, err := requirePermission(ctx, permRolesWrite); err != nil {
+		return nil, err
+	}
+
+	if err := x.store.DeleteAccessRole(ctx, in.Name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "access role not found or built-in")
+		}
+		if errors.Is(err, ErrInUse) {
+			return nil, status.Error(codes.FailedPrecondition, "access role is still assigned to accounts")
+		}
+		slog.Error("storage delete access role", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (x *AuthService) GetAuthPermissions(ctx context.Context, in *pb.GetAuthPermissionsRequest) (*pb.AuthPermissions, error) {
+
+	if _, err := requirePermission(ctx, permRolesRead); err != nil {
+		return nil, err
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	return x.authPermissions(ctx, authID)
+}
+
+// AssignAccessRole gives an access role to an admin account. The sessions of
+// the account are revoked so that its next token carries the permissions.
+func (x *AuthService) AssignAccessRole(ctx context.Context, in *pb.AssignAccessRoleRequest) (*pb.AuthPermissions, error) {
+
+	authID, role, err := x.authorizeAccessRoleChange(ctx, in.AuthId, in.RoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	callerID, _, _ := callerFromContext(ctx)
+
+	assigned, err := x.store.AssignAccessRole(ctx, authID, role.Name, callerID)
+	if err != nil {
+		slog.Error("storage assign access role", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if assigned {
+		id := authID.String()
+		x.recordSecurityEvent(ctx, &id, SecurityEvent_ACCESS_ROLE_ASSIGNED)
+	}
+
+	return x.authPermissions(ctx, authID)
+}
+
+func (x *AuthService) UnassignAccessRole(ctx context.Context, in *pb.UnassignAccessRoleRequest) (*pb.AuthPermissions, error) {
+
+	authID, role, err := x.authorizeAccessRoleChange(ctx, in.AuthId, in.RoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	unassigned, err := x.store.UnassignAccessRole(ctx, authID, role.Name)
+	if err != nil {
+		slog.Error("storage unassign access role", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if unassigned {
+		id := authID.String()
+		x.recordSecurityEvent(ctx, &id, SecurityEvent_ACCESS_ROLE_UNASSIGNED)
+	}
+
+	return x.authPermissions(ctx, authID)
+}
+
+// authorizeAccessRoleChange loads the account and the access role to assign
+// or unassign. Callers cannot change their own roles, only admin accounts can
+// hold roles, and callers must hold every permission of the role.
+func (x *AuthService) authorizeAccessRoleChange(ctx context.Context, rawID, roleName string) (uuid.UUID, *dbAccessRole, error) {
+
+	callerID, err := requirePermission(ctx, permRolesWrite)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	authID, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	if authID.String() == callerID {
+		return uuid.Nil, nil, status.Error(codes.FailedPrecondition, "cannot change your own access roles")
+	}
+
+	target, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return uuid.Nil, nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if !isAdmin(pb.Roles(target.Role)) {
+		return uuid.Nil, nil, status.Error(codes.FailedPrecondition, "only admin accounts can hold access roles")
+	}
+
+	role, err := x.store.GetAccessRole(ctx, roleName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, nil, status.Error(codes.NotFound, "access role not found")
+		}
+		slog.Error("storage get access role", "err", err)
+		return uuid.Nil, nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := checkGrantable(ctx, role.Permissions); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return authID, role, nil
+}
+
+func (x *AuthService) authPermissions(ctx context.Context, authID uuid.UUID) (*pb.AuthPermissions, error) {
+
+	perms, err := x.store.GetAuthPermissions(ctx, authID)
+	if err != nil {
+		slog.Error("storage get auth permissions", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.AuthPermissions{
+		AuthId:      authID.String(),
+		AccessRoles: perms.AccessRoles,
+		Permissions: perms.Permissions,
+	}, nil
+}
+
+// checkGrantable fails unless the caller holds every permission.
+func checkGrantable(ctx context.Context, permissions []string) error {
+
+	held := permissionsFromContext(ctx)
+	for _, permission := range permissions {
+		if !slices.Contains(held, permission) {
+			return status.Errorf(codes.PermissionDenied, "cannot grant permission %s you do not hold", permission)
+		}
+	}
+
+	return nil
+}
+
+// normalizePermissions sorts the permissions and removes duplicates. It fails
+// on permissions not in known.
+func normalizePermissions(permissions []string, known []*dbPermission) ([]string, error) {
+
+	var normalized []string
+	for _, permission := range permissions {
+		permission = strings.ToLower(strings.TrimSpace(permission))
+		if !slices.ContainsFunc(known, func(p *dbPermission) bool { return p.Name == permission }) {
+			return nil, fmt.Errorf("unknown permission %q", permission)
+		}
+		if !slices.Contains(normalized, permission) {
+			normalized = append(normalized, permission)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil, errors.New("at least one permission is required")
+	}
+
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+func toPbAccessRole(role *dbAccessRole) *pb.AccessRole {
+	return &pb.AccessRole{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		Builtin:     role.Builtin,
+		CreateTime:  timestamppb.New(role.CreateTime),
+		UpdateTime:  timestamppb.New(role.UpdateTime),
+	}
+}
@@ -0,0 +1,198 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// allPermissions are the permissions seeded by the migrations.
+const allPermissions = "accounts:read accounts:write admins:write roles:read roles:write " +
+	"security_events:read rider_applications:review customers:read customers:write coupons:write"
+
+// headerStream captures the headers a handler sends.
+type headerStream struct {
+	grpc.ServerTransportStream
+
+	header metadata.MD
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// withSigningKey sets the key tokens are signed with for the test.
+func withSigningKey(t *testing.T) {
+	t.Helper()
+
+	prev := signingKey
+	signingKey = []byte("test-signing-key")
+	t.Cleanup(func() { signingKey = prev })
+}
+
+// tokenPermissions logs the account in and returns the permissions of its
+// new token.
+func tokenPermissions(t *testing.T, x *AuthService, auth *dbAuthCredentials) []string {
+	t.Helper()
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if err := x.issueAccessToken(ctx, auth.ID, pb.Roles(auth.Role), false); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := stream.header.Get("access-token")
+	if len(tokens) != 1 {
+		t.Fatalf("sent %d access tokens, want 1", len(tokens))
+	}
+
+	var claims AuthClaims
+	if _, err := jwt.ParseWithClaims(tokens[0], &claims, func(*jwt.Token) (any, error) {
+		return signingKey, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return claims.Permissions
+}
+
+func TestRequirePermission(t *testing.T) {
+
+	callerID := uuid.NewString()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"assigned", adminContext(callerID, "accounts:read roles:read"), codes.OK},
+		{"unassigned", adminContext(callerID, "accounts:read accounts:write"), codes.PermissionDenied},
+		{"no permissions", adminContext(callerID, ""), codes.PermissionDenied},
+		{"super admin role without permissions", callerContext(callerID, pb.Roles_ROLES_SUPER_ADMIN), codes.PermissionDenied},
+		{"no identity", context.Background(), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := requirePermission(tt.ctx, permRolesRead)
+			if status.Code(err) != tt.want {
+				t.Fatalf("requirePermission() = %v, want %v", status.Code(err), tt.want)
+			}
+			if err == nil && got != callerID {
+				t.Errorf("requirePermission() returned caller %s, want %s", got, callerID)
+			}
+		})
+	}
+}
+
+func TestBuiltinAccessRoles(t *testing.T) {
+
+	s := testStorage(t)
+	x := &AuthService{store: s}
+	ctx := adminContext(uuid.NewString(), allPermissions)
+
+	for _, name := range []string{"super_admin", "admin"} {
+		before, err := s.GetAccessRole(context.Background(), name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !before.Builtin {
+			t.Fatalf("access role %s is not built in", name)
+		}
+
+		_, err = x.PutAccessRole(ctx, &pb.PutAccessRoleRequest{Name: name, Permissions: []string{permAccountsRead}})
+		if got := status.Code(err); got != codes.FailedPrecondition {
+			t.Errorf("put %s: got %v, want FailedPrecondition", name, got)
+		}
+		_, err = x.DeleteAccessRole(ctx, &pb.DeleteAccessRoleRequest{Name: name})
+		if got := status.Code(err); got != codes.NotFound {
+			t.Errorf("delete %s: got %v, want NotFound", name, got)
+		}
+
+		after, err := s.GetAccessRole(context.Background(), name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(before.Permissions, after.Permissions) {
+			t.Errorf("permissions of %s changed from %v to %v", name, before.Permissions, after.Permissions)
+		}
+	}
+
+	// The built-in super_admin role has every permission.
+	superAdmin, err := s.GetAccessRole(context.Background(), "super_admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, permission := range strings.Fields(allPermissions) {
+		if !slices.Contains(superAdmin.Permissions, permission) {
+			t.Errorf("super_admin lacks permission %s", permission)
+		}
+	}
+}
+
+// The token of an admin carries the permissions of its access roles, and a
+// change of them revokes the tokens issued before.
+func TestAccessRoleAssignmentRebuildsToken(t *testing.T) {
+
+	withSigningKey(t)
+	s := testStorage(t)
+	x := &AuthService{store: s}
+	ctx := context.Background()
+	callerCtx := adminContext(uuid.NewString(), allPermissions)
+
+	admin := testCredentials(t, s, Roles_ADMIN)
+	adminID := uuid.MustParse(admin.ID)
+
+	roleName := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := x.PutAccessRole(callerCtx, &pb.PutAccessRoleRequest{
+		Name:        roleName,
+		Permissions: []string{permAccountsRead},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.DeleteAccessRole(context.Background(), roleName) })
+
+	perms := tokenPermissions(t, x, admin)
+	if len(perms) != 0 {
+		t.Fatalf("token without access roles has permissions %v", perms)
+	}
+	if _, err := requirePermission(adminContext(admin.ID, strings.Join(perms, " ")), permAccountsRead); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("unassigned permission: got %v, want PermissionDenied", status.Code(err))
+	}
+
+	// Issue times are stored to the second.
+	issuedBefore := time.Now().Add(-time.Second)
+
+	if _, err := x.AssignAccessRole(callerCtx, &pb.AssignAccessRoleRequest{AuthId: admin.ID, RoleName: roleName}); err != nil {
+		t.Fatal(err)
+	}
+	if active, err := s.IsSessionActive(ctx, adminID, issuedBefore); err != nil || active {
+		t.Errorf("token issued before the assignment: active %v, %v, want revoked", active, err)
+	}
+
+	perms = tokenPermissions(t, x, admin)
+	if !slices.Equal(perms, []string{permAccountsRead}) {
+		t.Errorf("token after the assignment has permissions %v, want [%s]", perms, permAccountsRead)
+	}
+	if _, err := requirePermission(adminContext(admin.ID, strings.Join(perms, " ")), permAccountsRead); err != nil {
+		t.Errorf("assigned permission: %v", err)
+	}
+
+	if _, err := x.UnassignAccessRole(callerCtx, &pb.UnassignAccessRoleRequest{AuthId: admin.ID, RoleName: roleName}); err != nil {
+		t.Fatal(err)
+	}
+	if perms := tokenPermissions(t, x, admin); len(perms) != 0 {
+		t.Errorf("token after the unassignment has permissions %v", perms)
+	}
+}
@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// Limits of passwordless phone login. A number can request a code once per
+// resend interval and a few times per window. A code is invalidated after a
+// few wrong guesses, and only the latest code of a number can be used.
+const (
+	phoneCodeDigits         = 6
+	phoneCodeTTL            = 5 * time.Minute
+	phoneCodeResendInterval = time.Minute
+	phoneCodeWindow         = time.Hour
+	maxPhoneCodesPerWindow  = 5
+	maxPhoneCodeAttempts    = 5
+)
+
+// StartPhoneLogin sends a login code to the phone number. Codes are stored
+// and rate limited even for numbers without an account so that the response
+// does not reveal which numbers are registered.
+func (x *AuthService) StartPhoneLogin(ctx context.Context, in *pb.StartPhoneLoginRequest) (*pb.StartPhoneLoginResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to start phone login: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if ip := clientFromContext(ctx).IP; ip != "" {
+		if err := x.checkLoginThrottle(ctx, ipThrottleKey(ip)); err != nil {
+			return nil, err
+		}
+	}
+
+	quota, err := x.store.GetPhoneLoginQuota(ctx, in.PhoneNumber, phoneCodeWindow)
+	if err != nil {
+		slog.Error("storage get phone login quota", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if quota.SentCount > 0 {
+		if wait := phoneCodeResendInterval - quota.SinceLastSent; wait > 0 {
+			return nil, status.Errorf(codes.ResourceExhausted,
+				"a code was sent recently, try again in %s", wait.Round(time.Second))
+		}
+	}
+	if quota.SentCount >= maxPhoneCodesPerWindow {
+		return nil, status.Error(codes.ResourceExhausted, "too many codes requested, try again later")
+	}
+
+	auth, err := x.store.GetAuthByIdentifier(ctx, in.PhoneNumber)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	exists := err == nil && !auth.Disabled
+	guest := errors.Is(err, pgx.ErrNoRows) && in.AllowGuest
+
+	code, err := newNumericCode(phoneCodeDigits)
+	if err != nil {
+		slog.Error("generate phone code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	now := time.Now()
+	codeHash := hashOneTimeCode(phoneLoginPurpose, in.PhoneNumber, code)
+	if err := x.store.CreatePhoneLoginCode(ctx, in.PhoneNumber, codeHash, phoneCodeTTL); err != nil {
+		slog.Error("storage create phone login code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if exists || guest {
+		msg := fmt.Sprintf("Your IHAVEFOOD login code is %s. It expires in %d minutes.",
+			code, int(phoneCodeTTL.Minutes()))
+		if err := x.sms.Send(ctx, in.PhoneNumber, msg); err != nil {
+			slog.Error("send sms", "err", err)
+			return nil, status.Error(codes.Unavailable, "failed to send code")
+		}
+	}
+	if exists {
+		x.recordSecurityEvent(ctx, &auth.ID, SecurityEvent_PHONE_CODE_SENT)
+	}
+
+	return &pb.StartPhoneLoginResponse{
+		ExpireTime: timestamppb.New(now.Add(phoneCodeTTL)),
+		ResendTime: timestamppb.New(now.Add(phoneCodeResendInterval)),
+	}, nil
+}
+
+// CompletePhoneLogin logs in with the latest code sent to the phone number.
+// The code can only be used once, and wrong codes count toward the same
+// lockout as wrong passwords. A number without an account becomes a guest
+// when the client allows it.
+func (x *AuthService) CompletePhoneLogin(ctx context.Context, in *pb.CompletePhoneLoginRequest) (*pb.LoginResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to login: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if ip := clientFromContext(ctx).IP; ip != "" {
+		if err := x.checkLoginThrottle(ctx, ipThrottleKey(ip)); err != nil {
+			return nil, err
+		}
+	}
+
+	auth, err := x.store.GetAuthByIdentifier(ctx, in.PhoneNumber)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if in.AllowGuest {
+				return x.completeGuestLogin(ctx, in.PhoneNumber, in.Code)
+			}
+			x.recordLoginFailure(ctx, nil)
+			return nil, status.Error(codes.Unauthenticated, "incorrect or expired code")
+		}
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		return nil, err
+	}
+
+	codeHash := hashOneTimeCode(phoneLoginPurpose, in.PhoneNumber, in.Code)
+	used, err := x.store.UsePhoneLoginCode(ctx, in.PhoneNumber, codeHash, maxPhoneCodeAttempts)
+	if err != nil {
+		slog.Error("storage use phone login code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if !used {
+		x.recordLoginFailure(ctx, &auth.ID)
+		return nil, status.Error(codes.Unauthenticated, "incorrect or expired code")
+	}
+
+	if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(auth.ID)); err != nil {
+		slog.Error("storage reset login throttle", "err", err)
+	}
+
+	return x.completeLogin(ctx, auth)
+}
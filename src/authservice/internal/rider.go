@@ -0,0 +1,271 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// startOnboarding publishes the creation of the account to the service owning
+// the role. Riders are only created in delivery once an admin approves them,
+// so a new rider gets a pending application instead. A rider approved before
+// is created right away.
+func (x *AuthService) startOnboarding(ctx context.Context, tx pgx.Tx, role pb.Roles, auth *dbAuthCredentials) error {
+
+	if role != pb.Roles_ROLES_RIDER {
+		return x.dispatchCreation(ctx, role, auth)
+	}
+
+	application, err := x.store.CreateRiderApplicationTx(ctx, tx, auth.ID)
+	if err != nil {
+		return err
+	}
+
+	if application.Status == RiderApplication_APPROVED {
+		return x.dispatchCreation(ctx, role, auth)
+	}
+	return nil
+}
+
+// SubmitRiderDocuments submits the documents of the calling rider for review.
+func (x *AuthService) SubmitRiderDocuments(ctx context.Context, in *pb.SubmitRiderDocumentsRequest) (*pb.RiderApplication, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to submit documents: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	callerID, role, ok := callerFromContext(ctx)
+	if !ok || callerID != in.AuthId || role != pb.Roles_ROLES_RIDER {
+		return nil, status.Error(codes.PermissionDenied, "cannot submit documents of another account")
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	docs := &dbRiderDocuments{
+		LicenceNumber:     strings.TrimSpace(in.Documents.LicenceNumber),
+		LicenceExpireTime: in.Documents.LicenceExpireTime.AsTime(),
+		VehicleType:       in.Documents.VehicleType,
+		VehiclePlate:      strings.TrimSpace(in.Documents.VehiclePlate),
+	}
+
+	if !docs.LicenceExpireTime.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "failed to submit documents: licence has expired")
+	}
+	if docs.VehicleType != "bicycle" && docs.VehiclePlate == "" {
+		return nil, status.Error(codes.InvalidArgument, "failed to submit documents: VehiclePlate is required")
+	}
+
+	application, err := x.store.SubmitRiderDocuments(ctx, authID, docs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "rider application is not open for submission")
+		}
+		slog.Error("storage submit rider documents", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbRiderApplication(application), nil
+}
+
+// GetRiderApplication returns the application of the calling rider, or of any
+// rider for reviewers.
+func (x *AuthService) GetRiderApplication(ctx context.Context, in *pb.GetRiderApplicationRequest) (*pb.RiderApplication, error) {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	if !hasPermission(ctx, permRiderApplicationsReview) && in.AuthId != callerID {
+		return nil, status.Error(codes.PermissionDenied, "cannot get rider application of another account")
+	}
+
+	authID, err := uuid.Parse(in.AuthId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	application, err := x.store.GetRiderApplication(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "rider application not found")
+		}
+		slog.Error("storage get rider application", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbRiderApplication(application), nil
+}
+
+func (x *AuthService) ListRiderApplications(ctx context.Context, in *pb.ListRiderApplicationsRequest) (*pb.ListRiderApplicationsResponse, error) {
+
+	if _, err := requirePermission(ctx, permRiderApplicationsReview); err != nil {
+		return nil, err
+	}
+
+	filter := &dbRiderApplicationFilter{
+		Status: dbRiderApplicationStatus(in.Status),
+		Limit:  int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 50
+	case filter.Limit > 200:
+		filter.Limit = 200
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	applications, err := x.store.ListRiderApplications(ctx, filter)
+	if err != nil {
+		slog.Error("storage list rider applications", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListRiderApplicationsResponse{}
+	for _, application := range applications {
+		resp.Applications = append(resp.Applications, toPbRiderApplication(application))
+	}
+
+	if len(applications) == filter.Limit {
+		last := applications[len(applications)-1]
+		resp.NextPageToken = encodeCursor(last.CreateTime, last.AuthID)
+	}
+
+	return resp, nil
+}
+
+// ApproveRiderApplication approves a rider and creates it in delivery.
+func (x *AuthService) ApproveRiderApplication(ctx context.Context, in *pb.ApproveRiderApplicationRequest) (*pb.RiderApplication, error) {
+	return x.reviewRiderApplication(ctx, in.AuthId, RiderApplication_APPROVED, "")
+}
+
+// RejectRiderApplication rejects a rider, who can submit documents again.
+func (x *AuthService) RejectRiderApplication(ctx context.Context, in *pb.RejectRiderApplicationRequest) (*pb.RiderApplication, error) {
+
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" || len(reason) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "reason must be 1 to 255 characters")
+	}
+
+	return x.reviewRiderApplication(ctx, in.AuthId, RiderApplication_REJECTED, reason)
+}
+
+func (x *AuthService) reviewRiderApplication(ctx context.Context, rawID string, decision dbRiderApplicationStatus, reason string) (*pb.RiderApplication, error) {
+
+	reviewerID, err := requirePermission(ctx, permRiderApplicationsReview)
+	if err != nil {
+		return nil, err
+	}
+
+	authID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid auth id")
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if pb.Roles(auth.Role) != pb.Roles_ROLES_RIDER {
+		return nil, status.Error(codes.FailedPrecondition, "account is not a rider")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	application, err := x.store.ReviewRiderApplicationTx(ctx, tx, authID, decision, reviewerID, reason)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "rider application is not waiting for review")
+		}
+		slog.Error("storage review rider application", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if decision == RiderApplication_APPROVED {
+		if err := x.dispatchCreation(ctx, pb.Roles_ROLES_RIDER, auth); err != nil {
+			slog.Error("dispatch creation", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if err := x.publishSync(ctx, "sync.rider.approval.updated", &pb.SyncRiderApprovalUpdated{
+		RiderId:      application.AuthID,
+		Status:       pb.RiderApplicationStatus(application.Status),
+		RejectReason: safeDeref(application.RejectReason),
+		UpdateTime:   timestamppb.New(application.UpdateTime),
+	}); err != nil {
+		slog.Error("publish rider approval update", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbRiderApplication(application), nil
+}
+
+func toPbRiderApplication(application *dbRiderApplication) *pb.RiderApplication {
+
+	pbApplication := &pb.RiderApplication{
+		RiderId:      application.AuthID,
+		Status:       pb.RiderApplicationStatus(application.Status),
+		RejectReason: safeDeref(application.RejectReason),
+		CreateTime:   timestamppb.New(application.CreateTime),
+		UpdateTime:   timestamppb.New(application.UpdateTime),
+	}
+
+	if application.SubmitTime != nil {
+		pbApplication.SubmitTime = timestamppb.New(*application.SubmitTime)
+		pbApplication.Documents = &pb.RiderDocuments{
+			LicenceNumber: safeDeref(application.LicenceNumber),
+			VehicleType:   safeDeref(application.VehicleType),
+			VehiclePlate:  safeDeref(application.VehiclePlate),
+		}
+		if application.LicenceExpireTime != nil {
+			pbApplication.Documents.LicenceExpireTime = timestamppb.New(*application.LicenceExpireTime)
+		}
+	}
+	if application.ReviewTime != nil {
+		pbApplication.ReviewTime = timestamppb.New(*application.ReviewTime)
+	}
+
+	return pbApplication
+}
@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Brute-force protection for Login. Failed attempts are counted per account
+// and per client IP. Each failure delays the next attempt of the account
+// exponentially, and reaching the limit locks the account or IP until the
+// lockout expires.
+const (
+	maxAccountFailures = 5
+	maxIPFailures      = 20
+	lockoutDuration    = 15 * time.Minute
+	failureWindow      = 15 * time.Minute
+	baseLoginDelay     = time.Second
+	maxLoginDelay      = 30 * time.Second
+)
+
+// trustedProxyHops is the number of proxies in front of the api-gateway that
+// append to X-Forwarded-For. The client IP is the entry they were given.
+var trustedProxyHops int
+
+func LoadSecurityConfig() {
+	hops := os.Getenv("TRUSTED_PROXY_HOPS")
+	if hops == "" {
+		return
+	}
+	n, err := strconv.Atoi(hops)
+	if err != nil || n < 0 {
+		log.Fatalf("invalid TRUSTED_PROXY_HOPS: %q", hops)
+	}
+	trustedProxyHops = n
+}
+
+func accountThrottleKey(authID string) string { return "account:" + authID }
+
+func ipThrottleKey(ip string) string { return "ip:" + ip }
+
+// loginDelay returns how long an account must wait after failedCount
+// consecutive failures before it can try again.
+func loginDelay(failedCount int) time.Duration {
+	if failedCount < 1 {
+		return 0
+	}
+
+	delay := baseLoginDelay
+	for i := 1; i < failedCount; i++ {
+		delay *= 2
+		if delay >= maxLoginDelay {
+			return maxLoginDelay
+		}
+	}
+	return delay
+}
+
+// checkLoginThrottle returns a ResourceExhausted error when the key is locked
+// or still in its delay window.
+func (x *AuthService) checkLoginThrottle(ctx context.Context, key string) error {
+
+	throttle, err := x.store.GetLoginThrottle(ctx, key)
+	if err != nil {
+		slog.Error("storage get login throttle", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	if throttle.LockedFor > 0 {
+		return status.Errorf(codes.ResourceExhausted,
+			"too many failed login attempts, try again in %s", throttle.LockedFor.Round(time.Second))
+	}
+
+	if wait := loginDelay(throttle.FailedCount) - throttle.SinceLastFailure; wait > 0 {
+		return status.Errorf(codes.ResourceExhausted,
+			"too many failed login attempts, try again in %s", wait.Round(time.Second))
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed login for the client IP and, when known,
+// for the account. It logs the failure and the lockout if the account is locked.
+func (x *AuthService) recordLoginFailure(ctx context.Context, authID *string) {
+
+	client := clientFromContext(ctx)
+
+	if client.IP != "" {
+		if _, err := x.store.RecordLoginFailure(ctx, ipThrottleKey(client.IP),
+			maxIPFailures, lockoutDuration, failureWindow); err != nil {
+			slog.Error("storage record ip login failure", "err", err)
+		}
+	}
+
+	x.recordSecurityEvent(ctx, authID, SecurityEvent_LOGIN_FAILURE)
+
+	if authID != nil {
+		x.recordAccountFailure(ctx, *authID)
+	}
+}
+
+// recordAccountFailure counts a failed attempt for the account and logs the
+// lockout if the account is locked.
+func (x *AuthService) recordAccountFailure(ctx context.Context, authID string) {
+
+	throttle, err := x.store.RecordLoginFailure(ctx, accountThrottleKey(authID),
+		maxAccountFailures, lockoutDuration, failureWindow)
+	if err != nil {
+		slog.Error("storage record account login failure", "err", err)
+		return
+	}
+
+	if throttle.FailedCount >= maxAccountFailures && throttle.LockedFor > 0 {
+		x.recordSecurityEvent(ctx, &authID, SecurityEvent_ACCOUNT_LOCKED)
+	}
+}
+
+// recordSecurityEvent persists a security event with the client information.
+// Failing to persist it is logged and does not fail the request.
+func (x *AuthService) recordSecurityEvent(ctx context.Context, authID *string, eventType dbSecurityEventType) {
+
+	client := clientFromContext(ctx)
+	if err := x.store.CreateSecurityEvent(ctx, &dbNewSecurityEvent{
+		AuthID:    authID,
+		EventType: eventType,
+		IPAddress: client.IP,
+		UserAgent: client.UserAgent,
+	}); err != nil {
+		slog.Error("storage create security event", "err", err, "type", eventType)
+	}
+}
+
+type clientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// clientFromContext returns the client IP and user agent. Requests through
+// the api-gateway carry them in "x-forwarded-for" and "grpcgateway-user-agent".
+// Entries of X-Forwarded-For are read from the right, since the left-most ones
+// are sent by the client and can be spoofed.
+func clientFromContext(ctx context.Context) clientInfo {
+
+	var client clientInfo
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("x-forwarded-for"); len(v) > 0 {
+		hops := strings.Split(v[len(v)-1], ",")
+		if i := len(hops) - 1 - trustedProxyHops; i >= 0 {
+			client.IP = strings.TrimSpace(hops[i])
+		}
+	}
+	if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+		client.UserAgent = v[0]
+	} else if v := md.Get("user-agent"); len(v) > 0 {
+		client.UserAgent = v[0]
+	}
+
+	if client.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			host, _, err := net.SplitHostPort(p.Addr.String())
+			if err != nil {
+				host = p.Addr.String()
+			}
+			client.IP = host
+		}
+	}
+
+	if len(client.UserAgent) > 255 {
+		client.UserAgent = client.UserAgent[:255]
+	}
+
+	return client
+}
+
+// encodeCursor and decodeCursor convert the last listed row, ordered by
+// create time and ID, to and from a page token.
+func encodeCursor(createTime time.Time, id string) string {
+	return fmt.Sprintf("%d_%s", createTime.UnixNano(), id)
+}
+
+func decodeCursor(token string) (time.Time, string, error) {
+
+	nanos, id, ok := strings.Cut(token, "_")
+	if !ok {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	return time.Unix(0, n).UTC(), id, nil
+}
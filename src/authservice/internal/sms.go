@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SMSSender delivers text messages to phone numbers.
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
+
+type logSMSSender struct{}
+
+// NewLogSMSSender returns a sender that writes messages to the log instead of
+// sending them. It is meant for local development.
+func NewLogSMSSender() SMSSender {
+	return logSMSSender{}
+}
+
+func (logSMSSender) Send(ctx context.Context, phoneNumber, message string) error {
+	slog.Info("sms", "to", phoneNumber, "message", message)
+	return nil
+}
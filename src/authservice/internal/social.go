@@ -0,0 +1,288 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// socialLoginStateTTL is how long the user has to sign in with the provider.
+const socialLoginStateTTL = 10 * time.Minute
+
+// socialLoginStateKey is the metadata that binds a login to the browser that
+// started it. StartSocialLogin sends the state in a header the api-gateway
+// keeps in an HttpOnly cookie, and the gateway forwards the cookie back with
+// CompleteSocialLogin.
+const socialLoginStateKey = "social-login-state"
+
+// socialLoginStateFromContext returns the state the api-gateway forwarded
+// from the cookie of the browser.
+func socialLoginStateFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(socialLoginStateKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// StartSocialLogin creates the state, nonce and PKCE verifier of a login with
+// the provider and returns the URL of the provider login page. The state is
+// also sent as a header for the api-gateway to bind it to the browser.
+func (x *AuthService) StartSocialLogin(ctx context.Context, in *pb.StartSocialLoginRequest) (*pb.StartSocialLoginResponse, error) {
+
+	provider, ok := socialProviders[in.Provider]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported provider")
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		slog.Error("generate state", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	nonce, err := randomToken()
+	if err != nil {
+		slog.Error("generate nonce", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	authURL, err := provider.authCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		slog.Error("social login authorization url", "provider", provider.Name, "err", err)
+		return nil, status.Error(codes.Unavailable, "identity provider unavailable")
+	}
+
+	if err := x.store.CreateSocialLoginState(ctx, &dbSocialLoginState{
+		State:        state,
+		Provider:     provider.Name,
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+	}, socialLoginStateTTL); err != nil {
+		slog.Error("storage create social login state", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs(socialLoginStateKey, state)); err != nil {
+		slog.Error("send social login state header", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.StartSocialLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+	}, nil
+}
+
+// CompleteSocialLogin redeems the authorization code and logs in the account
+// linked to the provider identity. An unlinked identity is linked to the
+// account with the same verified email, or a new customer is created. The
+// state must match the one bound to the browser, so a login started by
+// someone else cannot be completed in it.
+func (x *AuthService) CompleteSocialLogin(ctx context.Context, in *pb.CompleteSocialLoginRequest) (*pb.LoginResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to login: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	provider, ok := socialProviders[in.Provider]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported provider")
+	}
+
+	bound := socialLoginStateFromContext(ctx)
+	if bound == "" || subtle.ConstantTimeCompare([]byte(bound), []byte(in.State)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "state does not match the browser that started the login")
+	}
+
+	state, err := x.store.ConsumeSocialLoginState(ctx, in.State, provider.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired state")
+		}
+		slog.Error("storage consume social login state", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	identity, err := provider.exchange(ctx, in.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		switch {
+		case errors.As(err, &retrieveErr):
+			return nil, status.Error(codes.Unauthenticated, "authorization code was rejected")
+		case errors.Is(err, errInvalidIDToken):
+			slog.Error("verify id token", "provider", provider.Name, "err", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid id token")
+		default:
+			slog.Error("social login exchange", "provider", provider.Name, "err", err)
+			return nil, status.Error(codes.Unavailable, "identity provider unavailable")
+		}
+	}
+
+	auth, err := x.socialAccount(ctx, provider.Name, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if isAdmin(pb.Roles(auth.Role)) {
+		return nil, status.Error(codes.PermissionDenied, "admin accounts cannot use social login")
+	}
+
+	return x.completeLogin(ctx, auth)
+}
+
+// socialAccount returns the account linked to the identity, linking or
+// creating one on the first login with the provider.
+func (x *AuthService) socialAccount(ctx context.Context, provider string, identity *socialIdentity) (*dbAuthCredentials, error) {
+
+	linked, err := x.store.GetSocialIdentity(ctx, provider, identity.Subject)
+	if err == nil {
+		authID, err := uuid.Parse(linked.AuthID)
+		if err != nil {
+			slog.Error("parse linked auth id", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		auth, err := x.store.GetAuth(ctx, authID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, status.Error(codes.NotFound, "auth credential not found")
+			}
+			slog.Error("storage get auth", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		return auth, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("storage get social identity", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Linking by an unverified email would let anyone who registers the
+	// address with the provider take over the account.
+	if !identity.EmailVerified {
+		return nil, status.Error(codes.FailedPrecondition, "provider did not return a verified email")
+	}
+
+	auth, err := x.store.GetAuthByIdentifier(ctx, identity.Email)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("storage get auth by identifier", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	existing := err == nil
+
+	if existing && isAdmin(pb.Roles(auth.Role)) {
+		return nil, status.Error(codes.PermissionDenied, "admin accounts cannot use social login")
+	}
+
+	tx, err := x.store.Begin(ctx)
+	if err != nil {
+		slog.Error("begin transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer tx.Rollback(ctx)
+
+	if !existing {
+		auth, err = x.createSocialCustomer(ctx, tx, identity.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := x.store.LinkSocialIdentityTx(ctx, tx, &dbSocialIdentity{
+		Provider: provider,
+		Subject:  identity.Subject,
+		AuthID:   auth.ID,
+		Email:    identity.Email,
+	}); err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.Aborted, "identity is being linked, try again")
+		}
+		slog.Error("storage link social identity", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("commit transaction", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if existing {
+		x.recordSecurityEvent(ctx, &auth.ID, SecurityEvent_SOCIAL_LOGIN_LINKED)
+	}
+
+	return auth, nil
+}
+
+// createSocialCustomer creates a customer without a usable password and asks
+// the customer service to create its record.
+func (x *AuthService) createSocialCustomer(ctx context.Context, tx pgx.Tx, email string) (*dbAuthCredentials, error) {
+
+	password, err := randomToken()
+	if err != nil {
+		slog.Error("generate password", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	hashPass, algorithm, err := hashPassword(password)
+	if err != nil {
+		slog.Error("hashing password", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	auth, err := x.store.CreateTx(ctx, tx, &dbNewAuthCredentials{
+		Email:             email,
+		HashedPass:        hashPass,
+		PasswordAlgorithm: algorithm,
+		Role:              dbRoles(pb.Roles_ROLES_CUSTOMER),
+	})
+	if err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.Aborted, "account is being created, try again")
+		}
+		slog.Error("storage create new auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.dispatchCreation(ctx, pb.Roles_ROLES_CUSTOMER, auth); err != nil {
+		slog.Error("dispatch creation", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return auth, nil
+}
+
+// randomToken returns 32 random bytes encoded with unpadded base64url.
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -2,7 +2,10 @@ package internal
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -26,22 +29,42 @@ func (s *storage) Begin(ctx context.Context) (pgx.Tx, error) {
 	return tx, nil
 }
 
-func (s *storage) ListAuths(ctx context.Context) ([]*dbAuthCredentials, error) {
+// ListAuths lists credentials matching the filter, newest first.
+func (s *storage) ListAuths(ctx context.Context, filter *dbAuthFilter) ([]*dbAuthCredentials, error) {
 
 	rows, err := s.pool.Query(ctx, `
 		SELECT 
-			auth_id,
+			id,
 			email,
 			role,
 			phone_number,
 			create_time,
-			update_time
+			update_time,
+			disabled,
+			guest
 		FROM 
 			credentials 
-	`)
+		WHERE
+			delete_time IS NULL AND
+			($1 = '' OR email ILIKE $1 || '%' OR phone_number LIKE $1 || '%') AND
+			($2 = 0 OR role = $2) AND
+			($3 = FALSE OR disabled = TRUE) AND
+			($4::timestamp IS NULL OR (create_time, id) < ($4, $5::uuid))
+		ORDER BY
+			create_time DESC, id DESC
+		LIMIT $6
+	`,
+		escapeLike(filter.Query),
+		filter.Role,
+		filter.DisabledOnly,
+		filter.BeforeTime,
+		nullIfEmpty(filter.BeforeID),
+		filter.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	var auths []*dbAuthCredentials
 	for rows.Next() {
@@ -53,6 +76,8 @@ func (s *storage) ListAuths(ctx context.Context) ([]*dbAuthCredentials, error) {
 			&auth.PhoneNumber,
 			&auth.CreateTime,
 			&auth.UpdateTime,
+			&auth.Disabled,
+			&auth.Guest,
 		)
 		if err != nil {
 			return nil, err
@@ -71,16 +96,21 @@ func (s *storage) GetAuth(ctx context.Context, authID uuid.UUID) (*dbAuthCredent
 
 	row := s.pool.QueryRow(ctx, `
 		SELECT 
-			auth_id,
+			id,
 			email,
+			password,
+			password_algorithm,
 			role,
 			phone_number,
 			create_time,
-			update_time
+			update_time,
+			disabled,
+			guest
 		FROM 
 			credentials
 		WHERE
-			auth_id=$1
+			id=$1 AND
+			delete_time IS NULL
 	`,
 		authID)
 
@@ -88,10 +118,14 @@ func (s *storage) GetAuth(ctx context.Context, authID uuid.UUID) (*dbAuthCredent
 	err := row.Scan(
 		&auth.ID,
 		&auth.Email,
+		&auth.HashedPass,
+		&auth.PasswordAlgorithm,
 		&auth.Role,
 		&auth.PhoneNumber,
 		&auth.CreateTime,
 		&auth.UpdateTime,
+		&auth.Disabled,
+		&auth.Guest,
 	)
 	if err != nil {
 		return nil, err
@@ -108,15 +142,18 @@ func (s *storage) GetAuthByIdentifier(ctx context.Context, iden string) (*dbAuth
 			id,
 			email,
 			password,
+			password_algorithm,
 			role,
 			phone_number,
 			create_time,
-			update_time
+			update_time,
+			disabled,
+			guest
 		FROM 
 			credentials
 		WHERE
-			email=$1 OR
-			phone_number=$1 
+			(email=$1 OR phone_number=$1) AND
+			delete_time IS NULL
 	`,
 		iden)
 
@@ -125,10 +162,13 @@ func (s *storage) GetAuthByIdentifier(ctx context.Context, iden string) (*dbAuth
 		&auth.ID,
 		&auth.Email,
 		&auth.HashedPass,
+		&auth.PasswordAlgorithm,
 		&auth.Role,
 		&auth.PhoneNumber,
 		&auth.CreateTime,
 		&auth.UpdateTime,
+		&auth.Disabled,
+		&auth.Guest,
 	)
 	if err != nil {
 		return nil, err
@@ -145,14 +185,26 @@ func (s *storage) Create(ctx context.Context, newAuth *dbNewAuthCredentials) (*d
 		INSERT INTO credentials(
 			email,
 			password,
+			password_algorithm,
 			role,
 			phone_number
 		)VALUES(
-			$1,$2,$3,$4
-		)RETURNING *;
+			$1,$2,$3,$4,$5
+		)RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest;
 	`,
 		newAuth.Email,
 		newAuth.HashedPass,
+		newAuth.PasswordAlgorithm,
 		newAuth.Role,
 		newAuth.PhoneNumber,
 	)
@@ -162,10 +214,13 @@ func (s *storage) Create(ctx context.Context, newAuth *dbNewAuthCredentials) (*d
 		&auth.ID,
 		&auth.Email,
 		&auth.HashedPass,
+		&auth.PasswordAlgorithm,
 		&auth.Role,
 		&auth.PhoneNumber,
 		&auth.CreateTime,
 		&auth.UpdateTime,
+		&auth.Disabled,
+		&auth.Guest,
 	); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -184,15 +239,27 @@ func (s *storage) CreateTx(ctx context.Context, tx pgx.Tx, newAuth *dbNewAuthCre
 		INSERT INTO credentials(
 			email,
 			password,
+			password_algorithm,
 			role,
 			phone_number,
 			create_time
 		)VALUES(
-			$1,$2,$3,$4,now()
-		)RETURNING *;
+			$1,$2,$3,$4,$5,now()
+		)RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest;
 	`,
 		newAuth.Email,
 		newAuth.HashedPass,
+		newAuth.PasswordAlgorithm,
 		newAuth.Role,
 		newAuth.PhoneNumber,
 	)
@@ -202,10 +269,13 @@ func (s *storage) CreateTx(ctx context.Context, tx pgx.Tx, newAuth *dbNewAuthCre
 		&auth.ID,
 		&auth.Email,
 		&auth.HashedPass,
+		&auth.PasswordAlgorithm,
 		&auth.Role,
 		&auth.PhoneNumber,
 		&auth.CreateTime,
 		&auth.UpdateTime,
+		&auth.Disabled,
+		&auth.Guest,
 	); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -220,7 +290,7 @@ func (s *storage) CreateTx(ctx context.Context, tx pgx.Tx, newAuth *dbNewAuthCre
 // Delete deletes the auth credential.
 func (s *storage) Delete(ctx context.Context, authID uuid.UUID) error {
 
-	query := `DELETE FROM credentials WHERE auth_id=$1`
+	query := `DELETE FROM credentials WHERE id=$1`
 
 	if _, err := s.pool.Exec(ctx, query, authID); err != nil {
 		return err
@@ -228,3 +298,1989 @@ func (s *storage) Delete(ctx context.Context, authID uuid.UUID) error {
 
 	return nil
 }
+
+// SoftDeleteTx anonymises the auth credential within the transaction and
+// revokes its sessions. The row is kept so that the ID stays reserved and
+// security events remain attributable. Its second factor, social identities
+// and pending email change are removed.
+func (s *storage) SoftDeleteTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID) error {
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE credentials
+		SET
+			email = id::text || '@deleted.invalid',
+			phone_number = NULL,
+			password = '',
+			disabled = TRUE,
+			sessions_revoked_time = date_trunc('second', NOW()),
+			delete_time = NOW(),
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			delete_time IS NULL
+	`,
+		authID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM recovery_codes WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM totp_factors WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM social_identities WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM email_changes WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM phone_number_changes WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM api_keys WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM rider_applications WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM access_role_assignments WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsSessionActive reports whether a token of the account issued at issueTime
+// is still valid. Tokens of disabled or deleted accounts, and tokens issued
+// before sessions were revoked, are not. Tokens carry the issue time in whole
+// seconds, so revocations are stored truncated to the second and a token
+// issued in the second of the revocation stays valid; otherwise a login
+// right after a role change would be rejected.
+func (s *storage) IsSessionActive(ctx context.Context, authID uuid.UUID, issueTime time.Time) (bool, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			NOT disabled AND
+			delete_time IS NULL AND
+			(sessions_revoked_time IS NULL OR to_timestamp($2) >= sessions_revoked_time)
+		FROM
+			credentials
+		WHERE
+			id = $1
+	`,
+		authID,
+		issueTime.Unix(),
+	)
+
+	var active bool
+	if err := row.Scan(&active); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return active, nil
+}
+
+// UpdateDisabledTx disables or enables the auth credential within the
+// transaction. Disabling revokes its sessions.
+func (s *storage) UpdateDisabledTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, disabled bool) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE credentials
+		SET
+			disabled = $2,
+			sessions_revoked_time = CASE WHEN $2 THEN date_trunc('second', NOW()) ELSE sessions_revoked_time END,
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			delete_time IS NULL
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		authID,
+		disabled,
+	)
+
+	return scanAuth(row)
+}
+
+// UpdateRoleTx changes the role of the auth credential within the
+// transaction. Sessions are revoked since tokens carry the role.
+func (s *storage) UpdateRoleTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, role dbRoles) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE credentials
+		SET
+			role = $2,
+			sessions_revoked_time = date_trunc('second', NOW()),
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			delete_time IS NULL
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		authID,
+		role,
+	)
+
+	return scanAuth(row)
+}
+
+// UpdatePassword replaces the hashed password of the auth credential and the
+// algorithm it was hashed with.
+func (s *storage) UpdatePassword(ctx context.Context, authID uuid.UUID, hashedPass, algorithm string) error {
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE credentials
+		SET
+			password = $2,
+			password_algorithm = $3,
+			update_time = NOW()
+		WHERE id = $1
+	`,
+		authID,
+		hashedPass,
+		algorithm,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetLoginThrottle returns the failed login state of the key. A key without
+// failed attempts returns a zero dbLoginThrottle.
+func (s *storage) GetLoginThrottle(ctx context.Context, key string) (*dbLoginThrottle, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			failed_count,
+			GREATEST(EXTRACT(EPOCH FROM locked_until - NOW()), 0),
+			EXTRACT(EPOCH FROM NOW() - last_failed_time)
+		FROM
+			login_attempts
+		WHERE
+			attempt_key = $1
+	`,
+		key)
+
+	var (
+		throttle         dbLoginThrottle
+		lockedFor, since *float64
+	)
+	if err := row.Scan(&throttle.FailedCount, &lockedFor, &since); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &dbLoginThrottle{}, nil
+		}
+		return nil, err
+	}
+
+	throttle.LockedFor = secondsToDuration(lockedFor)
+	throttle.SinceLastFailure = secondsToDuration(since)
+	return &throttle, nil
+}
+
+// RecordLoginFailure counts a failed login for the key and locks it for lockFor
+// once maxFailures is reached. Counting restarts when the previous lock has
+// expired or the last failure is older than window.
+func (s *storage) RecordLoginFailure(ctx context.Context, key string, maxFailures int, lockFor, window time.Duration) (*dbLoginThrottle, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		INSERT INTO login_attempts AS a(
+			attempt_key,
+			failed_count,
+			last_failed_time
+		)VALUES(
+			$1, 1, NOW()
+		)
+		ON CONFLICT (attempt_key) DO UPDATE SET
+			failed_count = CASE
+				WHEN a.locked_until <= NOW() OR a.last_failed_time < NOW() - make_interval(secs => $4)
+				THEN 1
+				ELSE a.failed_count + 1
+			END,
+			locked_until = CASE
+				WHEN a.locked_until > NOW()
+				THEN a.locked_until
+				WHEN a.locked_until IS NULL AND a.last_failed_time >= NOW() - make_interval(secs => $4)
+					AND a.failed_count + 1 >= $2
+				THEN NOW() + make_interval(secs => $3)
+				ELSE NULL
+			END,
+			last_failed_time = NOW()
+		RETURNING
+			failed_count,
+			GREATEST(EXTRACT(EPOCH FROM locked_until - NOW()), 0)
+	`,
+		key,
+		maxFailures,
+		lockFor.Seconds(),
+		window.Seconds(),
+	)
+
+	var (
+		throttle  dbLoginThrottle
+		lockedFor *float64
+	)
+	if err := row.Scan(&throttle.FailedCount, &lockedFor); err != nil {
+		return nil, err
+	}
+
+	throttle.LockedFor = secondsToDuration(lockedFor)
+	return &throttle, nil
+}
+
+// ResetLoginThrottle clears failed login attempts of the key.
+func (s *storage) ResetLoginThrottle(ctx context.Context, key string) error {
+
+	if _, err := s.pool.Exec(ctx, `DELETE FROM login_attempts WHERE attempt_key=$1`, key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *storage) CreateSecurityEvent(ctx context.Context, event *dbNewSecurityEvent) error {
+
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO security_events(
+			auth_id,
+			event_type,
+			ip_address,
+			user_agent
+		)VALUES(
+			$1,$2,NULLIF($3,''),NULLIF($4,'')
+		)
+	`,
+		event.AuthID,
+		event.EventType,
+		event.IPAddress,
+		event.UserAgent,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListSecurityEvents lists security events matching the filter, newest first.
+func (s *storage) ListSecurityEvents(ctx context.Context, filter *dbSecurityEventFilter) ([]*dbSecurityEvent, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			id,
+			auth_id,
+			event_type,
+			ip_address,
+			user_agent,
+			create_time
+		FROM
+			security_events
+		WHERE
+			($1::uuid IS NULL OR auth_id = $1) AND
+			($2 = 0 OR event_type = $2) AND
+			($3::timestamp IS NULL OR (create_time, id) < ($3, $4::uuid))
+		ORDER BY
+			create_time DESC, id DESC
+		LIMIT $5
+	`,
+		filter.AuthID,
+		filter.EventType,
+		filter.BeforeTime,
+		nullIfEmpty(filter.BeforeID),
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*dbSecurityEvent
+	for rows.Next() {
+		var event dbSecurityEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.AuthID,
+			&event.EventType,
+			&event.IPAddress,
+			&event.UserAgent,
+			&event.CreateTime,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return events, nil
+}
+
+// GetTOTPFactor returns the TOTP factor of the account.
+func (s *storage) GetTOTPFactor(ctx context.Context, authID uuid.UUID) (*dbTOTPFactor, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			auth_id,
+			secret,
+			confirmed,
+			last_used_step
+		FROM
+			totp_factors
+		WHERE
+			auth_id = $1
+	`,
+		authID)
+
+	var factor dbTOTPFactor
+	if err := row.Scan(
+		&factor.AuthID,
+		&factor.Secret,
+		&factor.Confirmed,
+		&factor.LastUsedStep,
+	); err != nil {
+		return nil, err
+	}
+
+	return &factor, nil
+}
+
+// SaveTOTPSecret stores a new unconfirmed TOTP secret for the account. It
+// replaces a previous unconfirmed secret and returns ErrDuplicate when the
+// account already has a confirmed factor.
+func (s *storage) SaveTOTPSecret(ctx context.Context, authID uuid.UUID, secret string) error {
+
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO totp_factors AS f(
+			auth_id,
+			secret
+		)VALUES(
+			$1,$2
+		)
+		ON CONFLICT (auth_id) DO UPDATE SET
+			secret = EXCLUDED.secret,
+			last_used_step = 0,
+			update_time = NOW()
+		WHERE
+			f.confirmed = FALSE
+	`,
+		authID,
+		secret,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrDuplicate
+	}
+
+	return nil
+}
+
+// ConfirmTOTPFactor enables the TOTP factor of the account and replaces its
+// recovery codes. step is the time step of the code used to confirm.
+func (s *storage) ConfirmTOTPFactor(ctx context.Context, authID uuid.UUID, step int64, codeHashes []string) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE totp_factors
+		SET
+			confirmed = TRUE,
+			last_used_step = $2,
+			update_time = NOW()
+		WHERE
+			auth_id = $1 AND
+			confirmed = FALSE
+	`,
+		authID,
+		step,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM recovery_codes WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO recovery_codes(
+				auth_id,
+				code_hash
+			)VALUES(
+				$1,$2
+			)
+		`,
+			authID,
+			hash,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UseTOTPStep marks the time step as used. It returns false when a code of
+// the same or a later step was already accepted.
+func (s *storage) UseTOTPStep(ctx context.Context, authID uuid.UUID, step int64) (bool, error) {
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE totp_factors
+		SET
+			last_used_step = $2,
+			update_time = NOW()
+		WHERE
+			auth_id = $1 AND
+			confirmed = TRUE AND
+			last_used_step < $2
+	`,
+		authID,
+		step,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UseRecoveryCode marks an unused recovery code as used. It returns false when
+// the code does not exist or was already used.
+func (s *storage) UseRecoveryCode(ctx context.Context, authID uuid.UUID, codeHash string) (bool, error) {
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE recovery_codes
+		SET
+			used_time = NOW()
+		WHERE
+			auth_id = $1 AND
+			code_hash = $2 AND
+			used_time IS NULL
+	`,
+		authID,
+		codeHash,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// CreateSocialLoginState stores a pending social login that expires after
+// ttl. Expired logins are removed at the same time.
+func (s *storage) CreateSocialLoginState(ctx context.Context, state *dbSocialLoginState, ttl time.Duration) error {
+
+	if _, err := s.pool.Exec(ctx, `DELETE FROM social_login_states WHERE expire_time < NOW()`); err != nil {
+		return err
+	}
+
+	if _, err := s.pool.Exec(ctx, `
+		INSERT INTO social_login_states(
+			state,
+			provider,
+			code_verifier,
+			nonce,
+			expire_time
+		)VALUES(
+			$1,$2,$3,$4,NOW() + make_interval(secs => $5)
+		)
+	`,
+		state.State,
+		state.Provider,
+		state.CodeVerifier,
+		state.Nonce,
+		ttl.Seconds(),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeSocialLoginState deletes and returns the unexpired login, so that
+// a state can only be used once.
+func (s *storage) ConsumeSocialLoginState(ctx context.Context, state, provider string) (*dbSocialLoginState, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		DELETE FROM social_login_states
+		WHERE
+			state = $1 AND
+			provider = $2 AND
+			expire_time > NOW()
+		RETURNING
+			state,
+			provider,
+			code_verifier,
+			nonce
+	`,
+		state,
+		provider,
+	)
+
+	var login dbSocialLoginState
+	if err := row.Scan(
+		&login.State,
+		&login.Provider,
+		&login.CodeVerifier,
+		&login.Nonce,
+	); err != nil {
+		return nil, err
+	}
+
+	return &login, nil
+}
+
+// GetSocialIdentity returns the link of the provider user to an account.
+func (s *storage) GetSocialIdentity(ctx context.Context, provider, subject string) (*dbSocialIdentity, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			provider,
+			subject,
+			auth_id,
+			email
+		FROM
+			social_identities
+		WHERE
+			provider = $1 AND
+			subject = $2
+	`,
+		provider,
+		subject,
+	)
+
+	var identity dbSocialIdentity
+	var email *string
+	if err := row.Scan(
+		&identity.Provider,
+		&identity.Subject,
+		&identity.AuthID,
+		&email,
+	); err != nil {
+		return nil, err
+	}
+
+	if email != nil {
+		identity.Email = *email
+	}
+
+	return &identity, nil
+}
+
+// ListSocialIdentities lists the provider users linked to the account,
+// oldest first.
+func (s *storage) ListSocialIdentities(ctx context.Context, authID uuid.UUID) ([]*dbSocialIdentity, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			provider,
+			subject,
+			auth_id,
+			email,
+			create_time
+		FROM
+			social_identities
+		WHERE
+			auth_id = $1
+		ORDER BY
+			create_time
+	`,
+		authID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var identities []*dbSocialIdentity
+	for rows.Next() {
+		var identity dbSocialIdentity
+		var email *string
+		if err := rows.Scan(
+			&identity.Provider,
+			&identity.Subject,
+			&identity.AuthID,
+			&email,
+			&identity.CreateTime,
+		); err != nil {
+			return nil, err
+		}
+		if email != nil {
+			identity.Email = *email
+		}
+		identities = append(identities, &identity)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return identities, nil
+}
+
+// LinkSocialIdentityTx links the provider user to an account within the
+// transaction. It returns ErrDuplicate when the user is already linked.
+func (s *storage) LinkSocialIdentityTx(ctx context.Context, tx pgx.Tx, identity *dbSocialIdentity) error {
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO social_identities(
+			provider,
+			subject,
+			auth_id,
+			email
+		)VALUES(
+			$1,$2,$3,$4
+		)
+	`,
+		identity.Provider,
+		identity.Subject,
+		identity.AuthID,
+		nullIfEmpty(identity.Email),
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrDuplicate
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateEmailTx changes the email of the auth credential within the
+// transaction. It returns ErrDuplicate when the email is taken.
+func (s *storage) UpdateEmailTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, email string) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE credentials
+		SET
+			email = $2,
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			delete_time IS NULL
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		authID,
+		email,
+	)
+
+	auth, err := scanAuth(row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// CreateGuestTx creates a guest customer with the phone number within the
+// transaction. Guests have a placeholder email and no password. It returns
+// ErrDuplicate when the number is taken.
+func (s *storage) CreateGuestTx(ctx context.Context, tx pgx.Tx, phoneNumber string) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		INSERT INTO credentials(
+			id,
+			email,
+			password,
+			role,
+			phone_number,
+			guest
+		)
+		SELECT
+			g.id,
+			g.id::text || '@guest.invalid',
+			'',
+			$1,
+			$2,
+			TRUE
+		FROM
+			(SELECT gen_random_uuid() AS id) g
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		Roles_CUSTOMER,
+		phoneNumber,
+	)
+
+	auth, err := scanAuth(row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// UpgradeGuestTx sets the email and password of a guest within the
+// transaction, making it a full account. It returns pgx.ErrNoRows when the
+// account is not a guest and ErrDuplicate when the email is taken.
+func (s *storage) UpgradeGuestTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, email, hashedPass, algorithm string) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE credentials
+		SET
+			email = $2,
+			password = $3,
+			password_algorithm = $4,
+			guest = FALSE,
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			guest = TRUE AND
+			delete_time IS NULL
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		authID,
+		email,
+		hashedPass,
+		algorithm,
+	)
+
+	auth, err := scanAuth(row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// UpdatePhoneNumberTx changes the phone number of the auth credential within
+// the transaction. It returns ErrDuplicate when the number is taken.
+func (s *storage) UpdatePhoneNumberTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, phoneNumber string) (*dbAuthCredentials, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE credentials
+		SET
+			phone_number = $2,
+			update_time = NOW()
+		WHERE
+			id = $1 AND
+			delete_time IS NULL
+		RETURNING
+			id,
+			email,
+			password,
+			password_algorithm,
+			role,
+			phone_number,
+			create_time,
+			update_time,
+			disabled,
+			guest
+	`,
+		authID,
+		phoneNumber,
+	)
+
+	auth, err := scanAuth(row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// SaveEmailChange stores the email change of the account, replacing an
+// earlier one. It returns false when the earlier one was made within
+// resendInterval.
+func (s *storage) SaveEmailChange(ctx context.Context, change *dbEmailChange, ttl, resendInterval time.Duration) (bool, error) {
+
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO email_changes AS c(
+			auth_id,
+			new_email,
+			code_hash,
+			expire_time
+		)VALUES(
+			$1,$2,$3,NOW() + make_interval(secs => $4)
+		)
+		ON CONFLICT (auth_id) DO UPDATE SET
+			new_email = EXCLUDED.new_email,
+			code_hash = EXCLUDED.code_hash,
+			attempts = 0,
+			expire_time = EXCLUDED.expire_time,
+			create_time = NOW()
+		WHERE
+			c.create_time < NOW() - make_interval(secs => $5)
+	`,
+		change.AuthID,
+		change.NewEmail,
+		change.CodeHash,
+		ttl.Seconds(),
+		resendInterval.Seconds(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UseEmailChange removes and returns the unexpired email change of the
+// account if the code matches. A mismatch counts as an attempt, and the
+// change is removed after maxAttempts. It returns pgx.ErrNoRows when there
+// is no change or the code does not match.
+func (s *storage) UseEmailChange(ctx context.Context, authID uuid.UUID, codeHash string, maxAttempts int) (*dbEmailChange, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `
+		SELECT
+			auth_id,
+			new_email,
+			code_hash
+		FROM
+			email_changes
+		WHERE
+			auth_id = $1 AND
+			expire_time > NOW()
+		FOR UPDATE
+	`,
+		authID,
+	)
+
+	var change dbEmailChange
+	if err := row.Scan(&change.AuthID, &change.NewEmail, &change.CodeHash); err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(change.CodeHash), []byte(codeHash)) == 1 {
+		if _, err := tx.Exec(ctx, `DELETE FROM email_changes WHERE auth_id=$1`, authID); err != nil {
+			return nil, err
+		}
+		return &change, tx.Commit(ctx)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE email_changes
+		SET
+			attempts = attempts + 1
+		WHERE
+			auth_id = $1
+	`,
+		authID,
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM email_changes
+		WHERE
+			auth_id = $1 AND
+			attempts >= $2
+	`,
+		authID,
+		maxAttempts,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return nil, pgx.ErrNoRows
+}
+
+// SavePhoneNumberChange stores the phone number change of the account, replacing an
+// earlier one. It returns false when the earlier one was made within
+// resendInterval.
+func (s *storage) SavePhoneNumberChange(ctx context.Context, change *dbPhoneNumberChange, ttl, resendInterval time.Duration) (bool, error) {
+
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO phone_number_changes AS c(
+			auth_id,
+			new_phone_number,
+			code_hash,
+			expire_time
+		)VALUES(
+			$1,$2,$3,NOW() + make_interval(secs => $4)
+		)
+		ON CONFLICT (auth_id) DO UPDATE SET
+			new_phone_number = EXCLUDED.new_phone_number,
+			code_hash = EXCLUDED.code_hash,
+			attempts = 0,
+			expire_time = EXCLUDED.expire_time,
+			create_time = NOW()
+		WHERE
+			c.create_time < NOW() - make_interval(secs => $5)
+	`,
+		change.AuthID,
+		change.NewPhoneNumber,
+		change.CodeHash,
+		ttl.Seconds(),
+		resendInterval.Seconds(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UsePhoneNumberChange removes and returns the unexpired phone number change of the
+// account if the code matches. A mismatch counts as an attempt, and the
+// change is removed after maxAttempts. It returns pgx.ErrNoRows when there
+// is no change or the code does not match.
+func (s *storage) UsePhoneNumberChange(ctx context.Context, authID uuid.UUID, codeHash string, maxAttempts int) (*dbPhoneNumberChange, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `
+		SELECT
+			auth_id,
+			new_phone_number,
+			code_hash
+		FROM
+			phone_number_changes
+		WHERE
+			auth_id = $1 AND
+			expire_time > NOW()
+		FOR UPDATE
+	`,
+		authID,
+	)
+
+	var change dbPhoneNumberChange
+	if err := row.Scan(&change.AuthID, &change.NewPhoneNumber, &change.CodeHash); err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(change.CodeHash), []byte(codeHash)) == 1 {
+		if _, err := tx.Exec(ctx, `DELETE FROM phone_number_changes WHERE auth_id=$1`, authID); err != nil {
+			return nil, err
+		}
+		return &change, tx.Commit(ctx)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE phone_number_changes
+		SET
+			attempts = attempts + 1
+		WHERE
+			auth_id = $1
+	`,
+		authID,
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM phone_number_changes
+		WHERE
+			auth_id = $1 AND
+			attempts >= $2
+	`,
+		authID,
+		maxAttempts,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return nil, pgx.ErrNoRows
+}
+
+// GetPhoneLoginQuota counts the login codes sent to the phone number within
+// the window.
+func (s *storage) GetPhoneLoginQuota(ctx context.Context, phoneNumber string, window time.Duration) (*dbPhoneLoginQuota, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			COUNT(*),
+			EXTRACT(EPOCH FROM NOW() - MAX(create_time))::float8
+		FROM
+			phone_login_codes
+		WHERE
+			phone_number = $1 AND
+			create_time > NOW() - make_interval(secs => $2)
+	`,
+		phoneNumber,
+		window.Seconds(),
+	)
+
+	var (
+		quota         dbPhoneLoginQuota
+		sinceLastSent *float64
+	)
+	if err := row.Scan(&quota.SentCount, &sinceLastSent); err != nil {
+		return nil, err
+	}
+	quota.SinceLastSent = secondsToDuration(sinceLastSent)
+
+	return &quota, nil
+}
+
+// CreatePhoneLoginCode stores a login code that expires after ttl. Codes
+// older than a day are removed at the same time.
+func (s *storage) CreatePhoneLoginCode(ctx context.Context, phoneNumber, codeHash string, ttl time.Duration) error {
+
+	if _, err := s.pool.Exec(ctx, `
+		DELETE FROM phone_login_codes WHERE create_time < NOW() - INTERVAL '1 day'
+	`); err != nil {
+		return err
+	}
+
+	if _, err := s.pool.Exec(ctx, `
+		INSERT INTO phone_login_codes(
+			phone_number,
+			code_hash,
+			expire_time
+		)VALUES(
+			$1,$2,NOW() + make_interval(secs => $3)
+		)
+	`,
+		phoneNumber,
+		codeHash,
+		ttl.Seconds(),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UsePhoneLoginCode checks the code against the latest unexpired code of the
+// phone number. A match invalidates every code of the number. A mismatch
+// counts as an attempt, and the code is invalidated after maxAttempts.
+func (s *storage) UsePhoneLoginCode(ctx context.Context, phoneNumber, codeHash string, maxAttempts int) (bool, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `
+		SELECT
+			id,
+			code_hash
+		FROM
+			phone_login_codes
+		WHERE
+			phone_number = $1 AND
+			used_time IS NULL AND
+			expire_time > NOW()
+		ORDER BY
+			create_time DESC
+		LIMIT 1
+		FOR UPDATE
+	`,
+		phoneNumber,
+	)
+
+	var (
+		id         string
+		storedHash string
+	)
+	if err := row.Scan(&id, &storedHash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(storedHash), []byte(codeHash)) == 1 {
+		if _, err := tx.Exec(ctx, `
+			UPDATE phone_login_codes
+			SET
+				used_time = NOW()
+			WHERE
+				phone_number = $1 AND
+				used_time IS NULL
+		`,
+			phoneNumber,
+		); err != nil {
+			return false, err
+		}
+		return true, tx.Commit(ctx)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE phone_login_codes
+		SET
+			attempts = attempts + 1,
+			used_time = CASE WHEN attempts + 1 >= $2 THEN NOW() END
+		WHERE
+			id = $1
+	`,
+		id,
+		maxAttempts,
+	); err != nil {
+		return false, err
+	}
+
+	return false, tx.Commit(ctx)
+}
+
+// CreateAPIKey stores the hash of a new API key.
+func (s *storage) CreateAPIKey(ctx context.Context, newKey *dbNewAPIKey) (*dbAPIKey, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		INSERT INTO api_keys(
+			auth_id,
+			name,
+			prefix,
+			key_hash,
+			scopes,
+			expire_time
+		)VALUES(
+			$1,$2,$3,$4,$5,$6
+		)
+		RETURNING
+			id,
+			auth_id,
+			name,
+			prefix,
+			scopes,
+			expire_time,
+			last_used_time,
+			revoke_time,
+			create_time
+	`,
+		newKey.AuthID,
+		newKey.Name,
+		newKey.Prefix,
+		newKey.KeyHash,
+		newKey.Scopes,
+		newKey.ExpireTime,
+	)
+
+	return scanAPIKey(row)
+}
+
+// ListAPIKeys lists the API keys of the account, newest first.
+func (s *storage) ListAPIKeys(ctx context.Context, authID uuid.UUID) ([]*dbAPIKey, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			id,
+			auth_id,
+			name,
+			prefix,
+			scopes,
+			expire_time,
+			last_used_time,
+			revoke_time,
+			create_time
+		FROM
+			api_keys
+		WHERE
+			auth_id = $1
+		ORDER BY
+			create_time DESC
+	`,
+		authID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*dbAPIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes the API key of the account. It returns pgx.ErrNoRows
+// when the key does not exist or was already revoked.
+func (s *storage) RevokeAPIKey(ctx context.Context, authID, keyID uuid.UUID) (*dbAPIKey, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		UPDATE api_keys
+		SET
+			revoke_time = NOW()
+		WHERE
+			id = $2 AND
+			auth_id = $1 AND
+			revoke_time IS NULL
+		RETURNING
+			id,
+			auth_id,
+			name,
+			prefix,
+			scopes,
+			expire_time,
+			last_used_time,
+			revoke_time,
+			create_time
+	`,
+		authID,
+		keyID,
+	)
+
+	return scanAPIKey(row)
+}
+
+// UseAPIKey returns the account of an unexpired and unrevoked key and records
+// its use. Keys stop working when the account is disabled, deleted or no
+// longer has the role. It returns pgx.ErrNoRows for such keys.
+func (s *storage) UseAPIKey(ctx context.Context, keyHash string, role dbRoles) (*dbAPIKeyPrincipal, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		UPDATE api_keys k
+		SET
+			last_used_time = NOW()
+		FROM
+			credentials c
+		WHERE
+			k.key_hash = $1 AND
+			k.revoke_time IS NULL AND
+			k.expire_time > NOW() AND
+			c.id = k.auth_id AND
+			c.role = $2 AND
+			c.disabled = FALSE AND
+			c.delete_time IS NULL
+		RETURNING
+			k.id,
+			k.auth_id,
+			c.role,
+			k.scopes
+	`,
+		keyHash,
+		role,
+	)
+
+	var principal dbAPIKeyPrincipal
+	if err := row.Scan(
+		&principal.KeyID,
+		&principal.AuthID,
+		&principal.Role,
+		&principal.Scopes,
+	); err != nil {
+		return nil, err
+	}
+
+	return &principal, nil
+}
+
+// CreateRiderApplicationTx starts the onboarding of a rider within the
+// transaction. An existing application is kept and returned, so a rider
+// approved before keeps the approval.
+func (s *storage) CreateRiderApplicationTx(ctx context.Context, tx pgx.Tx, authID string) (*dbRiderApplication, error) {
+
+	row := tx.QueryRow(ctx, `
+		INSERT INTO rider_applications AS a(
+			auth_id
+		)VALUES(
+			$1
+		)
+		ON CONFLICT (auth_id) DO UPDATE SET
+			update_time = a.update_time
+		RETURNING
+			auth_id,
+			status,
+			licence_number,
+			licence_expire_time,
+			vehicle_type,
+			vehicle_plate,
+			submit_time,
+			review_time,
+			reject_reason,
+			create_time,
+			update_time
+	`,
+		authID,
+	)
+
+	return scanRiderApplication(row)
+}
+
+func (s *storage) GetRiderApplication(ctx context.Context, authID uuid.UUID) (*dbRiderApplication, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		SELECT
+			auth_id,
+			status,
+			licence_number,
+			licence_expire_time,
+			vehicle_type,
+			vehicle_plate,
+			submit_time,
+			review_time,
+			reject_reason,
+			create_time,
+			update_time
+		FROM
+			rider_applications
+		WHERE
+			auth_id = $1
+	`,
+		authID,
+	)
+
+	return scanRiderApplication(row)
+}
+
+// ListRiderApplications lists applications matching the filter, newest first.
+func (s *storage) ListRiderApplications(ctx context.Context, filter *dbRiderApplicationFilter) ([]*dbRiderApplication, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			auth_id,
+			status,
+			licence_number,
+			licence_expire_time,
+			vehicle_type,
+			vehicle_plate,
+			submit_time,
+			review_time,
+			reject_reason,
+			create_time,
+			update_time
+		FROM
+			rider_applications
+		WHERE
+			($1 = 0 OR status = $1) AND
+			($2::timestamp IS NULL OR (create_time, auth_id) < ($2, $3::uuid))
+		ORDER BY
+			create_time DESC, auth_id DESC
+		LIMIT $4
+	`,
+		filter.Status,
+		filter.BeforeTime,
+		nullIfEmpty(filter.BeforeID),
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applications []*dbRiderApplication
+	for rows.Next() {
+		application, err := scanRiderApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, application)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return applications, nil
+}
+
+// SubmitRiderDocuments stores the documents of a pending or rejected rider
+// and puts the application back to pending. It returns pgx.ErrNoRows when
+// the rider has no such application.
+func (s *storage) SubmitRiderDocuments(ctx context.Context, authID uuid.UUID, docs *dbRiderDocuments) (*dbRiderApplication, error) {
+
+	row := s.pool.QueryRow(ctx, `
+		UPDATE rider_applications
+		SET
+			status = $2,
+			licence_number = $3,
+			licence_expire_time = $4,
+			vehicle_type = $5,
+			vehicle_plate = NULLIF($6, ''),
+			submit_time = NOW(),
+			review_time = NULL,
+			reviewer_id = NULL,
+			reject_reason = NULL,
+			update_time = NOW()
+		WHERE
+			auth_id = $1 AND
+			status IN ($2, $7)
+		RETURNING
+			auth_id,
+			status,
+			licence_number,
+			licence_expire_time,
+			vehicle_type,
+			vehicle_plate,
+			submit_time,
+			review_time,
+			reject_reason,
+			create_time,
+			update_time
+	`,
+		authID,
+		RiderApplication_PENDING,
+		docs.LicenceNumber,
+		docs.LicenceExpireTime,
+		docs.VehicleType,
+		docs.VehiclePlate,
+		RiderApplication_REJECTED,
+	)
+
+	return scanRiderApplication(row)
+}
+
+// ReviewRiderApplicationTx approves or rejects a pending application with
+// submitted documents within the transaction. It returns pgx.ErrNoRows when
+// the application is not waiting for review.
+func (s *storage) ReviewRiderApplicationTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID, status dbRiderApplicationStatus, reviewerID, reason string) (*dbRiderApplication, error) {
+
+	row := tx.QueryRow(ctx, `
+		UPDATE rider_applications
+		SET
+			status = $2,
+			reviewer_id = $3,
+			reject_reason = NULLIF($4, ''),
+			review_time = NOW(),
+			update_time = NOW()
+		WHERE
+			auth_id = $1 AND
+			status = $5 AND
+			submit_time IS NOT NULL
+		RETURNING
+			auth_id,
+			status,
+			licence_number,
+			licence_expire_time,
+			vehicle_type,
+			vehicle_plate,
+			submit_time,
+			review_time,
+			reject_reason,
+			create_time,
+			update_time
+	`,
+		authID,
+		status,
+		reviewerID,
+		reason,
+		RiderApplication_PENDING,
+	)
+
+	return scanRiderApplication(row)
+}
+
+func (s *storage) ListPermissions(ctx context.Context) ([]*dbPermission, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			name,
+			description
+		FROM
+			permissions
+		ORDER BY
+			name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []*dbPermission
+	for rows.Next() {
+		var permission dbPermission
+		if err := rows.Scan(&permission.Name, &permission.Description); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, &permission)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return permissions, nil
+}
+
+// accessRoleQuery selects access roles with their permissions, filtered by
+// name unless $1 is NULL.
+const accessRoleQuery = `
+	SELECT
+		r.name,
+		r.description,
+		COALESCE(array_agg(p.permission_name ORDER BY p.permission_name)
+			FILTER (WHERE p.permission_name IS NOT NULL), '{}'),
+		r.builtin,
+		r.create_time,
+		r.update_time
+	FROM
+		access_roles r
+		LEFT JOIN access_role_permissions p ON p.role_name = r.name
+	WHERE
+		($1::text IS NULL OR r.name = $1)
+	GROUP BY
+		r.name
+	ORDER BY
+		r.name
+`
+
+func (s *storage) ListAccessRoles(ctx context.Context) ([]*dbAccessRole, error) {
+
+	rows, err := s.pool.Query(ctx, accessRoleQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*dbAccessRole
+	for rows.Next() {
+		role, err := scanAccessRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return roles, nil
+}
+
+func (s *storage) GetAccessRole(ctx context.Context, name string) (*dbAccessRole, error) {
+	return scanAccessRole(s.pool.QueryRow(ctx, accessRoleQuery, name))
+}
+
+// PutAccessRole creates the access role or replaces its description and
+// permissions, and revokes the sessions of the accounts holding it. It
+// returns pgx.ErrNoRows for built-in roles.
+func (s *storage) PutAccessRole(ctx context.Context, role *dbAccessRole) (*dbAccessRole, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var name string
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO access_roles(
+			name,
+			description
+		)VALUES(
+			$1,$2
+		)
+		ON CONFLICT (name) DO UPDATE
+		SET
+			description = EXCLUDED.description,
+			update_time = NOW()
+		WHERE
+			access_roles.builtin = FALSE
+		RETURNING
+			name
+	`,
+		role.Name,
+		role.Description,
+	).Scan(&name); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM access_role_permissions WHERE role_name=$1`, name); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO access_role_permissions(
+			role_name,
+			permission_name
+		)
+		SELECT $1, unnest($2::text[])
+	`,
+		name,
+		role.Permissions,
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE credentials
+		SET
+			sessions_revoked_time = date_trunc('second', NOW())
+		WHERE
+			id IN (SELECT auth_id FROM access_role_assignments WHERE role_name = $1)
+	`,
+		name,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.GetAccessRole(ctx, name)
+}
+
+// DeleteAccessRole returns pgx.ErrNoRows for unknown or built-in roles and
+// ErrInUse when an account still holds the role.
+func (s *storage) DeleteAccessRole(ctx context.Context, name string) error {
+
+	tag, err := s.pool.Exec(ctx, `
+		DELETE FROM access_roles
+		WHERE
+			name = $1 AND
+			builtin = FALSE
+	`,
+		name,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return ErrInUse
+		}
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetAuthPermissions returns the access roles of the account and their
+// permissions, both empty for accounts without roles.
+func (s *storage) GetAuthPermissions(ctx context.Context, authID uuid.UUID) (*dbAuthPermissions, error) {
+
+	var perms dbAuthPermissions
+	if err := s.pool.QueryRow(ctx, `
+		SELECT
+			COALESCE(array_agg(DISTINCT a.role_name ORDER BY a.role_name), '{}'),
+			COALESCE(array_agg(DISTINCT p.permission_name ORDER BY p.permission_name)
+				FILTER (WHERE p.permission_name IS NOT NULL), '{}')
+		FROM
+			access_role_assignments a
+			LEFT JOIN access_role_permissions p ON p.role_name = a.role_name
+		WHERE
+			a.auth_id = $1
+	`,
+		authID,
+	).Scan(&perms.AccessRoles, &perms.Permissions); err != nil {
+		return nil, err
+	}
+
+	return &perms, nil
+}
+
+// AssignAccessRole gives the role to the account and revokes its sessions.
+// It returns false when the account holds the role already.
+func (s *storage) AssignAccessRole(ctx context.Context, authID uuid.UUID, name, grantedBy string) (bool, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO access_role_assignments(
+			auth_id,
+			role_name,
+			granted_by
+		)VALUES(
+			$1,$2,$3
+		)
+		ON CONFLICT (auth_id, role_name) DO NOTHING
+	`,
+		authID,
+		name,
+		nullIfEmpty(grantedBy),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if err := revokeSessionsTx(ctx, tx, authID); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// UnassignAccessRole takes the role from the account and revokes its
+// sessions. It returns false when the account does not hold the role.
+func (s *storage) UnassignAccessRole(ctx context.Context, authID uuid.UUID, name string) (bool, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		DELETE FROM access_role_assignments
+		WHERE
+			auth_id = $1 AND
+			role_name = $2
+	`,
+		authID,
+		name,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if err := revokeSessionsTx(ctx, tx, authID); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// SetAccessRolesTx replaces the access roles of the account within the
+// transaction. Sessions are not revoked, callers changing the role enum do it
+// already.
+func (s *storage) SetAccessRolesTx(ctx context.Context, tx pgx.Tx, authID string, names []string) error {
+
+	if _, err := tx.Exec(ctx, `DELETE FROM access_role_assignments WHERE auth_id=$1`, authID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO access_role_assignments(
+			auth_id,
+			role_name
+		)
+		SELECT $1, unnest($2::text[])
+	`,
+		authID,
+		names,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func revokeSessionsTx(ctx context.Context, tx pgx.Tx, authID uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE credentials
+		SET
+			sessions_revoked_time = date_trunc('second', NOW())
+		WHERE
+			id = $1
+	`,
+		authID,
+	)
+	return err
+}
+
+func secondsToDuration(secs *float64) time.Duration {
+	if secs == nil {
+		return 0
+	}
+	return time.Duration(*secs * float64(time.Second))
+}
+
+func scanAuth(row pgx.Row) (*dbAuthCredentials, error) {
+
+	var auth dbAuthCredentials
+	if err := row.Scan(
+		&auth.ID,
+		&auth.Email,
+		&auth.HashedPass,
+		&auth.PasswordAlgorithm,
+		&auth.Role,
+		&auth.PhoneNumber,
+		&auth.CreateTime,
+		&auth.UpdateTime,
+		&auth.Disabled,
+		&auth.Guest,
+	); err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+func scanAPIKey(row pgx.Row) (*dbAPIKey, error) {
+
+	var key dbAPIKey
+	if err := row.Scan(
+		&key.ID,
+		&key.AuthID,
+		&key.Name,
+		&key.Prefix,
+		&key.Scopes,
+		&key.ExpireTime,
+		&key.LastUsedTime,
+		&key.RevokeTime,
+		&key.CreateTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func scanRiderApplication(row pgx.Row) (*dbRiderApplication, error) {
+
+	var application dbRiderApplication
+	if err := row.Scan(
+		&application.AuthID,
+		&application.Status,
+		&application.LicenceNumber,
+		&application.LicenceExpireTime,
+		&application.VehicleType,
+		&application.VehiclePlate,
+		&application.SubmitTime,
+		&application.ReviewTime,
+		&application.RejectReason,
+		&application.CreateTime,
+		&application.UpdateTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+func scanAccessRole(row pgx.Row) (*dbAccessRole, error) {
+
+	var role dbAccessRole
+	if err := row.Scan(
+		&role.Name,
+		&role.Description,
+		&role.Permissions,
+		&role.Builtin,
+		&role.CreateTime,
+		&role.UpdateTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// escapeLike escapes the LIKE wildcards of a user supplied pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pongsathonn/ihavefood/pkg/migrate"
+	"github.com/pongsathonn/ihavefood/src/authservice/supabase/migrations"
+)
+
+// testStorage returns the storage of the database at AUTH_TEST_DB_URL,
+// migrated to the latest version. The tests using it are skipped when the
+// variable is not set.
+func testStorage(t *testing.T) *storage {
+	t.Helper()
+
+	url := os.Getenv("AUTH_TEST_DB_URL")
+	if url == "" {
+		t.Skip("AUTH_TEST_DB_URL is not set")
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	m, err := migrate.New(pool, migrations.FS, "authservice.schema_versions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewStorage(pool)
+}
+
+// testCredentials creates an account of the role with a new email.
+func testCredentials(t *testing.T, s *storage, role dbRoles) *dbAuthCredentials {
+	t.Helper()
+
+	auth, err := s.Create(context.Background(), &dbNewAuthCredentials{
+		Email:             uuid.NewString() + "@example.com",
+		HashedPass:        "not-a-hash",
+		PasswordAlgorithm: "argon2id",
+		Role:              role,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return auth
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// Test vectors from RFC 6238 Appendix B for SHA1, truncated to 8 digits.
+func TestTOTPCode(t *testing.T) {
+
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := totpCode(secret, totpStep(time.Unix(tt.unix, 0)), 8)
+		if err != nil {
+			t.Fatalf("totpCode(%d): %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("totpCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1_700_000_000, 0)
+	step := totpStep(now)
+
+	for _, s := range []int64{step - 1, step, step + 1} {
+		code, err := totpCode(secret, s, totpDigits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := verifyTOTP(secret, code, now)
+		if !ok || got != s {
+			t.Errorf("verifyTOTP step %d = (%d, %v), want (%d, true)", s, got, ok, s)
+		}
+	}
+
+	code, err := totpCode(secret, step+2, totpDigits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := verifyTOTP(secret, code, now); ok {
+		t.Error("verifyTOTP accepted a code outside the allowed skew")
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	if hashRecoveryCode("abcde-fghij") != hashRecoveryCode("ABCDE FGHIJ") {
+		t.Error("hashRecoveryCode should ignore case and separators")
+	}
+}
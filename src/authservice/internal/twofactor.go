@@ -0,0 +1,348 @@
+package internal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
+)
+
+// secondFactorTokenTTL is how long a login may stay pending between the
+// password step and the second factor step.
+const secondFactorTokenTTL = 5 * time.Minute
+
+const secondFactorAudience = "second-factor"
+
+// requiresSecondFactor reports whether the role cannot log in without a
+// second factor. It is the login policy only, use isAdmin to authorize.
+func requiresSecondFactor(role pb.Roles) bool {
+	return isAdmin(role)
+}
+
+// VerifySecondFactor completes a pending login with a TOTP code or a recovery
+// code and issues the access token. Failures count toward the account lockout.
+func (x *AuthService) VerifySecondFactor(ctx context.Context, in *pb.VerifySecondFactorRequest) (*pb.LoginResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to verify second factor: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	authID, _, err := parseSecondFactorToken(in.SecondFactorToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired second factor token")
+	}
+
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(authID.String())); err != nil {
+		return nil, err
+	}
+
+	switch factor := in.Factor.(type) {
+	case *pb.VerifySecondFactorRequest_TotpCode:
+		totp, err := x.store.GetTOTPFactor(ctx, authID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+			}
+			slog.Error("storage get totp factor", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		if !totp.Confirmed {
+			return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+		}
+
+		step, ok := verifyTOTP(totp.Secret, factor.TotpCode, time.Now())
+		if !ok {
+			x.recordSecondFactorFailure(ctx, authID.String())
+			return nil, status.Error(codes.Unauthenticated, "incorrect code")
+		}
+
+		used, err := x.store.UseTOTPStep(ctx, authID, step)
+		if err != nil {
+			slog.Error("storage use totp step", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		if !used {
+			x.recordSecondFactorFailure(ctx, authID.String())
+			return nil, status.Error(codes.Unauthenticated, "code has already been used")
+		}
+
+	case *pb.VerifySecondFactorRequest_RecoveryCode:
+		used, err := x.store.UseRecoveryCode(ctx, authID, hashRecoveryCode(factor.RecoveryCode))
+		if err != nil {
+			slog.Error("storage use recovery code", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		if !used {
+			x.recordSecondFactorFailure(ctx, authID.String())
+			return nil, status.Error(codes.Unauthenticated, "incorrect code")
+		}
+		id := authID.String()
+		x.recordSecurityEvent(ctx, &id, SecurityEvent_RECOVERY_CODE_USED)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "totp_code or recovery_code is required")
+	}
+
+	if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(authID.String())); err != nil {
+		slog.Error("storage reset login throttle", "err", err)
+	}
+
+	if err := x.completeSecondFactorLogin(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{}, nil
+}
+
+// completeSecondFactorLogin issues the access token of a pending login whose
+// second factor is verified. The account is loaded again because it may have
+// been disabled, deleted or given another role since the password step.
+func (x *AuthService) completeSecondFactorLogin(ctx context.Context, authID uuid.UUID) error {
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.Unauthenticated, "invalid or expired second factor token")
+		}
+		slog.Error("storage get auth", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+	if auth.Disabled {
+		return status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	if err := x.issueAccessToken(ctx, auth.ID, pb.Roles(auth.Role), auth.Guest); err != nil {
+		slog.Error("issue access token", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	return nil
+}
+
+// BeginTOTPEnrollment generates a new TOTP secret for the caller. An earlier
+// unconfirmed secret is replaced.
+func (x *AuthService) BeginTOTPEnrollment(ctx context.Context, in *pb.BeginTOTPEnrollmentRequest) (*pb.BeginTOTPEnrollmentResponse, error) {
+
+	authID, _, _, err := enrollmentIdentity(ctx, in.SecondFactorToken)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := x.store.GetAuth(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "auth credential not found")
+		}
+		slog.Error("storage get auth", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		slog.Error("generate totp secret", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if err := x.store.SaveTOTPSecret(ctx, authID, secret); err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return nil, status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+		}
+		slog.Error("storage save totp secret", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.BeginTOTPEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningUri: totpProvisioningURI(secret, auth.Email),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment enables TOTP once the caller proves the authenticator
+// app works and returns new recovery codes. Accounts enrolling during login
+// are logged in on success.
+func (x *AuthService) ConfirmTOTPEnrollment(ctx context.Context, in *pb.ConfirmTOTPEnrollmentRequest) (*pb.ConfirmTOTPEnrollmentResponse, error) {
+
+	if err := ValidateStruct(in); err != nil {
+		var ve myValidatorErrs
+		if errors.As(err, &ve) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to confirm enrollment: %s", ve.Error())
+		}
+		slog.Error("validate struct", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	authID, _, pending, err := enrollmentIdentity(ctx, in.SecondFactorToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.checkLoginThrottle(ctx, accountThrottleKey(authID.String())); err != nil {
+		return nil, err
+	}
+
+	totp, err := x.store.GetTOTPFactor(ctx, authID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "totp enrollment has not been started")
+		}
+		slog.Error("storage get totp factor", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if totp.Confirmed {
+		return nil, status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+	}
+
+	step, ok := verifyTOTP(totp.Secret, in.Code, time.Now())
+	if !ok {
+		x.recordSecondFactorFailure(ctx, authID.String())
+		return nil, status.Error(codes.Unauthenticated, "incorrect code")
+	}
+
+	recoveryCodes, err := newRecoveryCodes()
+	if err != nil {
+		slog.Error("generate recovery codes", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	var hashes []string
+	for _, code := range recoveryCodes {
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err := x.store.ConfirmTOTPFactor(ctx, authID, step, hashes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.AlreadyExists, "two-factor authentication is already enabled")
+		}
+		slog.Error("storage confirm totp factor", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	id := authID.String()
+	x.recordSecurityEvent(ctx, &id, SecurityEvent_SECOND_FACTOR_ENABLED)
+
+	if pending {
+		if err := x.store.ResetLoginThrottle(ctx, accountThrottleKey(id)); err != nil {
+			slog.Error("storage reset login throttle", "err", err)
+		}
+		if err := x.completeSecondFactorLogin(ctx, authID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ConfirmTOTPEnrollmentResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// secondFactorEnabled reports whether the account has a confirmed TOTP factor.
+func (x *AuthService) secondFactorEnabled(ctx context.Context, authID string) (bool, error) {
+
+	id, err := uuid.Parse(authID)
+	if err != nil {
+		return false, err
+	}
+
+	totp, err := x.store.GetTOTPFactor(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return totp.Confirmed, nil
+}
+
+// recordSecondFactorFailure counts a wrong second factor code toward the
+// account lockout.
+func (x *AuthService) recordSecondFactorFailure(ctx context.Context, authID string) {
+	x.recordSecurityEvent(ctx, &authID, SecurityEvent_SECOND_FACTOR_FAILURE)
+	x.recordAccountFailure(ctx, authID)
+}
+
+// enrollmentIdentity returns the account enrolling a factor. A pending login
+// is identified by its second factor token, otherwise the caller must be
+// signed in.
+func enrollmentIdentity(ctx context.Context, token string) (authID uuid.UUID, role pb.Roles, pending bool, err error) {
+
+	if token != "" {
+		authID, role, err := parseSecondFactorToken(token)
+		if err != nil {
+			return uuid.Nil, 0, false, status.Error(codes.Unauthenticated, "invalid or expired second factor token")
+		}
+		return authID, role, true, nil
+	}
+
+	callerID, role, ok := callerFromContext(ctx)
+	if !ok {
+		return uuid.Nil, 0, false, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	authID, err = uuid.Parse(callerID)
+	if err != nil {
+		return uuid.Nil, 0, false, status.Error(codes.Unauthenticated, "invalid caller identity")
+	}
+
+	return authID, role, false, nil
+}
+
+// secondFactorKey derives the key of second factor tokens from the JWT
+// signing key. Using a separate key keeps the api-gateway from accepting a
+// second factor token as an access token.
+func secondFactorKey() []byte {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(secondFactorAudience))
+	return mac.Sum(nil)
+}
+
+func createSecondFactorToken(authID string, role pb.Roles) (string, error) {
+
+	now := time.Now()
+	claims := &AuthClaims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   authID,
+			Issuer:    "auth service",
+			Audience:  jwt.ClaimStrings{secondFactorAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(secondFactorTokenTTL)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secondFactorKey())
+}
+
+func parseSecondFactorToken(token string) (uuid.UUID, pb.Roles, error) {
+
+	var claims AuthClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		return secondFactorKey(), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(secondFactorAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	authID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	return authID, claims.Role, nil
+}
@@ -10,37 +10,263 @@ import (
 
 type AuthClaims struct {
 	Role pb.Roles `json:"role"`
+	// Permissions are the effective permissions of the account when the token
+	// was issued. Changing them revokes the session.
+	Permissions []string `json:"perms,omitempty"`
+	// Guest limits the token to browsing and placing and tracking orders.
+	Guest bool `json:"guest,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // dbNewAuthCredential contains information to create
 // both new user credential and admin
 type dbNewAuthCredentials struct {
-	Email       string
-	HashedPass  string
-	PhoneNumber *string
-	Role        dbRoles
+	Email             string
+	HashedPass        string
+	PasswordAlgorithm string
+	PhoneNumber       *string
+	Role              dbRoles
 }
 
 type dbAuthCredentials struct {
-	ID          string
-	Email       string
-	HashedPass  string
-	Role        dbRoles
-	PhoneNumber *string
-	CreateTime  time.Time
-	UpdateTime  time.Time
+	ID                string
+	Email             string
+	HashedPass        string
+	PasswordAlgorithm string
+	Role              dbRoles
+	PhoneNumber       *string
+	CreateTime        time.Time
+	UpdateTime        time.Time
+	Disabled          bool
+	// Guest accounts are customers known only by a verified phone number.
+	Guest bool
+}
+
+// dbAuthFilter selects credentials older than the cursor.
+type dbAuthFilter struct {
+	// Query is a prefix of the email or phone number.
+	Query        string
+	Role         dbRoles
+	DisabledOnly bool
+	BeforeTime   *time.Time
+	BeforeID     string
+	Limit        int
 }
 
+// dbRoles mirrors pb.Roles numbering so the stored role can be
+// converted to and from the proto enum directly.
 type dbRoles int32
 
 const (
 	Roles_UNKNOWN  dbRoles = 0
 	Roles_CUSTOMER dbRoles = 1
-	Roles_MERCHANT dbRoles = 2
-	Roles_RIDER    dbRoles = 3
+	Roles_RIDER    dbRoles = 2
+	Roles_MERCHANT dbRoles = 3
 
 	// For simplicity, admin roles are included in this enum.
 	Roles_SUPER_ADMIN dbRoles = 20
 	Roles_ADMIN       dbRoles = 21
 )
+
+// dbLoginThrottle is the failed login state of an account or an IP address.
+type dbLoginThrottle struct {
+	FailedCount int
+	// LockedFor is the remaining lockout, zero when not locked.
+	LockedFor time.Duration
+	// SinceLastFailure is the time elapsed since the last failed attempt.
+	SinceLastFailure time.Duration
+}
+
+type dbNewSecurityEvent struct {
+	AuthID    *string
+	EventType dbSecurityEventType
+	IPAddress string
+	UserAgent string
+}
+
+type dbSecurityEvent struct {
+	ID         string
+	AuthID     *string
+	EventType  dbSecurityEventType
+	IPAddress  *string
+	UserAgent  *string
+	CreateTime time.Time
+}
+
+// dbSecurityEventFilter selects security events older than the cursor.
+type dbSecurityEventFilter struct {
+	AuthID     *string
+	EventType  dbSecurityEventType
+	BeforeTime *time.Time
+	BeforeID   string
+	Limit      int
+}
+
+type dbSecurityEventType int16
+
+const (
+	SecurityEvent_UNKNOWN          dbSecurityEventType = 0
+	SecurityEvent_LOGIN_SUCCESS    dbSecurityEventType = 1
+	SecurityEvent_LOGIN_FAILURE    dbSecurityEventType = 2
+	SecurityEvent_ACCOUNT_LOCKED   dbSecurityEventType = 3
+	SecurityEvent_PASSWORD_CHANGED dbSecurityEventType = 4
+
+	SecurityEvent_SECOND_FACTOR_ENABLED  dbSecurityEventType = 5
+	SecurityEvent_SECOND_FACTOR_FAILURE  dbSecurityEventType = 6
+	SecurityEvent_RECOVERY_CODE_USED     dbSecurityEventType = 7
+	SecurityEvent_ACCOUNT_DELETED        dbSecurityEventType = 8
+	SecurityEvent_SOCIAL_LOGIN_LINKED    dbSecurityEventType = 9
+	SecurityEvent_PHONE_CODE_SENT        dbSecurityEventType = 10
+	SecurityEvent_EMAIL_CHANGED          dbSecurityEventType = 11
+	SecurityEvent_PHONE_NUMBER_CHANGED   dbSecurityEventType = 12
+	SecurityEvent_API_KEY_CREATED        dbSecurityEventType = 13
+	SecurityEvent_API_KEY_REVOKED        dbSecurityEventType = 14
+	SecurityEvent_ACCESS_ROLE_ASSIGNED   dbSecurityEventType = 15
+	SecurityEvent_ACCESS_ROLE_UNASSIGNED dbSecurityEventType = 16
+)
+
+// dbTOTPFactor is the TOTP secret of an account. It is unconfirmed until the
+// first code is verified.
+type dbTOTPFactor struct {
+	AuthID    string
+	Secret    string
+	Confirmed bool
+	// LastUsedStep is the time step of the last accepted code. Codes of the
+	// same or earlier steps are rejected to prevent replay.
+	LastUsedStep int64
+}
+
+// dbSocialLoginState is a social login waiting for the provider to redirect
+// back with the authorization code.
+type dbSocialLoginState struct {
+	State        string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+}
+
+// dbSocialIdentity links a user of an identity provider to an account.
+type dbSocialIdentity struct {
+	Provider   string
+	Subject    string
+	AuthID     string
+	Email      string
+	CreateTime time.Time
+}
+
+// dbPhoneLoginQuota is the number of login codes sent to a phone number
+// within the rate limit window.
+type dbPhoneLoginQuota struct {
+	SentCount int
+	// SinceLastSent is the time elapsed since the last code was sent.
+	SinceLastSent time.Duration
+}
+
+// dbEmailChange is an email change waiting for the code sent to the new
+// address.
+type dbEmailChange struct {
+	AuthID   string
+	NewEmail string
+	CodeHash string
+}
+
+// dbPhoneNumberChange is a phone number change waiting for the code sent to
+// the new number.
+type dbPhoneNumberChange struct {
+	AuthID         string
+	NewPhoneNumber string
+	CodeHash       string
+}
+
+type dbNewAPIKey struct {
+	AuthID     string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpireTime time.Time
+}
+
+// dbAPIKey is an API key of a merchant. Only a hash of the key is stored.
+type dbAPIKey struct {
+	ID           string
+	AuthID       string
+	Name         string
+	Prefix       string
+	Scopes       []string
+	ExpireTime   time.Time
+	LastUsedTime *time.Time
+	RevokeTime   *time.Time
+	CreateTime   time.Time
+}
+
+// dbAPIKeyPrincipal is the account a valid API key acts for.
+type dbAPIKeyPrincipal struct {
+	KeyID  string
+	AuthID string
+	Role   dbRoles
+	Scopes []string
+}
+
+// dbRiderApplicationStatus mirrors pb.RiderApplicationStatus numbering.
+type dbRiderApplicationStatus int16
+
+const (
+	RiderApplication_UNKNOWN  dbRiderApplicationStatus = 0
+	RiderApplication_PENDING  dbRiderApplicationStatus = 1
+	RiderApplication_APPROVED dbRiderApplicationStatus = 2
+	RiderApplication_REJECTED dbRiderApplicationStatus = 3
+)
+
+type dbRiderDocuments struct {
+	LicenceNumber     string
+	LicenceExpireTime time.Time
+	VehicleType       string
+	VehiclePlate      string
+}
+
+// dbRiderApplication is the onboarding state of a rider. Document fields are
+// nil until the rider submits them.
+type dbRiderApplication struct {
+	AuthID            string
+	Status            dbRiderApplicationStatus
+	LicenceNumber     *string
+	LicenceExpireTime *time.Time
+	VehicleType       *string
+	VehiclePlate      *string
+	SubmitTime        *time.Time
+	ReviewTime        *time.Time
+	RejectReason      *string
+	CreateTime        time.Time
+	UpdateTime        time.Time
+}
+
+// dbRiderApplicationFilter selects applications older than the cursor.
+type dbRiderApplicationFilter struct {
+	Status     dbRiderApplicationStatus
+	BeforeTime *time.Time
+	BeforeID   string
+	Limit      int
+}
+
+type dbPermission struct {
+	Name        string
+	Description string
+}
+
+// dbAccessRole is a named set of permissions.
+type dbAccessRole struct {
+	Name        string
+	Description string
+	Permissions []string
+	Builtin     bool
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+// dbAuthPermissions are the access roles of an account and the union of
+// their permissions.
+type dbAuthPermissions struct {
+	AccessRoles []string
+	Permissions []string
+}
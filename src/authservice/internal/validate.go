@@ -34,7 +34,7 @@ var validate = validator.New(validator.WithRequiredStructEnabled())
 func SetupValidator() {
 	validate.RegisterStructValidationMapRules(map[string]string{
 		"Email":       "required,email",
-		"Password":    "required,min=8,max=16,vpass",
+		"Password":    "required,min=8,max=128,vpass",
 		"PhoneNumber": "required,vphone",
 		"Role":        "vrole",
 	}, pb.RegisterRequest{})
@@ -44,6 +44,82 @@ func SetupValidator() {
 		"Password":   "required",
 	}, pb.LoginRequest{})
 
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":      "required,uuid",
+		"NewPassword": "required,min=8,max=128,vpass",
+	}, pb.ChangePasswordRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId": "required,uuid",
+	}, pb.DeleteAccountRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":   "required,uuid",
+		"NewEmail": "required,email",
+	}, pb.RequestEmailChangeRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId": "required,uuid",
+		"Code":   "required,len=6,numeric",
+	}, pb.ConfirmEmailChangeRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":   "required,uuid",
+		"NewPhone": "required,vphone",
+	}, pb.RequestPhoneNumberChangeRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId": "required,uuid",
+		"Code":   "required,len=6,numeric",
+	}, pb.ConfirmPhoneNumberChangeRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":    "required,uuid",
+		"Documents": "required",
+	}, pb.SubmitRiderDocumentsRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"LicenceNumber":     "required,max=32",
+		"LicenceExpireTime": "required",
+		"VehicleType":       "required,oneof=motorcycle car bicycle",
+		"VehiclePlate":      "max=16",
+	}, pb.RiderDocuments{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":   "required,uuid",
+		"Email":    "required,email",
+		"Password": "required,min=8,max=128,vpass",
+	}, pb.UpgradeGuestRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"AuthId":     "required,uuid",
+		"Identifier": "required",
+		"Password":   "required",
+	}, pb.MergeGuestRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"SecondFactorToken": "required",
+	}, pb.VerifySecondFactorRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"Code": "required,len=6,numeric",
+	}, pb.ConfirmTOTPEnrollmentRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"PhoneNumber": "required,vphone",
+	}, pb.StartPhoneLoginRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"PhoneNumber": "required,vphone",
+		"Code":        "required,len=6,numeric",
+	}, pb.CompletePhoneLoginRequest{})
+
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"Provider": "required",
+		"State":    "required",
+		"Code":     "required",
+	}, pb.CompleteSocialLoginRequest{})
+
 	// validate.RegisterStructValidationMapRules(rule2, nil)
 
 	// prefix 'v' for custom validation.
@@ -76,12 +152,20 @@ func buildMyValidatorErr(f validator.FieldError) myValidatorErr {
 	switch f.Tag() {
 	case "required":
 		return myValidatorErr{Field: f.Field(), Msg: "is required"}
+	case "uuid":
+		return myValidatorErr{Field: f.Field(), Msg: "must be a valid UUID"}
 	case "email":
 		return myValidatorErr{Field: f.Field(), Msg: "must be a valid email address"}
 	case "min":
 		return myValidatorErr{Field: f.Field(), Msg: fmt.Sprintf("must be at least %s", f.Param())}
 	case "max":
 		return myValidatorErr{Field: f.Field(), Msg: fmt.Sprintf("must be at most %s", f.Param())}
+	case "len":
+		return myValidatorErr{Field: f.Field(), Msg: fmt.Sprintf("must be exactly %s characters", f.Param())}
+	case "oneof":
+		return myValidatorErr{Field: f.Field(), Msg: fmt.Sprintf("must be one of %s", strings.ReplaceAll(f.Param(), " ", ", "))}
+	case "numeric":
+		return myValidatorErr{Field: f.Field(), Msg: "must contain digits only"}
 	case "lowercase":
 		return myValidatorErr{Field: f.Field(), Msg: "must be lowercase only"}
 	case "vpass":
@@ -90,10 +174,8 @@ func buildMyValidatorErr(f validator.FieldError) myValidatorErr {
 		return myValidatorErr{Field: f.Field(), Msg: "must be a valid phone number format"}
 	case "vrole":
 		var roles []string
-		for role := range pb.Roles_value {
-			if role != pb.Roles_ROLES_UNSPECIFIED.String() {
-				roles = append(roles, role)
-			}
+		for _, role := range registrableRoles {
+			roles = append(roles, role.String())
 		}
 		return myValidatorErr{
 			Field: f.Field(),
@@ -104,15 +186,23 @@ func buildMyValidatorErr(f validator.FieldError) myValidatorErr {
 	}
 }
 
+// registrableRoles are the roles that can be self-registered through
+// Register. Admin roles are created with CreateAdmin only.
+var registrableRoles = []pb.Roles{
+	pb.Roles_ROLES_CUSTOMER,
+	pb.Roles_ROLES_RIDER,
+	pb.Roles_ROLES_MERCHANT,
+}
+
 func validateRole(fl validator.FieldLevel) bool {
 
 	r := fl.Field().Interface().(pb.Roles)
-	if r == pb.Roles_ROLES_UNSPECIFIED {
-		return false
+	for _, role := range registrableRoles {
+		if r == role {
+			return true
+		}
 	}
-
-	_, exists := pb.Roles_value[r.String()]
-	return exists
+	return false
 }
 
 // phone number format (e.g., 06XXXXXXXX, 08XXXXXXXX, 09XXXXXXXX).
@@ -13,10 +13,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
 
-	_ "github.com/golang-migrate/migrate/v4/source/file"
-
+	"github.com/pongsathonn/ihavefood/pkg/migrate"
 	pb "github.com/pongsathonn/ihavefood/src/authservice/genproto"
 	"github.com/pongsathonn/ihavefood/src/authservice/internal"
+	"github.com/pongsathonn/ihavefood/src/authservice/supabase/migrations"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"google.golang.org/grpc/health"
 	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
@@ -126,9 +126,6 @@ func main() {
 	}))
 	slog.SetDefault(logger)
 
-	internal.LoadSigningKey()
-	internal.SetupValidator()
-
 	if err := initTimeZone(); err != nil {
 		slog.Error("failed to init time zone", "err", err)
 	}
@@ -138,9 +135,33 @@ func main() {
 		log.Fatalf("Failed to initialize PostgresDB connection: %v", err)
 	}
 
+	migrator, err := migrate.New(pool, migrations.FS, "authservice.schema_versions")
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(migrator, "authservice", os.Args[2:]); err != nil {
+			log.Fatalf("Failed to migrate: %v", err)
+		}
+		return
+	}
+
+	if err := migrate.OnStart(migrator); err != nil {
+		log.Fatalf("Failed to migrate: %v", err)
+	}
+
+	internal.LoadSigningKey()
+	internal.LoadSecurityConfig()
+	internal.LoadSocialProviders()
+	internal.SetupValidator()
+
 	auth := internal.NewAuthService(
 		internal.NewStorage(pool),
 		internal.NewRabbitMQ(initAMQPCon()),
+		internal.NewLogSMSSender(),
+		internal.NewLogEmailSender(),
+		internal.ServiceAuthenticatorFromEnv(),
 	)
 
 	startGRPCServer(auth)
@@ -1,11 +0,0 @@
-CREATE TABLE credentials (
-    id UUID DEFAULT gen_random_uuid(),
-    email VARCHAR(100) UNIQUE NOT NULL,
-    password VARCHAR(255) NOT NULL,
-    role SMALLINT NOT NULL,
-    phone_number VARCHAR(15) UNIQUE,
-    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
-    update_time TIMESTAMP NOT NULL DEFAULT NOW(),
-    PRIMARY KEY (id)
-);
-
@@ -15,10 +15,7 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER"  <<-EOSQL
     GRANT ALL PRIVILEGES ON DATABASE $AUTH_DB TO $AUTH_USER;
 EOSQL
 
-psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$AUTH_DB" \
-    -a -f /sql/create_table.sql
-
+# Tables are created by the migrations of the service, so its user owns them.
 psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$AUTH_DB" <<-EOSQL
-    GRANT SELECT, INSERT, UPDATE, DELETE ON credentials TO $AUTH_USER;
+    GRANT ALL PRIVILEGES ON SCHEMA public TO $AUTH_USER;
 EOSQL
-
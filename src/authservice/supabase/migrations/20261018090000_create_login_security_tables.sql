@@ -0,0 +1,19 @@
+CREATE TABLE login_attempts (
+    attempt_key VARCHAR(255),
+    failed_count INTEGER NOT NULL DEFAULT 0,
+    last_failed_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    PRIMARY KEY (attempt_key)
+);
+
+CREATE TABLE security_events (
+    id UUID DEFAULT gen_random_uuid(),
+    auth_id UUID,
+    event_type SMALLINT NOT NULL,
+    ip_address VARCHAR(45),
+    user_agent VARCHAR(255),
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX security_events_auth_id_idx ON security_events (auth_id, create_time DESC);
@@ -0,0 +1,21 @@
+CREATE TABLE totp_factors (
+    auth_id UUID,
+    secret VARCHAR(64) NOT NULL,
+    confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    update_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (auth_id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
+
+CREATE TABLE recovery_codes (
+    id UUID DEFAULT gen_random_uuid(),
+    auth_id UUID NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    used_time TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
+
+CREATE INDEX recovery_codes_auth_id_idx ON recovery_codes (auth_id);
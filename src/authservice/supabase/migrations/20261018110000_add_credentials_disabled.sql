@@ -0,0 +1,3 @@
+ALTER TABLE credentials ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX credentials_create_time_idx ON credentials (create_time DESC, id DESC);
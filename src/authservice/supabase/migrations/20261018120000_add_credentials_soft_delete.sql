@@ -0,0 +1,2 @@
+ALTER TABLE credentials ADD COLUMN sessions_revoked_time TIMESTAMP;
+ALTER TABLE credentials ADD COLUMN delete_time TIMESTAMP;
@@ -0,0 +1,20 @@
+CREATE TABLE social_login_states (
+    state VARCHAR(64),
+    provider VARCHAR(32) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce VARCHAR(64) NOT NULL,
+    expire_time TIMESTAMP NOT NULL,
+    PRIMARY KEY (state)
+);
+
+CREATE TABLE social_identities (
+    provider VARCHAR(32),
+    subject VARCHAR(255),
+    auth_id UUID NOT NULL,
+    email VARCHAR(100),
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
+
+CREATE INDEX social_identities_auth_id_idx ON social_identities (auth_id);
@@ -0,0 +1,12 @@
+CREATE TABLE phone_login_codes (
+    id UUID DEFAULT gen_random_uuid(),
+    phone_number VARCHAR(15) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts SMALLINT NOT NULL DEFAULT 0,
+    expire_time TIMESTAMP NOT NULL,
+    used_time TIMESTAMP,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX phone_login_codes_phone_number_idx ON phone_login_codes (phone_number, create_time DESC);
@@ -0,0 +1,3 @@
+-- Existing passwords were hashed with bcrypt and are rehashed with argon2id on
+-- the next login.
+ALTER TABLE credentials ADD COLUMN password_algorithm VARCHAR(16) NOT NULL DEFAULT 'bcrypt';
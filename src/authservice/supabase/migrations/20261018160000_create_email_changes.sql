@@ -0,0 +1,10 @@
+CREATE TABLE email_changes (
+    auth_id UUID,
+    new_email VARCHAR(100) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts SMALLINT NOT NULL DEFAULT 0,
+    expire_time TIMESTAMP NOT NULL,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (auth_id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+CREATE TABLE api_keys (
+    id UUID DEFAULT gen_random_uuid(),
+    auth_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    expire_time TIMESTAMP NOT NULL,
+    last_used_time TIMESTAMP,
+    revoke_time TIMESTAMP,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
+
+CREATE INDEX api_keys_auth_id_idx ON api_keys (auth_id, create_time DESC);
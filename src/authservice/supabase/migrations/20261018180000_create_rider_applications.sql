@@ -0,0 +1,22 @@
+CREATE TABLE rider_applications (
+    auth_id UUID,
+    status SMALLINT NOT NULL DEFAULT 1,
+    licence_number VARCHAR(32),
+    licence_expire_time TIMESTAMP,
+    vehicle_type VARCHAR(16),
+    vehicle_plate VARCHAR(16),
+    submit_time TIMESTAMP,
+    review_time TIMESTAMP,
+    reviewer_id UUID,
+    reject_reason VARCHAR(255),
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    update_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (auth_id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
+
+CREATE INDEX rider_applications_status_idx ON rider_applications (status, create_time DESC);
+
+-- Riders registered before onboarding were synced to delivery already.
+INSERT INTO rider_applications (auth_id, status, review_time)
+SELECT id, 2, NOW() FROM credentials WHERE role = 2 AND delete_time IS NULL;
@@ -0,0 +1,70 @@
+CREATE TABLE permissions (
+    name VARCHAR(50),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (name)
+);
+
+CREATE TABLE access_roles (
+    name VARCHAR(50),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    builtin BOOLEAN NOT NULL DEFAULT FALSE,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    update_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (name)
+);
+
+CREATE TABLE access_role_permissions (
+    role_name VARCHAR(50),
+    permission_name VARCHAR(50),
+    PRIMARY KEY (role_name, permission_name),
+    FOREIGN KEY (role_name) REFERENCES access_roles(name) ON DELETE CASCADE,
+    FOREIGN KEY (permission_name) REFERENCES permissions(name) ON DELETE CASCADE
+);
+
+-- Roles held by an account cannot be deleted.
+CREATE TABLE access_role_assignments (
+    auth_id UUID,
+    role_name VARCHAR(50),
+    granted_by UUID,
+    grant_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (auth_id, role_name),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE,
+    FOREIGN KEY (role_name) REFERENCES access_roles(name)
+);
+
+CREATE INDEX access_role_assignments_role_name_idx ON access_role_assignments (role_name);
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:read', 'Search accounts'),
+    ('accounts:write', 'Disable, enable, delete and change the role of accounts'),
+    ('admins:write', 'Create and manage admin accounts'),
+    ('roles:read', 'List access roles and the permissions of accounts'),
+    ('roles:write', 'Manage access roles and assign them'),
+    ('security_events:read', 'List security events of every account'),
+    ('rider_applications:review', 'Review rider applications'),
+    ('customers:read', 'List customers'),
+    ('coupons:write', 'Create coupons');
+
+INSERT INTO access_roles (name, description, builtin) VALUES
+    ('super_admin', 'Every permission', TRUE),
+    ('admin', 'Day to day operations', TRUE);
+
+INSERT INTO access_role_permissions (role_name, permission_name)
+SELECT 'super_admin', name FROM permissions;
+
+INSERT INTO access_role_permissions (role_name, permission_name) VALUES
+    ('admin', 'accounts:read'),
+    ('admin', 'accounts:write'),
+    ('admin', 'security_events:read'),
+    ('admin', 'rider_applications:review'),
+    ('admin', 'customers:read'),
+    ('admin', 'coupons:write');
+
+-- Admin accounts keep what the role enum allowed them before.
+INSERT INTO access_role_assignments (auth_id, role_name)
+SELECT id, CASE role WHEN 20 THEN 'super_admin' ELSE 'admin' END
+FROM credentials
+WHERE role IN (20, 21) AND delete_time IS NULL;
+
+-- Tokens issued before carry no permissions.
+UPDATE credentials SET sessions_revoked_time = NOW() WHERE role IN (20, 21);
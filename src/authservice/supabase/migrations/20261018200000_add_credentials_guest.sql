@@ -0,0 +1,3 @@
+-- Guests are customers known only by a verified phone number. Their email is
+-- a placeholder and they have no password until they upgrade.
+ALTER TABLE credentials ADD COLUMN guest BOOLEAN NOT NULL DEFAULT FALSE;
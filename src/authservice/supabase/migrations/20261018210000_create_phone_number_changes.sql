@@ -0,0 +1,10 @@
+CREATE TABLE phone_number_changes (
+    auth_id UUID,
+    new_phone_number VARCHAR(15) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts SMALLINT NOT NULL DEFAULT 0,
+    expire_time TIMESTAMP NOT NULL,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (auth_id),
+    FOREIGN KEY (auth_id) REFERENCES credentials(id) ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+-- Customer data is read and changed by admins through customerservice, which
+-- checks these permissions instead of the admin role.
+UPDATE permissions SET description = 'Read customers and their data' WHERE name = 'customers:read';
+
+INSERT INTO permissions (name, description) VALUES
+    ('customers:write', 'Change the data of customers');
+
+INSERT INTO access_role_permissions (role_name, permission_name) VALUES
+    ('super_admin', 'customers:write'),
+    ('admin', 'customers:write');
+
+-- Tokens issued before do not carry the new permission.
+UPDATE credentials SET sessions_revoked_time = date_trunc('second', NOW())
+WHERE id IN (
+    SELECT auth_id FROM access_role_assignments WHERE role_name IN ('super_admin', 'admin')
+);
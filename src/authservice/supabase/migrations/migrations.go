@@ -0,0 +1,8 @@
+// Package migrations embeds the schema migrations of the service, which are
+// applied by the service binary on start or with its migrate command.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
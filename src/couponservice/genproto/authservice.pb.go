@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -30,6 +31,7 @@ const (
 	Roles_ROLES_UNSPECIFIED Roles = 0
 	Roles_ROLES_CUSTOMER    Roles = 1
 	Roles_ROLES_RIDER       Roles = 2
+	Roles_ROLES_MERCHANT    Roles = 3
 	// For simplicity, admin roles are included in this enum.
 	Roles_ROLES_SUPER_ADMIN Roles = 20
 	Roles_ROLES_ADMIN       Roles = 21
@@ -41,6 +43,7 @@ var (
 		0:  "ROLES_UNSPECIFIED",
 		1:  "ROLES_CUSTOMER",
 		2:  "ROLES_RIDER",
+		3:  "ROLES_MERCHANT",
 		20: "ROLES_SUPER_ADMIN",
 		21: "ROLES_ADMIN",
 	}
@@ -48,6 +51,7 @@ var (
 		"ROLES_UNSPECIFIED": 0,
 		"ROLES_CUSTOMER":    1,
 		"ROLES_RIDER":       2,
+		"ROLES_MERCHANT":    3,
 		"ROLES_SUPER_ADMIN": 20,
 		"ROLES_ADMIN":       21,
 	}
@@ -80,14 +84,161 @@ func (Roles) EnumDescriptor() ([]byte, []int) {
 	return file_authservice_proto_rawDescGZIP(), []int{0}
 }
 
+// Riders start pending and are only dispatchable once approved.
+type RiderApplicationStatus int32
+
+const (
+	RiderApplicationStatus_RIDER_APPLICATION_STATUS_UNSPECIFIED RiderApplicationStatus = 0
+	RiderApplicationStatus_RIDER_APPLICATION_STATUS_PENDING     RiderApplicationStatus = 1
+	RiderApplicationStatus_RIDER_APPLICATION_STATUS_APPROVED    RiderApplicationStatus = 2
+	RiderApplicationStatus_RIDER_APPLICATION_STATUS_REJECTED    RiderApplicationStatus = 3
+)
+
+// Enum value maps for RiderApplicationStatus.
+var (
+	RiderApplicationStatus_name = map[int32]string{
+		0: "RIDER_APPLICATION_STATUS_UNSPECIFIED",
+		1: "RIDER_APPLICATION_STATUS_PENDING",
+		2: "RIDER_APPLICATION_STATUS_APPROVED",
+		3: "RIDER_APPLICATION_STATUS_REJECTED",
+	}
+	RiderApplicationStatus_value = map[string]int32{
+		"RIDER_APPLICATION_STATUS_UNSPECIFIED": 0,
+		"RIDER_APPLICATION_STATUS_PENDING":     1,
+		"RIDER_APPLICATION_STATUS_APPROVED":    2,
+		"RIDER_APPLICATION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x RiderApplicationStatus) Enum() *RiderApplicationStatus {
+	p := new(RiderApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x RiderApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiderApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authservice_proto_enumTypes[1].Descriptor()
+}
+
+func (RiderApplicationStatus) Type() protoreflect.EnumType {
+	return &file_authservice_proto_enumTypes[1]
+}
+
+func (x RiderApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiderApplicationStatus.Descriptor instead.
+func (RiderApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{1}
+}
+
+type SecurityEventType int32
+
+const (
+	SecurityEventType_SECURITY_EVENT_TYPE_UNSPECIFIED            SecurityEventType = 0
+	SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS          SecurityEventType = 1
+	SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE          SecurityEventType = 2
+	SecurityEventType_SECURITY_EVENT_TYPE_ACCOUNT_LOCKED         SecurityEventType = 3
+	SecurityEventType_SECURITY_EVENT_TYPE_PASSWORD_CHANGED       SecurityEventType = 4
+	SecurityEventType_SECURITY_EVENT_TYPE_SECOND_FACTOR_ENABLED  SecurityEventType = 5
+	SecurityEventType_SECURITY_EVENT_TYPE_SECOND_FACTOR_FAILURE  SecurityEventType = 6
+	SecurityEventType_SECURITY_EVENT_TYPE_RECOVERY_CODE_USED     SecurityEventType = 7
+	SecurityEventType_SECURITY_EVENT_TYPE_ACCOUNT_DELETED        SecurityEventType = 8
+	SecurityEventType_SECURITY_EVENT_TYPE_SOCIAL_LOGIN_LINKED    SecurityEventType = 9
+	SecurityEventType_SECURITY_EVENT_TYPE_PHONE_CODE_SENT        SecurityEventType = 10
+	SecurityEventType_SECURITY_EVENT_TYPE_EMAIL_CHANGED          SecurityEventType = 11
+	SecurityEventType_SECURITY_EVENT_TYPE_PHONE_NUMBER_CHANGED   SecurityEventType = 12
+	SecurityEventType_SECURITY_EVENT_TYPE_API_KEY_CREATED        SecurityEventType = 13
+	SecurityEventType_SECURITY_EVENT_TYPE_API_KEY_REVOKED        SecurityEventType = 14
+	SecurityEventType_SECURITY_EVENT_TYPE_ACCESS_ROLE_ASSIGNED   SecurityEventType = 15
+	SecurityEventType_SECURITY_EVENT_TYPE_ACCESS_ROLE_UNASSIGNED SecurityEventType = 16
+)
+
+// Enum value maps for SecurityEventType.
+var (
+	SecurityEventType_name = map[int32]string{
+		0:  "SECURITY_EVENT_TYPE_UNSPECIFIED",
+		1:  "SECURITY_EVENT_TYPE_LOGIN_SUCCESS",
+		2:  "SECURITY_EVENT_TYPE_LOGIN_FAILURE",
+		3:  "SECURITY_EVENT_TYPE_ACCOUNT_LOCKED",
+		4:  "SECURITY_EVENT_TYPE_PASSWORD_CHANGED",
+		5:  "SECURITY_EVENT_TYPE_SECOND_FACTOR_ENABLED",
+		6:  "SECURITY_EVENT_TYPE_SECOND_FACTOR_FAILURE",
+		7:  "SECURITY_EVENT_TYPE_RECOVERY_CODE_USED",
+		8:  "SECURITY_EVENT_TYPE_ACCOUNT_DELETED",
+		9:  "SECURITY_EVENT_TYPE_SOCIAL_LOGIN_LINKED",
+		10: "SECURITY_EVENT_TYPE_PHONE_CODE_SENT",
+		11: "SECURITY_EVENT_TYPE_EMAIL_CHANGED",
+		12: "SECURITY_EVENT_TYPE_PHONE_NUMBER_CHANGED",
+		13: "SECURITY_EVENT_TYPE_API_KEY_CREATED",
+		14: "SECURITY_EVENT_TYPE_API_KEY_REVOKED",
+		15: "SECURITY_EVENT_TYPE_ACCESS_ROLE_ASSIGNED",
+		16: "SECURITY_EVENT_TYPE_ACCESS_ROLE_UNASSIGNED",
+	}
+	SecurityEventType_value = map[string]int32{
+		"SECURITY_EVENT_TYPE_UNSPECIFIED":            0,
+		"SECURITY_EVENT_TYPE_LOGIN_SUCCESS":          1,
+		"SECURITY_EVENT_TYPE_LOGIN_FAILURE":          2,
+		"SECURITY_EVENT_TYPE_ACCOUNT_LOCKED":         3,
+		"SECURITY_EVENT_TYPE_PASSWORD_CHANGED":       4,
+		"SECURITY_EVENT_TYPE_SECOND_FACTOR_ENABLED":  5,
+		"SECURITY_EVENT_TYPE_SECOND_FACTOR_FAILURE":  6,
+		"SECURITY_EVENT_TYPE_RECOVERY_CODE_USED":     7,
+		"SECURITY_EVENT_TYPE_ACCOUNT_DELETED":        8,
+		"SECURITY_EVENT_TYPE_SOCIAL_LOGIN_LINKED":    9,
+		"SECURITY_EVENT_TYPE_PHONE_CODE_SENT":        10,
+		"SECURITY_EVENT_TYPE_EMAIL_CHANGED":          11,
+		"SECURITY_EVENT_TYPE_PHONE_NUMBER_CHANGED":   12,
+		"SECURITY_EVENT_TYPE_API_KEY_CREATED":        13,
+		"SECURITY_EVENT_TYPE_API_KEY_REVOKED":        14,
+		"SECURITY_EVENT_TYPE_ACCESS_ROLE_ASSIGNED":   15,
+		"SECURITY_EVENT_TYPE_ACCESS_ROLE_UNASSIGNED": 16,
+	}
+)
+
+func (x SecurityEventType) Enum() *SecurityEventType {
+	p := new(SecurityEventType)
+	*p = x
+	return p
+}
+
+func (x SecurityEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_authservice_proto_enumTypes[2].Descriptor()
+}
+
+func (SecurityEventType) Type() protoreflect.EnumType {
+	return &file_authservice_proto_enumTypes[2]
+}
+
+func (x SecurityEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityEventType.Descriptor instead.
+func (SecurityEventType) EnumDescriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{2}
+}
+
 type AuthCredentials struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Role          Roles                  `protobuf:"varint,4,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone      string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Role       Roles                  `protobuf:"varint,4,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Disabled   bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Guests are customers known only by a verified phone number.
+	Guest         bool `protobuf:"varint,8,opt,name=guest,proto3" json:"guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -164,6 +315,20 @@ func (x *AuthCredentials) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AuthCredentials) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *AuthCredentials) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -286,9 +451,17 @@ func (x *LoginRequest) GetRole() Roles {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The password is correct but a TOTP code or recovery code is required.
+	// Pass second_factor_token to VerifySecondFactor.
+	SecondFactorRequired bool `protobuf:"varint,1,opt,name=second_factor_required,json=secondFactorRequired,proto3" json:"second_factor_required,omitempty"`
+	// The role requires two-factor authentication and the account has not
+	// enrolled yet. Pass second_factor_token to the TOTP enrolment RPCs.
+	EnrollmentRequired bool `protobuf:"varint,2,opt,name=enrollment_required,json=enrollmentRequired,proto3" json:"enrollment_required,omitempty"`
+	// Short-lived token identifying the pending login.
+	SecondFactorToken string `protobuf:"bytes,3,opt,name=second_factor_token,json=secondFactorToken,proto3" json:"second_factor_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -321,28 +494,49 @@ func (*LoginResponse) Descriptor() ([]byte, []int) {
 	return file_authservice_proto_rawDescGZIP(), []int{3}
 }
 
-type UpdatePhoneNumberRequest struct {
+func (x *LoginResponse) GetSecondFactorRequired() bool {
+	if x != nil {
+		return x.SecondFactorRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetEnrollmentRequired() bool {
+	if x != nil {
+		return x.EnrollmentRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetSecondFactorToken() string {
+	if x != nil {
+		return x.SecondFactorToken
+	}
+	return ""
+}
+
+type StartPhoneLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
-	NewPhone      string                 `protobuf:"bytes,2,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	AllowGuest    bool                   `protobuf:"varint,2,opt,name=allow_guest,json=allowGuest,proto3" json:"allow_guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePhoneNumberRequest) Reset() {
-	*x = UpdatePhoneNumberRequest{}
+func (x *StartPhoneLoginRequest) Reset() {
+	*x = StartPhoneLoginRequest{}
 	mi := &file_authservice_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePhoneNumberRequest) String() string {
+func (x *StartPhoneLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePhoneNumberRequest) ProtoMessage() {}
+func (*StartPhoneLoginRequest) ProtoMessage() {}
 
-func (x *UpdatePhoneNumberRequest) ProtoReflect() protoreflect.Message {
+func (x *StartPhoneLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_authservice_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,46 +548,49 @@ func (x *UpdatePhoneNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePhoneNumberRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePhoneNumberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartPhoneLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartPhoneLoginRequest) Descriptor() ([]byte, []int) {
 	return file_authservice_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdatePhoneNumberRequest) GetAuthId() string {
+func (x *StartPhoneLoginRequest) GetPhoneNumber() string {
 	if x != nil {
-		return x.AuthId
+		return x.PhoneNumber
 	}
 	return ""
 }
 
-func (x *UpdatePhoneNumberRequest) GetNewPhone() string {
+func (x *StartPhoneLoginRequest) GetAllowGuest() bool {
 	if x != nil {
-		return x.NewPhone
+		return x.AllowGuest
 	}
-	return ""
+	return false
 }
 
-type UpdatePhoneNumberResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Auth          *AuthCredentials       `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+type StartPhoneLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The code cannot be used after expire_time.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Another code cannot be requested before resend_time.
+	ResendTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=resend_time,json=resendTime,proto3" json:"resend_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePhoneNumberResponse) Reset() {
-	*x = UpdatePhoneNumberResponse{}
+func (x *StartPhoneLoginResponse) Reset() {
+	*x = StartPhoneLoginResponse{}
 	mi := &file_authservice_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePhoneNumberResponse) String() string {
+func (x *StartPhoneLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePhoneNumberResponse) ProtoMessage() {}
+func (*StartPhoneLoginResponse) ProtoMessage() {}
 
-func (x *UpdatePhoneNumberResponse) ProtoReflect() protoreflect.Message {
+func (x *StartPhoneLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_authservice_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,40 +602,49 @@ func (x *UpdatePhoneNumberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePhoneNumberResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePhoneNumberResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartPhoneLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartPhoneLoginResponse) Descriptor() ([]byte, []int) {
 	return file_authservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdatePhoneNumberResponse) GetAuth() *AuthCredentials {
+func (x *StartPhoneLoginResponse) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Auth
+		return x.ExpireTime
 	}
 	return nil
 }
 
-type CreateAdminRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+func (x *StartPhoneLoginResponse) GetResendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResendTime
+	}
+	return nil
+}
+
+type CompletePhoneLoginRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Code        string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Create a guest customer when no account has the phone number.
+	AllowGuest    bool `protobuf:"varint,3,opt,name=allow_guest,json=allowGuest,proto3" json:"allow_guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdminRequest) Reset() {
-	*x = CreateAdminRequest{}
+func (x *CompletePhoneLoginRequest) Reset() {
+	*x = CompletePhoneLoginRequest{}
 	mi := &file_authservice_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdminRequest) String() string {
+func (x *CompletePhoneLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdminRequest) ProtoMessage() {}
+func (*CompletePhoneLoginRequest) ProtoMessage() {}
 
-func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
+func (x *CompletePhoneLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_authservice_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -450,129 +656,4228 @@ func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdminRequest.ProtoReflect.Descriptor instead.
-func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompletePhoneLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompletePhoneLoginRequest) Descriptor() ([]byte, []int) {
 	return file_authservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateAdminRequest) GetEmail() string {
+func (x *CompletePhoneLoginRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CompletePhoneLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompletePhoneLoginRequest) GetAllowGuest() bool {
+	if x != nil {
+		return x.AllowGuest
+	}
+	return false
+}
+
+type UpgradeGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeGuestRequest) Reset() {
+	*x = UpgradeGuestRequest{}
+	mi := &file_authservice_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeGuestRequest) ProtoMessage() {}
+
+func (x *UpgradeGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeGuestRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeGuestRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpgradeGuestRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *UpgradeGuestRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateAdminRequest) GetPassword() string {
+func (x *UpgradeGuestRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-var File_authservice_proto protoreflect.FileDescriptor
+type MergeGuestRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	AuthId string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	// Email or phone number of the customer account to merge into.
+	Identifier    string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_authservice_proto_rawDesc = "" +
-	"\n" +
-	"\x11authservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xed\x01\n" +
-	"\x0fAuthCredentials\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12$\n" +
-	"\x04role\x18\x04 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12;\n" +
-	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x12;\n" +
-	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"\xc5\x01\n" +
-	"\x0fRegisterRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12$\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role:Z\x92AW2U{\"email\": \"somsak@example.com\", \"password\": \"Newpa$sword9\", \"role\": \"ROLES_CUSTOMER\"}\"\xcf\x01\n" +
-	"\fLoginRequest\x12\x1e\n" +
-	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
-	"identifier\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12$\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role:]\x92AZ2X{\"identifier\": \"somsak22@mail.com\", \"password\": \"Newpa$sword9\",\"role\": \"ROLES_CUSTOMER\"}\"\x0f\n" +
-	"\rLoginResponse\"P\n" +
-	"\x18UpdatePhoneNumberRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1b\n" +
-	"\tnew_phone\x18\x02 \x01(\tR\bnewPhone\"K\n" +
-	"\x19UpdatePhoneNumberResponse\x12.\n" +
-	"\x04auth\x18\x01 \x01(\v2\x1a.ihavefood.AuthCredentialsR\x04auth\"F\n" +
-	"\x12CreateAdminRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword*k\n" +
-	"\x05Roles\x12\x15\n" +
-	"\x11ROLES_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eROLES_CUSTOMER\x10\x01\x12\x0f\n" +
-	"\vROLES_RIDER\x10\x02\x12\x15\n" +
-	"\x11ROLES_SUPER_ADMIN\x10\x14\x12\x0f\n" +
-	"\vROLES_ADMIN\x10\x152\xa0\x03\n" +
-	"\vAuthService\x12b\n" +
-	"\bRegister\x12\x1a.ihavefood.RegisterRequest\x1a\x1a.ihavefood.AuthCredentials\"\x1e\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/register\x12W\n" +
-	"\x05Login\x12\x17.ihavefood.LoginRequest\x1a\x18.ihavefood.LoginResponse\"\x1b\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/auth/login\x12\x87\x01\n" +
-	"\x11UpdatePhoneNumber\x12#.ihavefood.UpdatePhoneNumberRequest\x1a$.ihavefood.UpdatePhoneNumberResponse\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/auth/{auth_id}/phone-number\x12J\n" +
-	"\vCreateAdmin\x12\x1d.ihavefood.CreateAdminRequest\x1a\x1a.ihavefood.AuthCredentials\"\x00B\vZ\t/genprotob\x06proto3"
+func (x *MergeGuestRequest) Reset() {
+	*x = MergeGuestRequest{}
+	mi := &file_authservice_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_authservice_proto_rawDescOnce sync.Once
-	file_authservice_proto_rawDescData []byte
-)
+func (x *MergeGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_authservice_proto_rawDescGZIP() []byte {
-	file_authservice_proto_rawDescOnce.Do(func() {
-		file_authservice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_authservice_proto_rawDesc), len(file_authservice_proto_rawDesc)))
-	})
-	return file_authservice_proto_rawDescData
+func (*MergeGuestRequest) ProtoMessage() {}
+
+func (x *MergeGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_authservice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_authservice_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_authservice_proto_goTypes = []any{
-	(Roles)(0),                        // 0: ihavefood.Roles
-	(*AuthCredentials)(nil),           // 1: ihavefood.AuthCredentials
-	(*RegisterRequest)(nil),           // 2: ihavefood.RegisterRequest
-	(*LoginRequest)(nil),              // 3: ihavefood.LoginRequest
-	(*LoginResponse)(nil),             // 4: ihavefood.LoginResponse
-	(*UpdatePhoneNumberRequest)(nil),  // 5: ihavefood.UpdatePhoneNumberRequest
-	(*UpdatePhoneNumberResponse)(nil), // 6: ihavefood.UpdatePhoneNumberResponse
-	(*CreateAdminRequest)(nil),        // 7: ihavefood.CreateAdminRequest
-	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+// Deprecated: Use MergeGuestRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{8}
 }
-var file_authservice_proto_depIdxs = []int32{
-	0,  // 0: ihavefood.AuthCredentials.role:type_name -> ihavefood.Roles
-	8,  // 1: ihavefood.AuthCredentials.create_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: ihavefood.AuthCredentials.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 3: ihavefood.RegisterRequest.role:type_name -> ihavefood.Roles
-	0,  // 4: ihavefood.LoginRequest.role:type_name -> ihavefood.Roles
-	1,  // 5: ihavefood.UpdatePhoneNumberResponse.auth:type_name -> ihavefood.AuthCredentials
-	2,  // 6: ihavefood.AuthService.Register:input_type -> ihavefood.RegisterRequest
-	3,  // 7: ihavefood.AuthService.Login:input_type -> ihavefood.LoginRequest
-	5,  // 8: ihavefood.AuthService.UpdatePhoneNumber:input_type -> ihavefood.UpdatePhoneNumberRequest
-	7,  // 9: ihavefood.AuthService.CreateAdmin:input_type -> ihavefood.CreateAdminRequest
-	1,  // 10: ihavefood.AuthService.Register:output_type -> ihavefood.AuthCredentials
-	4,  // 11: ihavefood.AuthService.Login:output_type -> ihavefood.LoginResponse
-	6,  // 12: ihavefood.AuthService.UpdatePhoneNumber:output_type -> ihavefood.UpdatePhoneNumberResponse
-	1,  // 13: ihavefood.AuthService.CreateAdmin:output_type -> ihavefood.AuthCredentials
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *MergeGuestRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
 }
 
-func init() { file_authservice_proto_init() }
-func file_authservice_proto_init() {
-	if File_authservice_proto != nil {
-		return
+func (x *MergeGuestRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *MergeGuestRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type StartSocialLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSocialLoginRequest) Reset() {
+	*x = StartSocialLoginRequest{}
+	mi := &file_authservice_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSocialLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSocialLoginRequest) ProtoMessage() {}
+
+func (x *StartSocialLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSocialLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartSocialLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartSocialLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type StartSocialLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartSocialLoginResponse) Reset() {
+	*x = StartSocialLoginResponse{}
+	mi := &file_authservice_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSocialLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSocialLoginResponse) ProtoMessage() {}
+
+func (x *StartSocialLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSocialLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartSocialLoginResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartSocialLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *StartSocialLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CompleteSocialLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSocialLoginRequest) Reset() {
+	*x = CompleteSocialLoginRequest{}
+	mi := &file_authservice_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSocialLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSocialLoginRequest) ProtoMessage() {}
+
+func (x *CompleteSocialLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSocialLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSocialLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompleteSocialLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteSocialLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteSocialLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifySecondFactorRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SecondFactorToken string                 `protobuf:"bytes,1,opt,name=second_factor_token,json=secondFactorToken,proto3" json:"second_factor_token,omitempty"`
+	// Types that are valid to be assigned to Factor:
+	//
+	//	*VerifySecondFactorRequest_TotpCode
+	//	*VerifySecondFactorRequest_RecoveryCode
+	Factor        isVerifySecondFactorRequest_Factor `protobuf_oneof:"factor"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySecondFactorRequest) Reset() {
+	*x = VerifySecondFactorRequest{}
+	mi := &file_authservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySecondFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecondFactorRequest) ProtoMessage() {}
+
+func (x *VerifySecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifySecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifySecondFactorRequest) GetSecondFactorToken() string {
+	if x != nil {
+		return x.SecondFactorToken
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorRequest) GetFactor() isVerifySecondFactorRequest_Factor {
+	if x != nil {
+		return x.Factor
+	}
+	return nil
+}
+
+func (x *VerifySecondFactorRequest) GetTotpCode() string {
+	if x != nil {
+		if x, ok := x.Factor.(*VerifySecondFactorRequest_TotpCode); ok {
+			return x.TotpCode
+		}
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorRequest) GetRecoveryCode() string {
+	if x != nil {
+		if x, ok := x.Factor.(*VerifySecondFactorRequest_RecoveryCode); ok {
+			return x.RecoveryCode
+		}
+	}
+	return ""
+}
+
+type isVerifySecondFactorRequest_Factor interface {
+	isVerifySecondFactorRequest_Factor()
+}
+
+type VerifySecondFactorRequest_TotpCode struct {
+	TotpCode string `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3,oneof"`
+}
+
+type VerifySecondFactorRequest_RecoveryCode struct {
+	RecoveryCode string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3,oneof"`
+}
+
+func (*VerifySecondFactorRequest_TotpCode) isVerifySecondFactorRequest_Factor() {}
+
+func (*VerifySecondFactorRequest_RecoveryCode) isVerifySecondFactorRequest_Factor() {}
+
+type BeginTOTPEnrollmentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SecondFactorToken string                 `protobuf:"bytes,1,opt,name=second_factor_token,json=secondFactorToken,proto3" json:"second_factor_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BeginTOTPEnrollmentRequest) Reset() {
+	*x = BeginTOTPEnrollmentRequest{}
+	mi := &file_authservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentRequest) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BeginTOTPEnrollmentRequest) GetSecondFactorToken() string {
+	if x != nil {
+		return x.SecondFactorToken
+	}
+	return ""
+}
+
+type BeginTOTPEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32 secret for manual entry.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to render as a QR code.
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BeginTOTPEnrollmentResponse) Reset() {
+	*x = BeginTOTPEnrollmentResponse{}
+	mi := &file_authservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentResponse) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BeginTOTPEnrollmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTOTPEnrollmentResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type ConfirmTOTPEnrollmentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SecondFactorToken string                 `protobuf:"bytes,1,opt,name=second_factor_token,json=secondFactorToken,proto3" json:"second_factor_token,omitempty"`
+	Code              string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) Reset() {
+	*x = ConfirmTOTPEnrollmentRequest{}
+	mi := &file_authservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) GetSecondFactorToken() string {
+	if x != nil {
+		return x.SecondFactorToken
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shown once. Each code can replace a TOTP code a single time.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) Reset() {
+	*x = ConfirmTOTPEnrollmentResponse{}
+	mi := &file_authservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type RequestEmailChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AuthId          string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	NewEmail        string                 `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	CurrentPassword string                 `protobuf:"bytes,3,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_authservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestEmailChangeRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The code cannot be used after expire_time.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_authservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RequestEmailChangeResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_authservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmEmailChangeRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *ConfirmEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RequestPhoneNumberChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AuthId          string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	NewPhone        string                 `protobuf:"bytes,2,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"`
+	CurrentPassword string                 `protobuf:"bytes,3,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestPhoneNumberChangeRequest) Reset() {
+	*x = RequestPhoneNumberChangeRequest{}
+	mi := &file_authservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneNumberChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneNumberChangeRequest) ProtoMessage() {}
+
+func (x *RequestPhoneNumberChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneNumberChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestPhoneNumberChangeRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RequestPhoneNumberChangeRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *RequestPhoneNumberChangeRequest) GetNewPhone() string {
+	if x != nil {
+		return x.NewPhone
+	}
+	return ""
+}
+
+func (x *RequestPhoneNumberChangeRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type RequestPhoneNumberChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The code cannot be used after expire_time.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPhoneNumberChangeResponse) Reset() {
+	*x = RequestPhoneNumberChangeResponse{}
+	mi := &file_authservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneNumberChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneNumberChangeResponse) ProtoMessage() {}
+
+func (x *RequestPhoneNumberChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneNumberChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestPhoneNumberChangeResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequestPhoneNumberChangeResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ConfirmPhoneNumberChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneNumberChangeRequest) Reset() {
+	*x = ConfirmPhoneNumberChangeRequest{}
+	mi := &file_authservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneNumberChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneNumberChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmPhoneNumberChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneNumberChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneNumberChangeRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfirmPhoneNumberChangeRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneNumberChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CreateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminRequest) Reset() {
+	*x = CreateAdminRequest{}
+	mi := &file_authservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminRequest) ProtoMessage() {}
+
+func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateAdminRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ListAuthsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prefix of the email or phone number.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Role  Roles  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	// Only return disabled accounts.
+	DisabledOnly bool `protobuf:"varint,3,opt,name=disabled_only,json=disabledOnly,proto3" json:"disabled_only,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthsRequest) Reset() {
+	*x = ListAuthsRequest{}
+	mi := &file_authservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthsRequest) ProtoMessage() {}
+
+func (x *ListAuthsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuthsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListAuthsRequest) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *ListAuthsRequest) GetDisabledOnly() bool {
+	if x != nil {
+		return x.DisabledOnly
+	}
+	return false
+}
+
+func (x *ListAuthsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuthsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auths         []*AuthCredentials     `protobuf:"bytes,1,rep,name=auths,proto3" json:"auths,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthsResponse) Reset() {
+	*x = ListAuthsResponse{}
+	mi := &file_authservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthsResponse) ProtoMessage() {}
+
+func (x *ListAuthsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthsResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAuthsResponse) GetAuths() []*AuthCredentials {
+	if x != nil {
+		return x.Auths
+	}
+	return nil
+}
+
+func (x *ListAuthsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DisableAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableAuthRequest) Reset() {
+	*x = DisableAuthRequest{}
+	mi := &file_authservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableAuthRequest) ProtoMessage() {}
+
+func (x *DisableAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableAuthRequest.ProtoReflect.Descriptor instead.
+func (*DisableAuthRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DisableAuthRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type EnableAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableAuthRequest) Reset() {
+	*x = EnableAuthRequest{}
+	mi := &file_authservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableAuthRequest) ProtoMessage() {}
+
+func (x *EnableAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableAuthRequest.ProtoReflect.Descriptor instead.
+func (*EnableAuthRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EnableAuthRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_authservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateRoleRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+type DeleteAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuthRequest) Reset() {
+	*x = DeleteAuthRequest{}
+	mi := &file_authservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthRequest) ProtoMessage() {}
+
+func (x *DeleteAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAuthRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteAuthRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type DeleteAccountRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	AuthId string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	// Required unless the caller logged in within the last 5 minutes, which
+	// is how accounts without a password, from social login or guests,
+	// confirm the deletion.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_authservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteAccountRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CheckSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	IssueTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSessionRequest) Reset() {
+	*x = CheckSessionRequest{}
+	mi := &file_authservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSessionRequest) ProtoMessage() {}
+
+func (x *CheckSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSessionRequest.ProtoReflect.Descriptor instead.
+func (*CheckSessionRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckSessionRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *CheckSessionRequest) GetIssueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssueTime
+	}
+	return nil
+}
+
+type CheckSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSessionResponse) Reset() {
+	*x = CheckSessionResponse{}
+	mi := &file_authservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSessionResponse) ProtoMessage() {}
+
+func (x *CheckSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSessionResponse.ProtoReflect.Descriptor instead.
+func (*CheckSessionResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CheckSessionResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListContactsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  Roles                  `protobuf:"varint,1,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	// Defaults to 200, at most 1000.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_authservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListContactsRequest) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *ListContactsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListContactsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_authservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Contact) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Contact) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_authservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ListContactsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AuthId          string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	CurrentPassword string                 `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_authservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChangePasswordRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The start of the key, to tell keys apart.
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	RevokeTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_authservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	AuthId string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// One or more of "menu:read" and "menu:write".
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Defaults to 90 days from now, at most 365 days.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_authservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateAPIKeyRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The key to send in the "X-API-Key" header. It cannot be shown again.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_authservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_authservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAPIKeysRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_authservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_authservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeAPIKeyRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type VerifyAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyRequest) Reset() {
+	*x = VerifyAPIKeyRequest{}
+	mi := &file_authservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyRequest) ProtoMessage() {}
+
+func (x *VerifyAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyAPIKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type VerifyAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	AuthId        string                 `protobuf:"bytes,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,3,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyResponse) Reset() {
+	*x = VerifyAPIKeyResponse{}
+	mi := &file_authservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyResponse) ProtoMessage() {}
+
+func (x *VerifyAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VerifyAPIKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *VerifyAPIKeyResponse) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *VerifyAPIKeyResponse) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *VerifyAPIKeyResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type RiderDocuments struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LicenceNumber     string                 `protobuf:"bytes,1,opt,name=licence_number,json=licenceNumber,proto3" json:"licence_number,omitempty"`
+	LicenceExpireTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=licence_expire_time,json=licenceExpireTime,proto3" json:"licence_expire_time,omitempty"`
+	// One of "motorcycle", "car" and "bicycle".
+	VehicleType string `protobuf:"bytes,3,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	// Not required for bicycles.
+	VehiclePlate  string `protobuf:"bytes,4,opt,name=vehicle_plate,json=vehiclePlate,proto3" json:"vehicle_plate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiderDocuments) Reset() {
+	*x = RiderDocuments{}
+	mi := &file_authservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiderDocuments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderDocuments) ProtoMessage() {}
+
+func (x *RiderDocuments) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderDocuments.ProtoReflect.Descriptor instead.
+func (*RiderDocuments) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RiderDocuments) GetLicenceNumber() string {
+	if x != nil {
+		return x.LicenceNumber
+	}
+	return ""
+}
+
+func (x *RiderDocuments) GetLicenceExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenceExpireTime
+	}
+	return nil
+}
+
+func (x *RiderDocuments) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *RiderDocuments) GetVehiclePlate() string {
+	if x != nil {
+		return x.VehiclePlate
+	}
+	return ""
+}
+
+type RiderApplication struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RiderId string                 `protobuf:"bytes,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Status  RiderApplicationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ihavefood.RiderApplicationStatus" json:"status,omitempty"`
+	// Empty until the rider submits documents.
+	Documents     *RiderDocuments        `protobuf:"bytes,3,opt,name=documents,proto3" json:"documents,omitempty"`
+	SubmitTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	ReviewTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,6,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiderApplication) Reset() {
+	*x = RiderApplication{}
+	mi := &file_authservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiderApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderApplication) ProtoMessage() {}
+
+func (x *RiderApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderApplication.ProtoReflect.Descriptor instead.
+func (*RiderApplication) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RiderApplication) GetRiderId() string {
+	if x != nil {
+		return x.RiderId
+	}
+	return ""
+}
+
+func (x *RiderApplication) GetStatus() RiderApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RiderApplicationStatus_RIDER_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *RiderApplication) GetDocuments() *RiderDocuments {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *RiderApplication) GetSubmitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return nil
+}
+
+func (x *RiderApplication) GetReviewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewTime
+	}
+	return nil
+}
+
+func (x *RiderApplication) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *RiderApplication) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RiderApplication) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type SubmitRiderDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Documents     *RiderDocuments        `protobuf:"bytes,2,opt,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRiderDocumentsRequest) Reset() {
+	*x = SubmitRiderDocumentsRequest{}
+	mi := &file_authservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRiderDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRiderDocumentsRequest) ProtoMessage() {}
+
+func (x *SubmitRiderDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRiderDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRiderDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubmitRiderDocumentsRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SubmitRiderDocumentsRequest) GetDocuments() *RiderDocuments {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type GetRiderApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiderApplicationRequest) Reset() {
+	*x = GetRiderApplicationRequest{}
+	mi := &file_authservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiderApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiderApplicationRequest) ProtoMessage() {}
+
+func (x *GetRiderApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiderApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetRiderApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetRiderApplicationRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type ListRiderApplicationsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status RiderApplicationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ihavefood.RiderApplicationStatus" json:"status,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiderApplicationsRequest) Reset() {
+	*x = ListRiderApplicationsRequest{}
+	mi := &file_authservice_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiderApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiderApplicationsRequest) ProtoMessage() {}
+
+func (x *ListRiderApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiderApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiderApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListRiderApplicationsRequest) GetStatus() RiderApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RiderApplicationStatus_RIDER_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListRiderApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRiderApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRiderApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*RiderApplication    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiderApplicationsResponse) Reset() {
+	*x = ListRiderApplicationsResponse{}
+	mi := &file_authservice_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiderApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiderApplicationsResponse) ProtoMessage() {}
+
+func (x *ListRiderApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiderApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiderApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListRiderApplicationsResponse) GetApplications() []*RiderApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListRiderApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApproveRiderApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRiderApplicationRequest) Reset() {
+	*x = ApproveRiderApplicationRequest{}
+	mi := &file_authservice_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRiderApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRiderApplicationRequest) ProtoMessage() {}
+
+func (x *ApproveRiderApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRiderApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRiderApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApproveRiderApplicationRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type RejectRiderApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRiderApplicationRequest) Reset() {
+	*x = RejectRiderApplicationRequest{}
+	mi := &file_authservice_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRiderApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRiderApplicationRequest) ProtoMessage() {}
+
+func (x *RejectRiderApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRiderApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectRiderApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RejectRiderApplicationRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *RejectRiderApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SecurityEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Empty when a login failed for an unknown identifier.
+	AuthId        string                 `protobuf:"bytes,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Type          SecurityEventType      `protobuf:"varint,3,opt,name=type,proto3,enum=ihavefood.SecurityEventType" json:"type,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
+	mi := &file_authservice_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent) ProtoMessage() {}
+
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SecurityEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetType() SecurityEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityEventType_SECURITY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SecurityEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListSecurityEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required for non-admin callers, who can only list their own events.
+	AuthId string            `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Type   SecurityEventType `protobuf:"varint,2,opt,name=type,proto3,enum=ihavefood.SecurityEventType" json:"type,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityEventsRequest) Reset() {
+	*x = ListSecurityEventsRequest{}
+	mi := &file_authservice_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityEventsRequest) ProtoMessage() {}
+
+func (x *ListSecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecurityEventsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListSecurityEventsRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *ListSecurityEventsRequest) GetType() SecurityEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityEventType_SECURITY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListSecurityEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSecurityEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSecurityEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SecurityEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityEventsResponse) Reset() {
+	*x = ListSecurityEventsResponse{}
+	mi := &file_authservice_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityEventsResponse) ProtoMessage() {}
+
+func (x *ListSecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecurityEventsResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSecurityEventsResponse) GetEvents() []*SecurityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListSecurityEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportAuthDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuthDataRequest) Reset() {
+	*x = ExportAuthDataRequest{}
+	mi := &file_authservice_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuthDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuthDataRequest) ProtoMessage() {}
+
+func (x *ExportAuthDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuthDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuthDataRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportAuthDataRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type LinkedSocialIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	LinkTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedSocialIdentity) Reset() {
+	*x = LinkedSocialIdentity{}
+	mi := &file_authservice_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedSocialIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedSocialIdentity) ProtoMessage() {}
+
+func (x *LinkedSocialIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedSocialIdentity.ProtoReflect.Descriptor instead.
+func (*LinkedSocialIdentity) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LinkedSocialIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedSocialIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedSocialIdentity) GetLinkTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkTime
+	}
+	return nil
+}
+
+// AuthDataExport is the account metadata of auth. Password hashes, TOTP
+// secrets and recovery codes are left out.
+type AuthDataExport struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Credentials      *AuthCredentials        `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	TotpEnabled      bool                    `protobuf:"varint,2,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	SocialIdentities []*LinkedSocialIdentity `protobuf:"bytes,3,rep,name=social_identities,json=socialIdentities,proto3" json:"social_identities,omitempty"`
+	SecurityEvents   []*SecurityEvent        `protobuf:"bytes,4,rep,name=security_events,json=securityEvents,proto3" json:"security_events,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuthDataExport) Reset() {
+	*x = AuthDataExport{}
+	mi := &file_authservice_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthDataExport) ProtoMessage() {}
+
+func (x *AuthDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthDataExport.ProtoReflect.Descriptor instead.
+func (*AuthDataExport) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AuthDataExport) GetCredentials() *AuthCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *AuthDataExport) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *AuthDataExport) GetSocialIdentities() []*LinkedSocialIdentity {
+	if x != nil {
+		return x.SocialIdentities
+	}
+	return nil
+}
+
+func (x *AuthDataExport) GetSecurityEvents() []*SecurityEvent {
+	if x != nil {
+		return x.SecurityEvents
+	}
+	return nil
+}
+
+// Permission is a named action on a resource, such as "accounts:write".
+type Permission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_authservice_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Permission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Permission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// AccessRole is a named set of permissions given to admin accounts, such as
+// "support_agent". It is separate from Roles, which is the kind of account.
+type AccessRole struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Built-in roles are seeded by migrations and cannot be changed.
+	Builtin       bool                   `protobuf:"varint,4,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRole) Reset() {
+	*x = AccessRole{}
+	mi := &file_authservice_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole) ProtoMessage() {}
+
+func (x *AccessRole) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole.ProtoReflect.Descriptor instead.
+func (*AccessRole) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AccessRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccessRole) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccessRole) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *AccessRole) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AccessRole) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionsRequest) Reset() {
+	*x = ListPermissionsRequest{}
+	mi := &file_authservice_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsRequest) ProtoMessage() {}
+
+func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{61}
+}
+
+type ListPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionsResponse) Reset() {
+	*x = ListPermissionsResponse{}
+	mi := &file_authservice_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionsResponse) ProtoMessage() {}
+
+func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListAccessRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRolesRequest) Reset() {
+	*x = ListAccessRolesRequest{}
+	mi := &file_authservice_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRolesRequest) ProtoMessage() {}
+
+func (x *ListAccessRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessRolesRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{63}
+}
+
+type ListAccessRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessRoles   []*AccessRole          `protobuf:"bytes,1,rep,name=access_roles,json=accessRoles,proto3" json:"access_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRolesResponse) Reset() {
+	*x = ListAccessRolesResponse{}
+	mi := &file_authservice_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRolesResponse) ProtoMessage() {}
+
+func (x *ListAccessRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessRolesResponse) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAccessRolesResponse) GetAccessRoles() []*AccessRole {
+	if x != nil {
+		return x.AccessRoles
+	}
+	return nil
+}
+
+type PutAccessRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercase letters, digits and underscores, e.g. "finance".
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAccessRoleRequest) Reset() {
+	*x = PutAccessRoleRequest{}
+	mi := &file_authservice_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAccessRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAccessRoleRequest) ProtoMessage() {}
+
+func (x *PutAccessRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAccessRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutAccessRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PutAccessRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutAccessRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PutAccessRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type DeleteAccessRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccessRoleRequest) Reset() {
+	*x = DeleteAccessRoleRequest{}
+	mi := &file_authservice_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccessRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccessRoleRequest) ProtoMessage() {}
+
+func (x *DeleteAccessRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccessRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccessRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteAccessRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetAuthPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthPermissionsRequest) Reset() {
+	*x = GetAuthPermissionsRequest{}
+	mi := &file_authservice_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthPermissionsRequest) ProtoMessage() {}
+
+func (x *GetAuthPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetAuthPermissionsRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+type AuthPermissions struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AuthId      string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	AccessRoles []string               `protobuf:"bytes,2,rep,name=access_roles,json=accessRoles,proto3" json:"access_roles,omitempty"`
+	// Effective permissions, the union of the permissions of access_roles.
+	Permissions   []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthPermissions) Reset() {
+	*x = AuthPermissions{}
+	mi := &file_authservice_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPermissions) ProtoMessage() {}
+
+func (x *AuthPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPermissions.ProtoReflect.Descriptor instead.
+func (*AuthPermissions) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AuthPermissions) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *AuthPermissions) GetAccessRoles() []string {
+	if x != nil {
+		return x.AccessRoles
+	}
+	return nil
+}
+
+func (x *AuthPermissions) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type AssignAccessRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	RoleName      string                 `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignAccessRoleRequest) Reset() {
+	*x = AssignAccessRoleRequest{}
+	mi := &file_authservice_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignAccessRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignAccessRoleRequest) ProtoMessage() {}
+
+func (x *AssignAccessRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignAccessRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignAccessRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AssignAccessRoleRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *AssignAccessRoleRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type UnassignAccessRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	RoleName      string                 `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignAccessRoleRequest) Reset() {
+	*x = UnassignAccessRoleRequest{}
+	mi := &file_authservice_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignAccessRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignAccessRoleRequest) ProtoMessage() {}
+
+func (x *UnassignAccessRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authservice_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignAccessRoleRequest.ProtoReflect.Descriptor instead.
+func (*UnassignAccessRoleRequest) Descriptor() ([]byte, []int) {
+	return file_authservice_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UnassignAccessRoleRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *UnassignAccessRoleRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+var File_authservice_proto protoreflect.FileDescriptor
+
+const file_authservice_proto_rawDesc = "" +
+	"\n" +
+	"\x11authservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9f\x02\n" +
+	"\x0fAuthCredentials\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12$\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12\x14\n" +
+	"\x05guest\x18\b \x01(\bR\x05guest\"\xc5\x01\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role:Z\x92AW2U{\"email\": \"somsak@example.com\", \"password\": \"Newpa$sword9\", \"role\": \"ROLES_CUSTOMER\"}\"\xcf\x01\n" +
+	"\fLoginRequest\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role:]\x92AZ2X{\"identifier\": \"somsak22@mail.com\", \"password\": \"Newpa$sword9\",\"role\": \"ROLES_CUSTOMER\"}\"\xa6\x01\n" +
+	"\rLoginResponse\x124\n" +
+	"\x16second_factor_required\x18\x01 \x01(\bR\x14secondFactorRequired\x12/\n" +
+	"\x13enrollment_required\x18\x02 \x01(\bR\x12enrollmentRequired\x12.\n" +
+	"\x13second_factor_token\x18\x03 \x01(\tR\x11secondFactorToken\"\\\n" +
+	"\x16StartPhoneLoginRequest\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1f\n" +
+	"\vallow_guest\x18\x02 \x01(\bR\n" +
+	"allowGuest\"\x93\x01\n" +
+	"\x17StartPhoneLoginResponse\x12;\n" +
+	"\vexpire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12;\n" +
+	"\vresend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resendTime\"s\n" +
+	"\x19CompletePhoneLoginRequest\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1f\n" +
+	"\vallow_guest\x18\x03 \x01(\bR\n" +
+	"allowGuest\"`\n" +
+	"\x13UpgradeGuestRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"h\n" +
+	"\x11MergeGuestRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"5\n" +
+	"\x17StartSocialLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"]\n" +
+	"\x18StartSocialLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"b\n" +
+	"\x1aCompleteSocialLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xe1\x01\n" +
+	"\x19VerifySecondFactorRequest\x12.\n" +
+	"\x13second_factor_token\x18\x01 \x01(\tR\x11secondFactorToken\x12\x1d\n" +
+	"\ttotp_code\x18\x02 \x01(\tH\x00R\btotpCode\x12%\n" +
+	"\rrecovery_code\x18\x03 \x01(\tH\x00R\frecoveryCode:D\x92AA2?{\"second_factor_token\": \"eyJhbGciOi...\", \"totp_code\": \"123456\"}B\b\n" +
+	"\x06factor\"L\n" +
+	"\x1aBeginTOTPEnrollmentRequest\x12.\n" +
+	"\x13second_factor_token\x18\x01 \x01(\tR\x11secondFactorToken\"`\n" +
+	"\x1bBeginTOTPEnrollmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tR\x0fprovisioningUri\"b\n" +
+	"\x1cConfirmTOTPEnrollmentRequest\x12.\n" +
+	"\x13second_factor_token\x18\x01 \x01(\tR\x11secondFactorToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"F\n" +
+	"\x1dConfirmTOTPEnrollmentResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"|\n" +
+	"\x19RequestEmailChangeRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1b\n" +
+	"\tnew_email\x18\x02 \x01(\tR\bnewEmail\x12)\n" +
+	"\x10current_password\x18\x03 \x01(\tR\x0fcurrentPassword\"Y\n" +
+	"\x1aRequestEmailChangeResponse\x12;\n" +
+	"\vexpire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"H\n" +
+	"\x19ConfirmEmailChangeRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x82\x01\n" +
+	"\x1fRequestPhoneNumberChangeRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1b\n" +
+	"\tnew_phone\x18\x02 \x01(\tR\bnewPhone\x12)\n" +
+	"\x10current_password\x18\x03 \x01(\tR\x0fcurrentPassword\"_\n" +
+	" RequestPhoneNumberChangeResponse\x12;\n" +
+	"\vexpire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"N\n" +
+	"\x1fConfirmPhoneNumberChangeRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"F\n" +
+	"\x12CreateAdminRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xaf\x01\n" +
+	"\x10ListAuthsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12#\n" +
+	"\rdisabled_only\x18\x03 \x01(\bR\fdisabledOnly\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"m\n" +
+	"\x11ListAuthsResponse\x120\n" +
+	"\x05auths\x18\x01 \x03(\v2\x1a.ihavefood.AuthCredentialsR\x05auths\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x12DisableAuthRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\",\n" +
+	"\x11EnableAuthRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"p\n" +
+	"\x11UpdateRoleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role:\x1c\x92A\x192\x17{\"role\": \"ROLES_RIDER\"}\",\n" +
+	"\x11DeleteAuthRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"K\n" +
+	"\x14DeleteAccountRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"i\n" +
+	"\x13CheckSessionRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x129\n" +
+	"\n" +
+	"issue_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tissueTime\".\n" +
+	"\x14CheckSessionResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\"w\n" +
+	"\x13ListContactsRequest\x12$\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x98\x01\n" +
+	"\aContact\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fphone_number\x18\x03 \x01(\tR\vphoneNumber\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"n\n" +
+	"\x14ListContactsResponse\x12.\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x12.ihavefood.ContactR\bcontacts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfd\x01\n" +
+	"\x15ChangePasswordRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12)\n" +
+	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword:}\x92Az2x{\"auth_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\", \"current_password\": \"Newpa$sword9\", \"new_password\": \"Newpa$sword10\"}\"\xdc\x02\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12@\n" +
+	"\x0elast_used_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vrevoke_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revokeTime\"\xe2\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime:I\x92AF2D{\"name\": \"Front counter POS\", \"scopes\": [\"menu:read\", \"menu:write\"]}\"T\n" +
+	"\x14CreateAPIKeyResponse\x12*\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x11.ihavefood.APIKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"-\n" +
+	"\x12ListAPIKeysRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"C\n" +
+	"\x13ListAPIKeysResponse\x12,\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x11.ihavefood.APIKeyR\aapiKeys\"E\n" +
+	"\x13RevokeAPIKeyRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"'\n" +
+	"\x13VerifyAPIKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x84\x01\n" +
+	"\x14VerifyAPIKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x17\n" +
+	"\aauth_id\x18\x02 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"\xcb\x01\n" +
+	"\x0eRiderDocuments\x12%\n" +
+	"\x0elicence_number\x18\x01 \x01(\tR\rlicenceNumber\x12J\n" +
+	"\x13licence_expire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x11licenceExpireTime\x12!\n" +
+	"\fvehicle_type\x18\x03 \x01(\tR\vvehicleType\x12#\n" +
+	"\rvehicle_plate\x18\x04 \x01(\tR\fvehiclePlate\"\xba\x03\n" +
+	"\x10RiderApplication\x12\x19\n" +
+	"\brider_id\x18\x01 \x01(\tR\ariderId\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.ihavefood.RiderApplicationStatusR\x06status\x127\n" +
+	"\tdocuments\x18\x03 \x01(\v2\x19.ihavefood.RiderDocumentsR\tdocuments\x12;\n" +
+	"\vsubmit_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"submitTime\x12;\n" +
+	"\vreview_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewTime\x12#\n" +
+	"\rreject_reason\x18\x06 \x01(\tR\frejectReason\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x94\x02\n" +
+	"\x1bSubmitRiderDocumentsRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x127\n" +
+	"\tdocuments\x18\x02 \x01(\v2\x19.ihavefood.RiderDocumentsR\tdocuments:\xa2\x01\x92A\x9e\x012\x9b\x01{\"documents\": {\"licence_number\": \"12345678\", \"licence_expire_time\": \"2030-01-01T00:00:00Z\", \"vehicle_type\": \"motorcycle\", \"vehicle_plate\": \"1กข 1234\"}}\"5\n" +
+	"\x1aGetRiderApplicationRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"\x95\x01\n" +
+	"\x1cListRiderApplicationsRequest\x129\n" +
+	"\x06status\x18\x01 \x01(\x0e2!.ihavefood.RiderApplicationStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1dListRiderApplicationsResponse\x12?\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1b.ihavefood.RiderApplicationR\fapplications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"9\n" +
+	"\x1eApproveRiderApplicationRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"P\n" +
+	"\x1dRejectRiderApplicationRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xf0\x01\n" +
+	"\rSecurityEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aauth_id\x18\x02 \x01(\tR\x06authId\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.ihavefood.SecurityEventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xa2\x01\n" +
+	"\x19ListSecurityEventsRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.ihavefood.SecurityEventTypeR\x04type\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"v\n" +
+	"\x1aListSecurityEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.ihavefood.SecurityEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"0\n" +
+	"\x15ExportAuthDataRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"\x81\x01\n" +
+	"\x14LinkedSocialIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x127\n" +
+	"\tlink_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blinkTime\"\x82\x02\n" +
+	"\x0eAuthDataExport\x12<\n" +
+	"\vcredentials\x18\x01 \x01(\v2\x1a.ihavefood.AuthCredentialsR\vcredentials\x12!\n" +
+	"\ftotp_enabled\x18\x02 \x01(\bR\vtotpEnabled\x12L\n" +
+	"\x11social_identities\x18\x03 \x03(\v2\x1f.ihavefood.LinkedSocialIdentityR\x10socialIdentities\x12A\n" +
+	"\x0fsecurity_events\x18\x04 \x03(\v2\x18.ihavefood.SecurityEventR\x0esecurityEvents\"B\n" +
+	"\n" +
+	"Permission\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xf8\x01\n" +
+	"\n" +
+	"AccessRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x18\n" +
+	"\abuiltin\x18\x04 \x01(\bR\abuiltin\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x18\n" +
+	"\x16ListPermissionsRequest\"R\n" +
+	"\x17ListPermissionsResponse\x127\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x15.ihavefood.PermissionR\vpermissions\"\x18\n" +
+	"\x16ListAccessRolesRequest\"S\n" +
+	"\x17ListAccessRolesResponse\x128\n" +
+	"\faccess_roles\x18\x01 \x03(\v2\x15.ihavefood.AccessRoleR\vaccessRoles\"n\n" +
+	"\x14PutAccessRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"-\n" +
+	"\x17DeleteAccessRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x19GetAuthPermissionsRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\"o\n" +
+	"\x0fAuthPermissions\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12!\n" +
+	"\faccess_roles\x18\x02 \x03(\tR\vaccessRoles\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"O\n" +
+	"\x17AssignAccessRoleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1b\n" +
+	"\trole_name\x18\x02 \x01(\tR\broleName\"Q\n" +
+	"\x19UnassignAccessRoleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x1b\n" +
+	"\trole_name\x18\x02 \x01(\tR\broleName*\x7f\n" +
+	"\x05Roles\x12\x15\n" +
+	"\x11ROLES_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eROLES_CUSTOMER\x10\x01\x12\x0f\n" +
+	"\vROLES_RIDER\x10\x02\x12\x12\n" +
+	"\x0eROLES_MERCHANT\x10\x03\x12\x15\n" +
+	"\x11ROLES_SUPER_ADMIN\x10\x14\x12\x0f\n" +
+	"\vROLES_ADMIN\x10\x15*\xb6\x01\n" +
+	"\x16RiderApplicationStatus\x12(\n" +
+	"$RIDER_APPLICATION_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" RIDER_APPLICATION_STATUS_PENDING\x10\x01\x12%\n" +
+	"!RIDER_APPLICATION_STATUS_APPROVED\x10\x02\x12%\n" +
+	"!RIDER_APPLICATION_STATUS_REJECTED\x10\x03*\xe6\x05\n" +
+	"\x11SecurityEventType\x12#\n" +
+	"\x1fSECURITY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!SECURITY_EVENT_TYPE_LOGIN_SUCCESS\x10\x01\x12%\n" +
+	"!SECURITY_EVENT_TYPE_LOGIN_FAILURE\x10\x02\x12&\n" +
+	"\"SECURITY_EVENT_TYPE_ACCOUNT_LOCKED\x10\x03\x12(\n" +
+	"$SECURITY_EVENT_TYPE_PASSWORD_CHANGED\x10\x04\x12-\n" +
+	")SECURITY_EVENT_TYPE_SECOND_FACTOR_ENABLED\x10\x05\x12-\n" +
+	")SECURITY_EVENT_TYPE_SECOND_FACTOR_FAILURE\x10\x06\x12*\n" +
+	"&SECURITY_EVENT_TYPE_RECOVERY_CODE_USED\x10\a\x12'\n" +
+	"#SECURITY_EVENT_TYPE_ACCOUNT_DELETED\x10\b\x12+\n" +
+	"'SECURITY_EVENT_TYPE_SOCIAL_LOGIN_LINKED\x10\t\x12'\n" +
+	"#SECURITY_EVENT_TYPE_PHONE_CODE_SENT\x10\n" +
+	"\x12%\n" +
+	"!SECURITY_EVENT_TYPE_EMAIL_CHANGED\x10\v\x12,\n" +
+	"(SECURITY_EVENT_TYPE_PHONE_NUMBER_CHANGED\x10\f\x12'\n" +
+	"#SECURITY_EVENT_TYPE_API_KEY_CREATED\x10\r\x12'\n" +
+	"#SECURITY_EVENT_TYPE_API_KEY_REVOKED\x10\x0e\x12,\n" +
+	"(SECURITY_EVENT_TYPE_ACCESS_ROLE_ASSIGNED\x10\x0f\x12.\n" +
+	"*SECURITY_EVENT_TYPE_ACCESS_ROLE_UNASSIGNED\x10\x102\x80+\n" +
+	"\vAuthService\x12b\n" +
+	"\bRegister\x12\x1a.ihavefood.RegisterRequest\x1a\x1a.ihavefood.AuthCredentials\"\x1e\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/register\x12W\n" +
+	"\x05Login\x12\x17.ihavefood.LoginRequest\x1a\x18.ihavefood.LoginResponse\"\x1b\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/auth/login\x12{\n" +
+	"\x0fStartPhoneLogin\x12!.ihavefood.StartPhoneLoginRequest\x1a\".ihavefood.StartPhoneLoginResponse\"!\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/login/phone\x12~\n" +
+	"\x12CompletePhoneLogin\x12$.ihavefood.CompletePhoneLoginRequest\x1a\x18.ihavefood.LoginResponse\"(\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/login/phone/verify\x12\x8a\x01\n" +
+	"\x10StartSocialLogin\x12\".ihavefood.StartSocialLoginRequest\x1a#.ihavefood.StartSocialLoginResponse\"-\x92A\x02b\x00\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/auth/social/{provider}/start\x12\x88\x01\n" +
+	"\x13CompleteSocialLogin\x12%.ihavefood.CompleteSocialLoginRequest\x1a\x18.ihavefood.LoginResponse\"0\x92A\x02b\x00\x82\xd3\xe4\x93\x02%:\x01*\" /auth/social/{provider}/callback\x12\x7f\n" +
+	"\x12VerifySecondFactor\x12$.ihavefood.VerifySecondFactorRequest\x1a\x18.ihavefood.LoginResponse\")\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/auth/login/second-factor\x12\xac\x01\n" +
+	"\x13BeginTOTPEnrollment\x12%.ihavefood.BeginTOTPEnrollmentRequest\x1a&.ihavefood.BeginTOTPEnrollmentResponse\"F\x82\xd3\xe4\x93\x02@:\x01*Z!:\x01*\"\x1c/api/auth/second-factor/totp\"\x18/auth/second-factor/totp\x12\xc2\x01\n" +
+	"\x15ConfirmTOTPEnrollment\x12'.ihavefood.ConfirmTOTPEnrollmentRequest\x1a(.ihavefood.ConfirmTOTPEnrollmentResponse\"V\x82\xd3\xe4\x93\x02P:\x01*Z):\x01*\"$/api/auth/second-factor/totp/confirm\" /auth/second-factor/totp/confirm\x12\x87\x01\n" +
+	"\x12RequestEmailChange\x12$.ihavefood.RequestEmailChangeRequest\x1a%.ihavefood.RequestEmailChangeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/auth/{auth_id}/email\x12\x84\x01\n" +
+	"\x12ConfirmEmailChange\x12$.ihavefood.ConfirmEmailChangeRequest\x1a\x1a.ihavefood.AuthCredentials\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/auth/{auth_id}/email/confirm\x12\xa0\x01\n" +
+	"\x18RequestPhoneNumberChange\x12*.ihavefood.RequestPhoneNumberChangeRequest\x1a+.ihavefood.RequestPhoneNumberChangeResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/auth/{auth_id}/phone-number\x12\x97\x01\n" +
+	"\x18ConfirmPhoneNumberChange\x12*.ihavefood.ConfirmPhoneNumberChangeRequest\x1a\x1a.ihavefood.AuthCredentials\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/auth/{auth_id}/phone-number/confirm\x12f\n" +
+	"\vCreateAdmin\x12\x1d.ihavefood.CreateAdminRequest\x1a\x1a.ihavefood.AuthCredentials\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/admin/admins\x12`\n" +
+	"\tListAuths\x12\x1b.ihavefood.ListAuthsRequest\x1a\x1c.ihavefood.ListAuthsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/admin/auths\x12w\n" +
+	"\vDisableAuth\x12\x1d.ihavefood.DisableAuthRequest\x1a\x1a.ihavefood.AuthCredentials\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/admin/auths/{auth_id}/disable\x12t\n" +
+	"\n" +
+	"EnableAuth\x12\x1c.ihavefood.EnableAuthRequest\x1a\x1a.ihavefood.AuthCredentials\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/admin/auths/{auth_id}/enable\x12r\n" +
+	"\n" +
+	"UpdateRole\x12\x1c.ihavefood.UpdateRoleRequest\x1a\x1a.ihavefood.AuthCredentials\"*\x82\xd3\xe4\x93\x02$:\x01*2\x1f/api/admin/auths/{auth_id}/role\x12f\n" +
+	"\n" +
+	"DeleteAuth\x12\x1c.ihavefood.DeleteAuthRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/admin/auths/{auth_id}\x12r\n" +
+	"\fUpgradeGuest\x12\x1e.ihavefood.UpgradeGuestRequest\x1a\x1a.ihavefood.AuthCredentials\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/auth/{auth_id}/upgrade\x12j\n" +
+	"\n" +
+	"MergeGuest\x12\x1c.ihavefood.MergeGuestRequest\x1a\x18.ihavefood.LoginResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/auth/{auth_id}/merge\x12s\n" +
+	"\x0eChangePassword\x12 .ihavefood.ChangePasswordRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/api/auth/{auth_id}/password\x12o\n" +
+	"\rDeleteAccount\x12\x1f.ihavefood.DeleteAccountRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/auth/{auth_id}/delete\x12Q\n" +
+	"\fCheckSession\x12\x1e.ihavefood.CheckSessionRequest\x1a\x1f.ihavefood.CheckSessionResponse\"\x00\x12x\n" +
+	"\fCreateAPIKey\x12\x1e.ihavefood.CreateAPIKeyRequest\x1a\x1f.ihavefood.CreateAPIKeyResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/auth/{auth_id}/api-keys\x12r\n" +
+	"\vListAPIKeys\x12\x1d.ihavefood.ListAPIKeysRequest\x1a\x1e.ihavefood.ListAPIKeysResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/auth/{auth_id}/api-keys\x12z\n" +
+	"\fRevokeAPIKey\x12\x1e.ihavefood.RevokeAPIKeyRequest\x1a\x11.ihavefood.APIKey\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/auth/{auth_id}/api-keys/{key_id}/revoke\x12Q\n" +
+	"\fVerifyAPIKey\x12\x1e.ihavefood.VerifyAPIKeyRequest\x1a\x1f.ihavefood.VerifyAPIKeyResponse\"\x00\x12Q\n" +
+	"\fListContacts\x12\x1e.ihavefood.ListContactsRequest\x1a\x1f.ihavefood.ListContactsResponse\"\x00\x12O\n" +
+	"\x0eExportAuthData\x12 .ihavefood.ExportAuthDataRequest\x1a\x19.ihavefood.AuthDataExport\"\x00\x12\xac\x01\n" +
+	"\x12ListSecurityEvents\x12$.ihavefood.ListSecurityEventsRequest\x1a%.ihavefood.ListSecurityEventsResponse\"I\x82\xd3\xe4\x93\x02CZ\x1c\x12\x1a/api/admin/security-events\x12#/api/auth/{auth_id}/security-events\x12\x8d\x01\n" +
+	"\x14SubmitRiderDocuments\x12&.ihavefood.SubmitRiderDocumentsRequest\x1a\x1b.ihavefood.RiderApplication\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/auth/{auth_id}/rider-application\x12\xb3\x01\n" +
+	"\x13GetRiderApplication\x12%.ihavefood.GetRiderApplicationRequest\x1a\x1b.ihavefood.RiderApplication\"X\x82\xd3\xe4\x93\x02RZ)\x12'/api/admin/rider-applications/{auth_id}\x12%/api/auth/{auth_id}/rider-application\x12\x91\x01\n" +
+	"\x15ListRiderApplications\x12'.ihavefood.ListRiderApplicationsRequest\x1a(.ihavefood.ListRiderApplicationsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/admin/rider-applications\x12\x9d\x01\n" +
+	"\x17ApproveRiderApplication\x12).ihavefood.ApproveRiderApplicationRequest\x1a\x1b.ihavefood.RiderApplication\":\x82\xd3\xe4\x93\x024:\x01*\"//api/admin/rider-applications/{auth_id}/approve\x12\x9a\x01\n" +
+	"\x16RejectRiderApplication\x12(.ihavefood.RejectRiderApplicationRequest\x1a\x1b.ihavefood.RiderApplication\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/admin/rider-applications/{auth_id}/reject\x12x\n" +
+	"\x0fListPermissions\x12!.ihavefood.ListPermissionsRequest\x1a\".ihavefood.ListPermissionsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/admin/permissions\x12y\n" +
+	"\x0fListAccessRoles\x12!.ihavefood.ListAccessRolesRequest\x1a\".ihavefood.ListAccessRolesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/admin/access-roles\x12r\n" +
+	"\rPutAccessRole\x12\x1f.ihavefood.PutAccessRoleRequest\x1a\x15.ihavefood.AccessRole\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/admin/access-roles/{name}\x12v\n" +
+	"\x10DeleteAccessRole\x12\".ihavefood.DeleteAccessRoleRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/api/admin/access-roles/{name}\x12\x86\x01\n" +
+	"\x12GetAuthPermissions\x12$.ihavefood.GetAuthPermissionsRequest\x1a\x1a.ihavefood.AuthPermissions\".\x82\xd3\xe4\x93\x02(\x12&/api/admin/auths/{auth_id}/permissions\x12\x86\x01\n" +
+	"\x10AssignAccessRole\x12\".ihavefood.AssignAccessRoleRequest\x1a\x1a.ihavefood.AuthPermissions\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/admin/auths/{auth_id}/access-roles\x12\x93\x01\n" +
+	"\x12UnassignAccessRole\x12$.ihavefood.UnassignAccessRoleRequest\x1a\x1a.ihavefood.AuthPermissions\";\x82\xd3\xe4\x93\x025*3/api/admin/auths/{auth_id}/access-roles/{role_name}B\vZ\t/genprotob\x06proto3"
+
+var (
+	file_authservice_proto_rawDescOnce sync.Once
+	file_authservice_proto_rawDescData []byte
+)
+
+func file_authservice_proto_rawDescGZIP() []byte {
+	file_authservice_proto_rawDescOnce.Do(func() {
+		file_authservice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_authservice_proto_rawDesc), len(file_authservice_proto_rawDesc)))
+	})
+	return file_authservice_proto_rawDescData
+}
+
+var file_authservice_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_authservice_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_authservice_proto_goTypes = []any{
+	(Roles)(0),                               // 0: ihavefood.Roles
+	(RiderApplicationStatus)(0),              // 1: ihavefood.RiderApplicationStatus
+	(SecurityEventType)(0),                   // 2: ihavefood.SecurityEventType
+	(*AuthCredentials)(nil),                  // 3: ihavefood.AuthCredentials
+	(*RegisterRequest)(nil),                  // 4: ihavefood.RegisterRequest
+	(*LoginRequest)(nil),                     // 5: ihavefood.LoginRequest
+	(*LoginResponse)(nil),                    // 6: ihavefood.LoginResponse
+	(*StartPhoneLoginRequest)(nil),           // 7: ihavefood.StartPhoneLoginRequest
+	(*StartPhoneLoginResponse)(nil),          // 8: ihavefood.StartPhoneLoginResponse
+	(*CompletePhoneLoginRequest)(nil),        // 9: ihavefood.CompletePhoneLoginRequest
+	(*UpgradeGuestRequest)(nil),              // 10: ihavefood.UpgradeGuestRequest
+	(*MergeGuestRequest)(nil),                // 11: ihavefood.MergeGuestRequest
+	(*StartSocialLoginRequest)(nil),          // 12: ihavefood.StartSocialLoginRequest
+	(*StartSocialLoginResponse)(nil),         // 13: ihavefood.StartSocialLoginResponse
+	(*CompleteSocialLoginRequest)(nil),       // 14: ihavefood.CompleteSocialLoginRequest
+	(*VerifySecondFactorRequest)(nil),        // 15: ihavefood.VerifySecondFactorRequest
+	(*BeginTOTPEnrollmentRequest)(nil),       // 16: ihavefood.BeginTOTPEnrollmentRequest
+	(*BeginTOTPEnrollmentResponse)(nil),      // 17: ihavefood.BeginTOTPEnrollmentResponse
+	(*ConfirmTOTPEnrollmentRequest)(nil),     // 18: ihavefood.ConfirmTOTPEnrollmentRequest
+	(*ConfirmTOTPEnrollmentResponse)(nil),    // 19: ihavefood.ConfirmTOTPEnrollmentResponse
+	(*RequestEmailChangeRequest)(nil),        // 20: ihavefood.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),       // 21: ihavefood.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),        // 22: ihavefood.ConfirmEmailChangeRequest
+	(*RequestPhoneNumberChangeRequest)(nil),  // 23: ihavefood.RequestPhoneNumberChangeRequest
+	(*RequestPhoneNumberChangeResponse)(nil), // 24: ihavefood.RequestPhoneNumberChangeResponse
+	(*ConfirmPhoneNumberChangeRequest)(nil),  // 25: ihavefood.ConfirmPhoneNumberChangeRequest
+	(*CreateAdminRequest)(nil),               // 26: ihavefood.CreateAdminRequest
+	(*ListAuthsRequest)(nil),                 // 27: ihavefood.ListAuthsRequest
+	(*ListAuthsResponse)(nil),                // 28: ihavefood.ListAuthsResponse
+	(*DisableAuthRequest)(nil),               // 29: ihavefood.DisableAuthRequest
+	(*EnableAuthRequest)(nil),                // 30: ihavefood.EnableAuthRequest
+	(*UpdateRoleRequest)(nil),                // 31: ihavefood.UpdateRoleRequest
+	(*DeleteAuthRequest)(nil),                // 32: ihavefood.DeleteAuthRequest
+	(*DeleteAccountRequest)(nil),             // 33: ihavefood.DeleteAccountRequest
+	(*CheckSessionRequest)(nil),              // 34: ihavefood.CheckSessionRequest
+	(*CheckSessionResponse)(nil),             // 35: ihavefood.CheckSessionResponse
+	(*ListContactsRequest)(nil),              // 36: ihavefood.ListContactsRequest
+	(*Contact)(nil),                          // 37: ihavefood.Contact
+	(*ListContactsResponse)(nil),             // 38: ihavefood.ListContactsResponse
+	(*ChangePasswordRequest)(nil),            // 39: ihavefood.ChangePasswordRequest
+	(*APIKey)(nil),                           // 40: ihavefood.APIKey
+	(*CreateAPIKeyRequest)(nil),              // 41: ihavefood.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),             // 42: ihavefood.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),               // 43: ihavefood.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),              // 44: ihavefood.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),              // 45: ihavefood.RevokeAPIKeyRequest
+	(*VerifyAPIKeyRequest)(nil),              // 46: ihavefood.VerifyAPIKeyRequest
+	(*VerifyAPIKeyResponse)(nil),             // 47: ihavefood.VerifyAPIKeyResponse
+	(*RiderDocuments)(nil),                   // 48: ihavefood.RiderDocuments
+	(*RiderApplication)(nil),                 // 49: ihavefood.RiderApplication
+	(*SubmitRiderDocumentsRequest)(nil),      // 50: ihavefood.SubmitRiderDocumentsRequest
+	(*GetRiderApplicationRequest)(nil),       // 51: ihavefood.GetRiderApplicationRequest
+	(*ListRiderApplicationsRequest)(nil),     // 52: ihavefood.ListRiderApplicationsRequest
+	(*ListRiderApplicationsResponse)(nil),    // 53: ihavefood.ListRiderApplicationsResponse
+	(*ApproveRiderApplicationRequest)(nil),   // 54: ihavefood.ApproveRiderApplicationRequest
+	(*RejectRiderApplicationRequest)(nil),    // 55: ihavefood.RejectRiderApplicationRequest
+	(*SecurityEvent)(nil),                    // 56: ihavefood.SecurityEvent
+	(*ListSecurityEventsRequest)(nil),        // 57: ihavefood.ListSecurityEventsRequest
+	(*ListSecurityEventsResponse)(nil),       // 58: ihavefood.ListSecurityEventsResponse
+	(*ExportAuthDataRequest)(nil),            // 59: ihavefood.ExportAuthDataRequest
+	(*LinkedSocialIdentity)(nil),             // 60: ihavefood.LinkedSocialIdentity
+	(*AuthDataExport)(nil),                   // 61: ihavefood.AuthDataExport
+	(*Permission)(nil),                       // 62: ihavefood.Permission
+	(*AccessRole)(nil),                       // 63: ihavefood.AccessRole
+	(*ListPermissionsRequest)(nil),           // 64: ihavefood.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),          // 65: ihavefood.ListPermissionsResponse
+	(*ListAccessRolesRequest)(nil),           // 66: ihavefood.ListAccessRolesRequest
+	(*ListAccessRolesResponse)(nil),          // 67: ihavefood.ListAccessRolesResponse
+	(*PutAccessRoleRequest)(nil),             // 68: ihavefood.PutAccessRoleRequest
+	(*DeleteAccessRoleRequest)(nil),          // 69: ihavefood.DeleteAccessRoleRequest
+	(*GetAuthPermissionsRequest)(nil),        // 70: ihavefood.GetAuthPermissionsRequest
+	(*AuthPermissions)(nil),                  // 71: ihavefood.AuthPermissions
+	(*AssignAccessRoleRequest)(nil),          // 72: ihavefood.AssignAccessRoleRequest
+	(*UnassignAccessRoleRequest)(nil),        // 73: ihavefood.UnassignAccessRoleRequest
+	(*timestamppb.Timestamp)(nil),            // 74: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 75: google.protobuf.Empty
+}
+var file_authservice_proto_depIdxs = []int32{
+	0,  // 0: ihavefood.AuthCredentials.role:type_name -> ihavefood.Roles
+	74, // 1: ihavefood.AuthCredentials.create_time:type_name -> google.protobuf.Timestamp
+	74, // 2: ihavefood.AuthCredentials.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: ihavefood.RegisterRequest.role:type_name -> ihavefood.Roles
+	0,  // 4: ihavefood.LoginRequest.role:type_name -> ihavefood.Roles
+	74, // 5: ihavefood.StartPhoneLoginResponse.expire_time:type_name -> google.protobuf.Timestamp
+	74, // 6: ihavefood.StartPhoneLoginResponse.resend_time:type_name -> google.protobuf.Timestamp
+	74, // 7: ihavefood.RequestEmailChangeResponse.expire_time:type_name -> google.protobuf.Timestamp
+	74, // 8: ihavefood.RequestPhoneNumberChangeResponse.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: ihavefood.ListAuthsRequest.role:type_name -> ihavefood.Roles
+	3,  // 10: ihavefood.ListAuthsResponse.auths:type_name -> ihavefood.AuthCredentials
+	0,  // 11: ihavefood.UpdateRoleRequest.role:type_name -> ihavefood.Roles
+	74, // 12: ihavefood.CheckSessionRequest.issue_time:type_name -> google.protobuf.Timestamp
+	0,  // 13: ihavefood.ListContactsRequest.role:type_name -> ihavefood.Roles
+	74, // 14: ihavefood.Contact.update_time:type_name -> google.protobuf.Timestamp
+	37, // 15: ihavefood.ListContactsResponse.contacts:type_name -> ihavefood.Contact
+	74, // 16: ihavefood.APIKey.expire_time:type_name -> google.protobuf.Timestamp
+	74, // 17: ihavefood.APIKey.last_used_time:type_name -> google.protobuf.Timestamp
+	74, // 18: ihavefood.APIKey.create_time:type_name -> google.protobuf.Timestamp
+	74, // 19: ihavefood.APIKey.revoke_time:type_name -> google.protobuf.Timestamp
+	74, // 20: ihavefood.CreateAPIKeyRequest.expire_time:type_name -> google.protobuf.Timestamp
+	40, // 21: ihavefood.CreateAPIKeyResponse.api_key:type_name -> ihavefood.APIKey
+	40, // 22: ihavefood.ListAPIKeysResponse.api_keys:type_name -> ihavefood.APIKey
+	0,  // 23: ihavefood.VerifyAPIKeyResponse.role:type_name -> ihavefood.Roles
+	74, // 24: ihavefood.RiderDocuments.licence_expire_time:type_name -> google.protobuf.Timestamp
+	1,  // 25: ihavefood.RiderApplication.status:type_name -> ihavefood.RiderApplicationStatus
+	48, // 26: ihavefood.RiderApplication.documents:type_name -> ihavefood.RiderDocuments
+	74, // 27: ihavefood.RiderApplication.submit_time:type_name -> google.protobuf.Timestamp
+	74, // 28: ihavefood.RiderApplication.review_time:type_name -> google.protobuf.Timestamp
+	74, // 29: ihavefood.RiderApplication.create_time:type_name -> google.protobuf.Timestamp
+	74, // 30: ihavefood.RiderApplication.update_time:type_name -> google.protobuf.Timestamp
+	48, // 31: ihavefood.SubmitRiderDocumentsRequest.documents:type_name -> ihavefood.RiderDocuments
+	1,  // 32: ihavefood.ListRiderApplicationsRequest.status:type_name -> ihavefood.RiderApplicationStatus
+	49, // 33: ihavefood.ListRiderApplicationsResponse.applications:type_name -> ihavefood.RiderApplication
+	2,  // 34: ihavefood.SecurityEvent.type:type_name -> ihavefood.SecurityEventType
+	74, // 35: ihavefood.SecurityEvent.create_time:type_name -> google.protobuf.Timestamp
+	2,  // 36: ihavefood.ListSecurityEventsRequest.type:type_name -> ihavefood.SecurityEventType
+	56, // 37: ihavefood.ListSecurityEventsResponse.events:type_name -> ihavefood.SecurityEvent
+	74, // 38: ihavefood.LinkedSocialIdentity.link_time:type_name -> google.protobuf.Timestamp
+	3,  // 39: ihavefood.AuthDataExport.credentials:type_name -> ihavefood.AuthCredentials
+	60, // 40: ihavefood.AuthDataExport.social_identities:type_name -> ihavefood.LinkedSocialIdentity
+	56, // 41: ihavefood.AuthDataExport.security_events:type_name -> ihavefood.SecurityEvent
+	74, // 42: ihavefood.AccessRole.create_time:type_name -> google.protobuf.Timestamp
+	74, // 43: ihavefood.AccessRole.update_time:type_name -> google.protobuf.Timestamp
+	62, // 44: ihavefood.ListPermissionsResponse.permissions:type_name -> ihavefood.Permission
+	63, // 45: ihavefood.ListAccessRolesResponse.access_roles:type_name -> ihavefood.AccessRole
+	4,  // 46: ihavefood.AuthService.Register:input_type -> ihavefood.RegisterRequest
+	5,  // 47: ihavefood.AuthService.Login:input_type -> ihavefood.LoginRequest
+	7,  // 48: ihavefood.AuthService.StartPhoneLogin:input_type -> ihavefood.StartPhoneLoginRequest
+	9,  // 49: ihavefood.AuthService.CompletePhoneLogin:input_type -> ihavefood.CompletePhoneLoginRequest
+	12, // 50: ihavefood.AuthService.StartSocialLogin:input_type -> ihavefood.StartSocialLoginRequest
+	14, // 51: ihavefood.AuthService.CompleteSocialLogin:input_type -> ihavefood.CompleteSocialLoginRequest
+	15, // 52: ihavefood.AuthService.VerifySecondFactor:input_type -> ihavefood.VerifySecondFactorRequest
+	16, // 53: ihavefood.AuthService.BeginTOTPEnrollment:input_type -> ihavefood.BeginTOTPEnrollmentRequest
+	18, // 54: ihavefood.AuthService.ConfirmTOTPEnrollment:input_type -> ihavefood.ConfirmTOTPEnrollmentRequest
+	20, // 55: ihavefood.AuthService.RequestEmailChange:input_type -> ihavefood.RequestEmailChangeRequest
+	22, // 56: ihavefood.AuthService.ConfirmEmailChange:input_type -> ihavefood.ConfirmEmailChangeRequest
+	23, // 57: ihavefood.AuthService.RequestPhoneNumberChange:input_type -> ihavefood.RequestPhoneNumberChangeRequest
+	25, // 58: ihavefood.AuthService.ConfirmPhoneNumberChange:input_type -> ihavefood.ConfirmPhoneNumberChangeRequest
+	26, // 59: ihavefood.AuthService.CreateAdmin:input_type -> ihavefood.CreateAdminRequest
+	27, // 60: ihavefood.AuthService.ListAuths:input_type -> ihavefood.ListAuthsRequest
+	29, // 61: ihavefood.AuthService.DisableAuth:input_type -> ihavefood.DisableAuthRequest
+	30, // 62: ihavefood.AuthService.EnableAuth:input_type -> ihavefood.EnableAuthRequest
+	31, // 63: ihavefood.AuthService.UpdateRole:input_type -> ihavefood.UpdateRoleRequest
+	32, // 64: ihavefood.AuthService.DeleteAuth:input_type -> ihavefood.DeleteAuthRequest
+	10, // 65: ihavefood.AuthService.UpgradeGuest:input_type -> ihavefood.UpgradeGuestRequest
+	11, // 66: ihavefood.AuthService.MergeGuest:input_type -> ihavefood.MergeGuestRequest
+	39, // 67: ihavefood.AuthService.ChangePassword:input_type -> ihavefood.ChangePasswordRequest
+	33, // 68: ihavefood.AuthService.DeleteAccount:input_type -> ihavefood.DeleteAccountRequest
+	34, // 69: ihavefood.AuthService.CheckSession:input_type -> ihavefood.CheckSessionRequest
+	41, // 70: ihavefood.AuthService.CreateAPIKey:input_type -> ihavefood.CreateAPIKeyRequest
+	43, // 71: ihavefood.AuthService.ListAPIKeys:input_type -> ihavefood.ListAPIKeysRequest
+	45, // 72: ihavefood.AuthService.RevokeAPIKey:input_type -> ihavefood.RevokeAPIKeyRequest
+	46, // 73: ihavefood.AuthService.VerifyAPIKey:input_type -> ihavefood.VerifyAPIKeyRequest
+	36, // 74: ihavefood.AuthService.ListContacts:input_type -> ihavefood.ListContactsRequest
+	59, // 75: ihavefood.AuthService.ExportAuthData:input_type -> ihavefood.ExportAuthDataRequest
+	57, // 76: ihavefood.AuthService.ListSecurityEvents:input_type -> ihavefood.ListSecurityEventsRequest
+	50, // 77: ihavefood.AuthService.SubmitRiderDocuments:input_type -> ihavefood.SubmitRiderDocumentsRequest
+	51, // 78: ihavefood.AuthService.GetRiderApplication:input_type -> ihavefood.GetRiderApplicationRequest
+	52, // 79: ihavefood.AuthService.ListRiderApplications:input_type -> ihavefood.ListRiderApplicationsRequest
+	54, // 80: ihavefood.AuthService.ApproveRiderApplication:input_type -> ihavefood.ApproveRiderApplicationRequest
+	55, // 81: ihavefood.AuthService.RejectRiderApplication:input_type -> ihavefood.RejectRiderApplicationRequest
+	64, // 82: ihavefood.AuthService.ListPermissions:input_type -> ihavefood.ListPermissionsRequest
+	66, // 83: ihavefood.AuthService.ListAccessRoles:input_type -> ihavefood.ListAccessRolesRequest
+	68, // 84: ihavefood.AuthService.PutAccessRole:input_type -> ihavefood.PutAccessRoleRequest
+	69, // 85: ihavefood.AuthService.DeleteAccessRole:input_type -> ihavefood.DeleteAccessRoleRequest
+	70, // 86: ihavefood.AuthService.GetAuthPermissions:input_type -> ihavefood.GetAuthPermissionsRequest
+	72, // 87: ihavefood.AuthService.AssignAccessRole:input_type -> ihavefood.AssignAccessRoleRequest
+	73, // 88: ihavefood.AuthService.UnassignAccessRole:input_type -> ihavefood.UnassignAccessRoleRequest
+	3,  // 89: ihavefood.AuthService.Register:output_type -> ihavefood.AuthCredentials
+	6,  // 90: ihavefood.AuthService.Login:output_type -> ihavefood.LoginResponse
+	8,  // 91: ihavefood.AuthService.StartPhoneLogin:output_type -> ihavefood.StartPhoneLoginResponse
+	6,  // 92: ihavefood.AuthService.CompletePhoneLogin:output_type -> ihavefood.LoginResponse
+	13, // 93: ihavefood.AuthService.StartSocialLogin:output_type -> ihavefood.StartSocialLoginResponse
+	6,  // 94: ihavefood.AuthService.CompleteSocialLogin:output_type -> ihavefood.LoginResponse
+	6,  // 95: ihavefood.AuthService.VerifySecondFactor:output_type -> ihavefood.LoginResponse
+	17, // 96: ihavefood.AuthService.BeginTOTPEnrollment:output_type -> ihavefood.BeginTOTPEnrollmentResponse
+	19, // 97: ihavefood.AuthService.ConfirmTOTPEnrollment:output_type -> ihavefood.ConfirmTOTPEnrollmentResponse
+	21, // 98: ihavefood.AuthService.RequestEmailChange:output_type -> ihavefood.RequestEmailChangeResponse
+	3,  // 99: ihavefood.AuthService.ConfirmEmailChange:output_type -> ihavefood.AuthCredentials
+	24, // 100: ihavefood.AuthService.RequestPhoneNumberChange:output_type -> ihavefood.RequestPhoneNumberChangeResponse
+	3,  // 101: ihavefood.AuthService.ConfirmPhoneNumberChange:output_type -> ihavefood.AuthCredentials
+	3,  // 102: ihavefood.AuthService.CreateAdmin:output_type -> ihavefood.AuthCredentials
+	28, // 103: ihavefood.AuthService.ListAuths:output_type -> ihavefood.ListAuthsResponse
+	3,  // 104: ihavefood.AuthService.DisableAuth:output_type -> ihavefood.AuthCredentials
+	3,  // 105: ihavefood.AuthService.EnableAuth:output_type -> ihavefood.AuthCredentials
+	3,  // 106: ihavefood.AuthService.UpdateRole:output_type -> ihavefood.AuthCredentials
+	75, // 107: ihavefood.AuthService.DeleteAuth:output_type -> google.protobuf.Empty
+	3,  // 108: ihavefood.AuthService.UpgradeGuest:output_type -> ihavefood.AuthCredentials
+	6,  // 109: ihavefood.AuthService.MergeGuest:output_type -> ihavefood.LoginResponse
+	75, // 110: ihavefood.AuthService.ChangePassword:output_type -> google.protobuf.Empty
+	75, // 111: ihavefood.AuthService.DeleteAccount:output_type -> google.protobuf.Empty
+	35, // 112: ihavefood.AuthService.CheckSession:output_type -> ihavefood.CheckSessionResponse
+	42, // 113: ihavefood.AuthService.CreateAPIKey:output_type -> ihavefood.CreateAPIKeyResponse
+	44, // 114: ihavefood.AuthService.ListAPIKeys:output_type -> ihavefood.ListAPIKeysResponse
+	40, // 115: ihavefood.AuthService.RevokeAPIKey:output_type -> ihavefood.APIKey
+	47, // 116: ihavefood.AuthService.VerifyAPIKey:output_type -> ihavefood.VerifyAPIKeyResponse
+	38, // 117: ihavefood.AuthService.ListContacts:output_type -> ihavefood.ListContactsResponse
+	61, // 118: ihavefood.AuthService.ExportAuthData:output_type -> ihavefood.AuthDataExport
+	58, // 119: ihavefood.AuthService.ListSecurityEvents:output_type -> ihavefood.ListSecurityEventsResponse
+	49, // 120: ihavefood.AuthService.SubmitRiderDocuments:output_type -> ihavefood.RiderApplication
+	49, // 121: ihavefood.AuthService.GetRiderApplication:output_type -> ihavefood.RiderApplication
+	53, // 122: ihavefood.AuthService.ListRiderApplications:output_type -> ihavefood.ListRiderApplicationsResponse
+	49, // 123: ihavefood.AuthService.ApproveRiderApplication:output_type -> ihavefood.RiderApplication
+	49, // 124: ihavefood.AuthService.RejectRiderApplication:output_type -> ihavefood.RiderApplication
+	65, // 125: ihavefood.AuthService.ListPermissions:output_type -> ihavefood.ListPermissionsResponse
+	67, // 126: ihavefood.AuthService.ListAccessRoles:output_type -> ihavefood.ListAccessRolesResponse
+	63, // 127: ihavefood.AuthService.PutAccessRole:output_type -> ihavefood.AccessRole
+	75, // 128: ihavefood.AuthService.DeleteAccessRole:output_type -> google.protobuf.Empty
+	71, // 129: ihavefood.AuthService.GetAuthPermissions:output_type -> ihavefood.AuthPermissions
+	71, // 130: ihavefood.AuthService.AssignAccessRole:output_type -> ihavefood.AuthPermissions
+	71, // 131: ihavefood.AuthService.UnassignAccessRole:output_type -> ihavefood.AuthPermissions
+	89, // [89:132] is the sub-list for method output_type
+	46, // [46:89] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_authservice_proto_init() }
+func file_authservice_proto_init() {
+	if File_authservice_proto != nil {
+		return
+	}
+	file_authservice_proto_msgTypes[12].OneofWrappers = []any{
+		(*VerifySecondFactorRequest_TotpCode)(nil),
+		(*VerifySecondFactorRequest_RecoveryCode)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authservice_proto_rawDesc), len(file_authservice_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,7 +33,7 @@ const (
 // ---------------------COUPON SERVICE-----------------------------
 // Manages coupons.
 type CouponServiceClient interface {
-	// ListCoupons shows all coupons.
+	//ListCoupons shows all coupons.
 	ListCoupons(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCouponsResponse, error)
 	// GetCoupon shows a valid coupon by code.
 	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*Coupon, error)
@@ -97,7 +97,7 @@ func (c *couponServiceClient) RedeemCoupon(ctx context.Context, in *RedeemCoupon
 // ---------------------COUPON SERVICE-----------------------------
 // Manages coupons.
 type CouponServiceServer interface {
-	// ListCoupons shows all coupons.
+	//ListCoupons shows all coupons.
 	ListCoupons(context.Context, *emptypb.Empty) (*ListCouponsResponse, error)
 	// GetCoupon shows a valid coupon by code.
 	GetCoupon(context.Context, *GetCouponRequest) (*Coupon, error)
@@ -20,14 +20,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CustomerService_ListCustomers_FullMethodName         = "/ihavefood.CustomerService/ListCustomers"
-	CustomerService_GetCustomer_FullMethodName           = "/ihavefood.CustomerService/GetCustomer"
-	CustomerService_CreateAddress_FullMethodName         = "/ihavefood.CustomerService/CreateAddress"
-	CustomerService_UpdateCustomerInfo_FullMethodName    = "/ihavefood.CustomerService/UpdateCustomerInfo"
-	CustomerService_UpdateCustomerSocial_FullMethodName  = "/ihavefood.CustomerService/UpdateCustomerSocial"
-	CustomerService_UpdateCustomerAddress_FullMethodName = "/ihavefood.CustomerService/UpdateCustomerAddress"
-	CustomerService_DeleteCustomer_FullMethodName        = "/ihavefood.CustomerService/DeleteCustomer"
-	CustomerService_DeleteCustomerAddress_FullMethodName = "/ihavefood.CustomerService/DeleteCustomerAddress"
+	CustomerService_ListCustomers_FullMethodName                 = "/ihavefood.CustomerService/ListCustomers"
+	CustomerService_GetCustomer_FullMethodName                   = "/ihavefood.CustomerService/GetCustomer"
+	CustomerService_CreateAddress_FullMethodName                 = "/ihavefood.CustomerService/CreateAddress"
+	CustomerService_UpdateCustomerInfo_FullMethodName            = "/ihavefood.CustomerService/UpdateCustomerInfo"
+	CustomerService_UpdateCustomerSocial_FullMethodName          = "/ihavefood.CustomerService/UpdateCustomerSocial"
+	CustomerService_UploadCustomerPicture_FullMethodName         = "/ihavefood.CustomerService/UploadCustomerPicture"
+	CustomerService_DeleteCustomerPicture_FullMethodName         = "/ihavefood.CustomerService/DeleteCustomerPicture"
+	CustomerService_UpdateCustomerAddress_FullMethodName         = "/ihavefood.CustomerService/UpdateCustomerAddress"
+	CustomerService_DeleteCustomer_FullMethodName                = "/ihavefood.CustomerService/DeleteCustomer"
+	CustomerService_DeleteCustomerAddress_FullMethodName         = "/ihavefood.CustomerService/DeleteCustomerAddress"
+	CustomerService_SuggestAddresses_FullMethodName              = "/ihavefood.CustomerService/SuggestAddresses"
+	CustomerService_ListFavourites_FullMethodName                = "/ihavefood.CustomerService/ListFavourites"
+	CustomerService_AddFavouriteMerchant_FullMethodName          = "/ihavefood.CustomerService/AddFavouriteMerchant"
+	CustomerService_RemoveFavouriteMerchant_FullMethodName       = "/ihavefood.CustomerService/RemoveFavouriteMerchant"
+	CustomerService_AddFavouriteMenuItem_FullMethodName          = "/ihavefood.CustomerService/AddFavouriteMenuItem"
+	CustomerService_RemoveFavouriteMenuItem_FullMethodName       = "/ihavefood.CustomerService/RemoveFavouriteMenuItem"
+	CustomerService_RequestDataExport_FullMethodName             = "/ihavefood.CustomerService/RequestDataExport"
+	CustomerService_GetDataExport_FullMethodName                 = "/ihavefood.CustomerService/GetDataExport"
+	CustomerService_DownloadDataExport_FullMethodName            = "/ihavefood.CustomerService/DownloadDataExport"
+	CustomerService_GetLoyaltyBalance_FullMethodName             = "/ihavefood.CustomerService/GetLoyaltyBalance"
+	CustomerService_ListLoyaltyTransactions_FullMethodName       = "/ihavefood.CustomerService/ListLoyaltyTransactions"
+	CustomerService_RedeemLoyaltyPoints_FullMethodName           = "/ihavefood.CustomerService/RedeemLoyaltyPoints"
+	CustomerService_ReverseLoyaltyRedemption_FullMethodName      = "/ihavefood.CustomerService/ReverseLoyaltyRedemption"
+	CustomerService_GetWallet_FullMethodName                     = "/ihavefood.CustomerService/GetWallet"
+	CustomerService_ListWalletTransactions_FullMethodName        = "/ihavefood.CustomerService/ListWalletTransactions"
+	CustomerService_RedeemGiftCard_FullMethodName                = "/ihavefood.CustomerService/RedeemGiftCard"
+	CustomerService_TopUpWallet_FullMethodName                   = "/ihavefood.CustomerService/TopUpWallet"
+	CustomerService_IssueGiftCard_FullMethodName                 = "/ihavefood.CustomerService/IssueGiftCard"
+	CustomerService_PayWithWallet_FullMethodName                 = "/ihavefood.CustomerService/PayWithWallet"
+	CustomerService_RefundToWallet_FullMethodName                = "/ihavefood.CustomerService/RefundToWallet"
+	CustomerService_GetNotificationPreferences_FullMethodName    = "/ihavefood.CustomerService/GetNotificationPreferences"
+	CustomerService_UpdateNotificationPreferences_FullMethodName = "/ihavefood.CustomerService/UpdateNotificationPreferences"
+	CustomerService_ListNotifications_FullMethodName             = "/ihavefood.CustomerService/ListNotifications"
+	CustomerService_MarkNotificationsRead_FullMethodName         = "/ihavefood.CustomerService/MarkNotificationsRead"
+	CustomerService_GetUnreadNotificationCount_FullMethodName    = "/ihavefood.CustomerService/GetUnreadNotificationCount"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -36,16 +63,96 @@ const (
 //
 // ---------------------CUSTOMER SERVICE------------------------------
 type CustomerServiceClient interface {
-	// ListCustomers shows all customers profile.
+	// ListCustomers pages through the customer profiles, newest first unless
+	// sorted otherwise.
 	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
 	// GetCustomer shows a customer profile.
 	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*Customer, error)
 	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*Address, error)
+	// UpdateCustomerInfo updates the profile of the customer. Email and phone
+	// number are changed in auth and copied here.
 	UpdateCustomerInfo(ctx context.Context, in *UpdateCustomerInfoRequest, opts ...grpc.CallOption) (*Customer, error)
 	UpdateCustomerSocial(ctx context.Context, in *UpdateCustomerSocialRequest, opts ...grpc.CallOption) (*Customer, error)
+	// UploadCustomerPicture sets the profile picture of the customer. The
+	// content is a JPEG or PNG image of at most 5 MiB. It is cropped square
+	// and resized to the sizes of CustomerPicture, without its metadata.
+	UploadCustomerPicture(ctx context.Context, in *UploadCustomerPictureRequest, opts ...grpc.CallOption) (*CustomerPicture, error)
+	DeleteCustomerPicture(ctx context.Context, in *DeleteCustomerPictureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCustomerAddress(ctx context.Context, in *UpdateCustomerAddressRequest, opts ...grpc.CallOption) (*Address, error)
 	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCustomerAddress(ctx context.Context, in *DeleteCustomerAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SuggestAddresses completes a partial Thai address for address forms.
+	// Addresses are written with the English names of the suggestions.
+	SuggestAddresses(ctx context.Context, in *SuggestAddressesRequest, opts ...grpc.CallOption) (*SuggestAddressesResponse, error)
+	// ListFavourites lists the favourite merchants and menu items of the
+	// customer, newest first.
+	ListFavourites(ctx context.Context, in *ListFavouritesRequest, opts ...grpc.CallOption) (*ListFavouritesResponse, error)
+	AddFavouriteMerchant(ctx context.Context, in *AddFavouriteMerchantRequest, opts ...grpc.CallOption) (*FavouriteMerchant, error)
+	RemoveFavouriteMerchant(ctx context.Context, in *RemoveFavouriteMerchantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddFavouriteMenuItem(ctx context.Context, in *AddFavouriteMenuItemRequest, opts ...grpc.CallOption) (*FavouriteMenuItem, error)
+	RemoveFavouriteMenuItem(ctx context.Context, in *RemoveFavouriteMenuItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RequestDataExport starts collecting the personal data of the customer
+	// into a ZIP archive of JSON files. It returns the export in progress
+	// when there is one.
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*DataExport, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*DataExport, error)
+	// DownloadDataExport streams the archive of a succeeded export until it
+	// expires, in chunks small enough for any message size limit. Over HTTP
+	// the chunks come as newline delimited JSON.
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataExportArchive], error)
+	// GetLoyaltyBalance returns the points the customer can redeem. Points are
+	// earned on delivered orders and expire a while after they were earned.
+	GetLoyaltyBalance(ctx context.Context, in *GetLoyaltyBalanceRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error)
+	// ListLoyaltyTransactions lists the points ledger of the customer, newest
+	// first.
+	ListLoyaltyTransactions(ctx context.Context, in *ListLoyaltyTransactionsRequest, opts ...grpc.CallOption) (*ListLoyaltyTransactionsResponse, error)
+	// RedeemLoyaltyPoints spends points for a discount on an order, points
+	// expiring first are spent first. It is called by orders at checkout;
+	// redeeming again for the same order returns the first redemption. Only
+	// the order service can call it.
+	RedeemLoyaltyPoints(ctx context.Context, in *RedeemLoyaltyPointsRequest, opts ...grpc.CallOption) (*RedeemLoyaltyPointsResponse, error)
+	// ReverseLoyaltyRedemption gives back the points redeemed for an order
+	// that was cancelled. Orders without a redemption are ignored. Only the
+	// order service can call it.
+	ReverseLoyaltyRedemption(ctx context.Context, in *ReverseLoyaltyRedemptionRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error)
+	// GetWallet returns the wallet balance of the customer, the sum of its
+	// ledger entries.
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*Wallet, error)
+	// ListWalletTransactions lists the transactions of the wallet, newest
+	// first.
+	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error)
+	// RedeemGiftCard adds the amount of a gift card to the wallet. A gift card
+	// is redeemed once, by the customer into their own wallet.
+	RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*WalletTransaction, error)
+	// TopUpWallet adds money to the wallet. It is called by the payment
+	// service once the payment provider confirmed the charge, other callers
+	// are refused.
+	TopUpWallet(ctx context.Context, in *TopUpWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error)
+	// IssueGiftCard creates a gift card for sale. The code grants the amount
+	// to whoever redeems it first. It is stored hashed and returned in full
+	// by the first issue only, retries return the gift card without it. Only
+	// the payment service can call it.
+	IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*GiftCard, error)
+	// PayWithWallet pays an order from the wallet. It is called by orders at
+	// checkout, paying again for the same order returns the first payment.
+	// Only the order service can call it.
+	PayWithWallet(ctx context.Context, in *PayWithWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error)
+	// RefundToWallet refunds a cancelled order to the wallet, whatever the
+	// order was paid with. An order is refunded once. Only the order service
+	// can call it.
+	RefundToWallet(ctx context.Context, in *RefundToWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error)
+	// GetNotificationPreferences returns whether the customer receives each
+	// category of notifications on each channel, defaults included.
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// UpdateNotificationPreferences changes the listed preferences, the
+	// others are left as they are.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// ListNotifications lists the in-app inbox of the customer, newest first.
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// MarkNotificationsRead marks notifications of the inbox as read, all of
+	// them when no IDs are given.
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error)
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error)
 }
 
 type customerServiceClient struct {
@@ -106,6 +213,26 @@ func (c *customerServiceClient) UpdateCustomerSocial(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *customerServiceClient) UploadCustomerPicture(ctx context.Context, in *UploadCustomerPictureRequest, opts ...grpc.CallOption) (*CustomerPicture, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerPicture)
+	err := c.cc.Invoke(ctx, CustomerService_UploadCustomerPicture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteCustomerPicture(ctx context.Context, in *DeleteCustomerPictureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteCustomerPicture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerServiceClient) UpdateCustomerAddress(ctx context.Context, in *UpdateCustomerAddressRequest, opts ...grpc.CallOption) (*Address, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Address)
@@ -136,22 +263,361 @@ func (c *customerServiceClient) DeleteCustomerAddress(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *customerServiceClient) SuggestAddresses(ctx context.Context, in *SuggestAddressesRequest, opts ...grpc.CallOption) (*SuggestAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestAddressesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_SuggestAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListFavourites(ctx context.Context, in *ListFavouritesRequest, opts ...grpc.CallOption) (*ListFavouritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavouritesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListFavourites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AddFavouriteMerchant(ctx context.Context, in *AddFavouriteMerchantRequest, opts ...grpc.CallOption) (*FavouriteMerchant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavouriteMerchant)
+	err := c.cc.Invoke(ctx, CustomerService_AddFavouriteMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RemoveFavouriteMerchant(ctx context.Context, in *RemoveFavouriteMerchantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CustomerService_RemoveFavouriteMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AddFavouriteMenuItem(ctx context.Context, in *AddFavouriteMenuItemRequest, opts ...grpc.CallOption) (*FavouriteMenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavouriteMenuItem)
+	err := c.cc.Invoke(ctx, CustomerService_AddFavouriteMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RemoveFavouriteMenuItem(ctx context.Context, in *RemoveFavouriteMenuItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CustomerService_RemoveFavouriteMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*DataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataExport)
+	err := c.cc.Invoke(ctx, CustomerService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*DataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataExport)
+	err := c.cc.Invoke(ctx, CustomerService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataExportArchive], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[0], CustomerService_DownloadDataExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDataExportRequest, DataExportArchive]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDataExportClient = grpc.ServerStreamingClient[DataExportArchive]
+
+func (c *customerServiceClient) GetLoyaltyBalance(ctx context.Context, in *GetLoyaltyBalanceRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoyaltyBalance)
+	err := c.cc.Invoke(ctx, CustomerService_GetLoyaltyBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListLoyaltyTransactions(ctx context.Context, in *ListLoyaltyTransactionsRequest, opts ...grpc.CallOption) (*ListLoyaltyTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoyaltyTransactionsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListLoyaltyTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RedeemLoyaltyPoints(ctx context.Context, in *RedeemLoyaltyPointsRequest, opts ...grpc.CallOption) (*RedeemLoyaltyPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemLoyaltyPointsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_RedeemLoyaltyPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReverseLoyaltyRedemption(ctx context.Context, in *ReverseLoyaltyRedemptionRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoyaltyBalance)
+	err := c.cc.Invoke(ctx, CustomerService_ReverseLoyaltyRedemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*Wallet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Wallet)
+	err := c.cc.Invoke(ctx, CustomerService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletTransactionsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListWalletTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*WalletTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletTransaction)
+	err := c.cc.Invoke(ctx, CustomerService_RedeemGiftCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) TopUpWallet(ctx context.Context, in *TopUpWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletTransaction)
+	err := c.cc.Invoke(ctx, CustomerService_TopUpWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*GiftCard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GiftCard)
+	err := c.cc.Invoke(ctx, CustomerService_IssueGiftCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) PayWithWallet(ctx context.Context, in *PayWithWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletTransaction)
+	err := c.cc.Invoke(ctx, CustomerService_PayWithWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RefundToWallet(ctx context.Context, in *RefundToWalletRequest, opts ...grpc.CallOption) (*WalletTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletTransaction)
+	err := c.cc.Invoke(ctx, CustomerService_RefundToWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, CustomerService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnreadNotificationCount)
+	err := c.cc.Invoke(ctx, CustomerService_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*UnreadNotificationCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnreadNotificationCount)
+	err := c.cc.Invoke(ctx, CustomerService_GetUnreadNotificationCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServiceServer is the server API for CustomerService service.
 // All implementations must embed UnimplementedCustomerServiceServer
 // for forward compatibility.
 //
 // ---------------------CUSTOMER SERVICE------------------------------
 type CustomerServiceServer interface {
-	// ListCustomers shows all customers profile.
+	// ListCustomers pages through the customer profiles, newest first unless
+	// sorted otherwise.
 	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
 	// GetCustomer shows a customer profile.
 	GetCustomer(context.Context, *GetCustomerRequest) (*Customer, error)
 	CreateAddress(context.Context, *CreateAddressRequest) (*Address, error)
+	// UpdateCustomerInfo updates the profile of the customer. Email and phone
+	// number are changed in auth and copied here.
 	UpdateCustomerInfo(context.Context, *UpdateCustomerInfoRequest) (*Customer, error)
 	UpdateCustomerSocial(context.Context, *UpdateCustomerSocialRequest) (*Customer, error)
+	// UploadCustomerPicture sets the profile picture of the customer. The
+	// content is a JPEG or PNG image of at most 5 MiB. It is cropped square
+	// and resized to the sizes of CustomerPicture, without its metadata.
+	UploadCustomerPicture(context.Context, *UploadCustomerPictureRequest) (*CustomerPicture, error)
+	DeleteCustomerPicture(context.Context, *DeleteCustomerPictureRequest) (*emptypb.Empty, error)
 	UpdateCustomerAddress(context.Context, *UpdateCustomerAddressRequest) (*Address, error)
 	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*emptypb.Empty, error)
 	DeleteCustomerAddress(context.Context, *DeleteCustomerAddressRequest) (*emptypb.Empty, error)
+	// SuggestAddresses completes a partial Thai address for address forms.
+	// Addresses are written with the English names of the suggestions.
+	SuggestAddresses(context.Context, *SuggestAddressesRequest) (*SuggestAddressesResponse, error)
+	// ListFavourites lists the favourite merchants and menu items of the
+	// customer, newest first.
+	ListFavourites(context.Context, *ListFavouritesRequest) (*ListFavouritesResponse, error)
+	AddFavouriteMerchant(context.Context, *AddFavouriteMerchantRequest) (*FavouriteMerchant, error)
+	RemoveFavouriteMerchant(context.Context, *RemoveFavouriteMerchantRequest) (*emptypb.Empty, error)
+	AddFavouriteMenuItem(context.Context, *AddFavouriteMenuItemRequest) (*FavouriteMenuItem, error)
+	RemoveFavouriteMenuItem(context.Context, *RemoveFavouriteMenuItemRequest) (*emptypb.Empty, error)
+	// RequestDataExport starts collecting the personal data of the customer
+	// into a ZIP archive of JSON files. It returns the export in progress
+	// when there is one.
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*DataExport, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*DataExport, error)
+	// DownloadDataExport streams the archive of a succeeded export until it
+	// expires, in chunks small enough for any message size limit. Over HTTP
+	// the chunks come as newline delimited JSON.
+	DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DataExportArchive]) error
+	// GetLoyaltyBalance returns the points the customer can redeem. Points are
+	// earned on delivered orders and expire a while after they were earned.
+	GetLoyaltyBalance(context.Context, *GetLoyaltyBalanceRequest) (*LoyaltyBalance, error)
+	// ListLoyaltyTransactions lists the points ledger of the customer, newest
+	// first.
+	ListLoyaltyTransactions(context.Context, *ListLoyaltyTransactionsRequest) (*ListLoyaltyTransactionsResponse, error)
+	// RedeemLoyaltyPoints spends points for a discount on an order, points
+	// expiring first are spent first. It is called by orders at checkout;
+	// redeeming again for the same order returns the first redemption. Only
+	// the order service can call it.
+	RedeemLoyaltyPoints(context.Context, *RedeemLoyaltyPointsRequest) (*RedeemLoyaltyPointsResponse, error)
+	// ReverseLoyaltyRedemption gives back the points redeemed for an order
+	// that was cancelled. Orders without a redemption are ignored. Only the
+	// order service can call it.
+	ReverseLoyaltyRedemption(context.Context, *ReverseLoyaltyRedemptionRequest) (*LoyaltyBalance, error)
+	// GetWallet returns the wallet balance of the customer, the sum of its
+	// ledger entries.
+	GetWallet(context.Context, *GetWalletRequest) (*Wallet, error)
+	// ListWalletTransactions lists the transactions of the wallet, newest
+	// first.
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error)
+	// RedeemGiftCard adds the amount of a gift card to the wallet. A gift card
+	// is redeemed once, by the customer into their own wallet.
+	RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*WalletTransaction, error)
+	// TopUpWallet adds money to the wallet. It is called by the payment
+	// service once the payment provider confirmed the charge, other callers
+	// are refused.
+	TopUpWallet(context.Context, *TopUpWalletRequest) (*WalletTransaction, error)
+	// IssueGiftCard creates a gift card for sale. The code grants the amount
+	// to whoever redeems it first. It is stored hashed and returned in full
+	// by the first issue only, retries return the gift card without it. Only
+	// the payment service can call it.
+	IssueGiftCard(context.Context, *IssueGiftCardRequest) (*GiftCard, error)
+	// PayWithWallet pays an order from the wallet. It is called by orders at
+	// checkout, paying again for the same order returns the first payment.
+	// Only the order service can call it.
+	PayWithWallet(context.Context, *PayWithWalletRequest) (*WalletTransaction, error)
+	// RefundToWallet refunds a cancelled order to the wallet, whatever the
+	// order was paid with. An order is refunded once. Only the order service
+	// can call it.
+	RefundToWallet(context.Context, *RefundToWalletRequest) (*WalletTransaction, error)
+	// GetNotificationPreferences returns whether the customer receives each
+	// category of notifications on each channel, defaults included.
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error)
+	// UpdateNotificationPreferences changes the listed preferences, the
+	// others are left as they are.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error)
+	// ListNotifications lists the in-app inbox of the customer, newest first.
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// MarkNotificationsRead marks notifications of the inbox as read, all of
+	// them when no IDs are given.
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*UnreadNotificationCount, error)
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*UnreadNotificationCount, error)
 	mustEmbedUnimplementedCustomerServiceServer()
 }
 
@@ -177,6 +643,12 @@ func (UnimplementedCustomerServiceServer) UpdateCustomerInfo(context.Context, *U
 func (UnimplementedCustomerServiceServer) UpdateCustomerSocial(context.Context, *UpdateCustomerSocialRequest) (*Customer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomerSocial not implemented")
 }
+func (UnimplementedCustomerServiceServer) UploadCustomerPicture(context.Context, *UploadCustomerPictureRequest) (*CustomerPicture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadCustomerPicture not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteCustomerPicture(context.Context, *DeleteCustomerPictureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomerPicture not implemented")
+}
 func (UnimplementedCustomerServiceServer) UpdateCustomerAddress(context.Context, *UpdateCustomerAddressRequest) (*Address, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomerAddress not implemented")
 }
@@ -186,6 +658,81 @@ func (UnimplementedCustomerServiceServer) DeleteCustomer(context.Context, *Delet
 func (UnimplementedCustomerServiceServer) DeleteCustomerAddress(context.Context, *DeleteCustomerAddressRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomerAddress not implemented")
 }
+func (UnimplementedCustomerServiceServer) SuggestAddresses(context.Context, *SuggestAddressesRequest) (*SuggestAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestAddresses not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListFavourites(context.Context, *ListFavouritesRequest) (*ListFavouritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavourites not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddFavouriteMerchant(context.Context, *AddFavouriteMerchantRequest) (*FavouriteMerchant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavouriteMerchant not implemented")
+}
+func (UnimplementedCustomerServiceServer) RemoveFavouriteMerchant(context.Context, *RemoveFavouriteMerchantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavouriteMerchant not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddFavouriteMenuItem(context.Context, *AddFavouriteMenuItemRequest) (*FavouriteMenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavouriteMenuItem not implemented")
+}
+func (UnimplementedCustomerServiceServer) RemoveFavouriteMenuItem(context.Context, *RemoveFavouriteMenuItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavouriteMenuItem not implemented")
+}
+func (UnimplementedCustomerServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*DataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*DataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedCustomerServiceServer) DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DataExportArchive]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetLoyaltyBalance(context.Context, *GetLoyaltyBalanceRequest) (*LoyaltyBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoyaltyBalance not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListLoyaltyTransactions(context.Context, *ListLoyaltyTransactionsRequest) (*ListLoyaltyTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoyaltyTransactions not implemented")
+}
+func (UnimplementedCustomerServiceServer) RedeemLoyaltyPoints(context.Context, *RedeemLoyaltyPointsRequest) (*RedeemLoyaltyPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemLoyaltyPoints not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReverseLoyaltyRedemption(context.Context, *ReverseLoyaltyRedemptionRequest) (*LoyaltyBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLoyaltyRedemption not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetWallet(context.Context, *GetWalletRequest) (*Wallet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
+}
+func (UnimplementedCustomerServiceServer) RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*WalletTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemGiftCard not implemented")
+}
+func (UnimplementedCustomerServiceServer) TopUpWallet(context.Context, *TopUpWalletRequest) (*WalletTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpWallet not implemented")
+}
+func (UnimplementedCustomerServiceServer) IssueGiftCard(context.Context, *IssueGiftCardRequest) (*GiftCard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueGiftCard not implemented")
+}
+func (UnimplementedCustomerServiceServer) PayWithWallet(context.Context, *PayWithWalletRequest) (*WalletTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayWithWallet not implemented")
+}
+func (UnimplementedCustomerServiceServer) RefundToWallet(context.Context, *RefundToWalletRequest) (*WalletTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundToWallet not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedCustomerServiceServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*UnreadNotificationCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*UnreadNotificationCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
 func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
 func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
 
@@ -297,6 +844,42 @@ func _CustomerService_UpdateCustomerSocial_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_UploadCustomerPicture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCustomerPictureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UploadCustomerPicture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UploadCustomerPicture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UploadCustomerPicture(ctx, req.(*UploadCustomerPictureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteCustomerPicture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerPictureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteCustomerPicture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteCustomerPicture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteCustomerPicture(ctx, req.(*DeleteCustomerPictureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CustomerService_UpdateCustomerAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCustomerAddressRequest)
 	if err := dec(in); err != nil {
@@ -351,13 +934,456 @@ func _CustomerService_DeleteCustomerAddress_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-// CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var CustomerService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ihavefood.CustomerService",
-	HandlerType: (*CustomerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _CustomerService_SuggestAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SuggestAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SuggestAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SuggestAddresses(ctx, req.(*SuggestAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListFavourites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListFavourites(ctx, req.(*ListFavouritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddFavouriteMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddFavouriteMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddFavouriteMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddFavouriteMerchant(ctx, req.(*AddFavouriteMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RemoveFavouriteMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavouriteMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RemoveFavouriteMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RemoveFavouriteMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RemoveFavouriteMerchant(ctx, req.(*RemoveFavouriteMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddFavouriteMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteMenuItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddFavouriteMenuItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddFavouriteMenuItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddFavouriteMenuItem(ctx, req.(*AddFavouriteMenuItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RemoveFavouriteMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavouriteMenuItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RemoveFavouriteMenuItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RemoveFavouriteMenuItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RemoveFavouriteMenuItem(ctx, req.(*RemoveFavouriteMenuItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomerServiceServer).DownloadDataExport(m, &grpc.GenericServerStream[DownloadDataExportRequest, DataExportArchive]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_DownloadDataExportServer = grpc.ServerStreamingServer[DataExportArchive]
+
+func _CustomerService_GetLoyaltyBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoyaltyBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetLoyaltyBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetLoyaltyBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetLoyaltyBalance(ctx, req.(*GetLoyaltyBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListLoyaltyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoyaltyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListLoyaltyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListLoyaltyTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListLoyaltyTransactions(ctx, req.(*ListLoyaltyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RedeemLoyaltyPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemLoyaltyPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RedeemLoyaltyPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RedeemLoyaltyPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RedeemLoyaltyPoints(ctx, req.(*RedeemLoyaltyPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReverseLoyaltyRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseLoyaltyRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReverseLoyaltyRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReverseLoyaltyRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReverseLoyaltyRedemption(ctx, req.(*ReverseLoyaltyRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListWalletTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListWalletTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListWalletTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RedeemGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RedeemGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RedeemGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RedeemGiftCard(ctx, req.(*RedeemGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_TopUpWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).TopUpWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_TopUpWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).TopUpWallet(ctx, req.(*TopUpWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_IssueGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).IssueGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_IssueGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).IssueGiftCard(ctx, req.(*IssueGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_PayWithWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayWithWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).PayWithWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_PayWithWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).PayWithWallet(ctx, req.(*PayWithWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RefundToWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundToWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RefundToWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RefundToWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RefundToWallet(ctx, req.(*RefundToWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetUnreadNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustomerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ihavefood.CustomerService",
+	HandlerType: (*CustomerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "ListCustomers",
 			Handler:    _CustomerService_ListCustomers_Handler,
@@ -378,6 +1404,14 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCustomerSocial",
 			Handler:    _CustomerService_UpdateCustomerSocial_Handler,
 		},
+		{
+			MethodName: "UploadCustomerPicture",
+			Handler:    _CustomerService_UploadCustomerPicture_Handler,
+		},
+		{
+			MethodName: "DeleteCustomerPicture",
+			Handler:    _CustomerService_DeleteCustomerPicture_Handler,
+		},
 		{
 			MethodName: "UpdateCustomerAddress",
 			Handler:    _CustomerService_UpdateCustomerAddress_Handler,
@@ -390,7 +1424,109 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCustomerAddress",
 			Handler:    _CustomerService_DeleteCustomerAddress_Handler,
 		},
+		{
+			MethodName: "SuggestAddresses",
+			Handler:    _CustomerService_SuggestAddresses_Handler,
+		},
+		{
+			MethodName: "ListFavourites",
+			Handler:    _CustomerService_ListFavourites_Handler,
+		},
+		{
+			MethodName: "AddFavouriteMerchant",
+			Handler:    _CustomerService_AddFavouriteMerchant_Handler,
+		},
+		{
+			MethodName: "RemoveFavouriteMerchant",
+			Handler:    _CustomerService_RemoveFavouriteMerchant_Handler,
+		},
+		{
+			MethodName: "AddFavouriteMenuItem",
+			Handler:    _CustomerService_AddFavouriteMenuItem_Handler,
+		},
+		{
+			MethodName: "RemoveFavouriteMenuItem",
+			Handler:    _CustomerService_RemoveFavouriteMenuItem_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _CustomerService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _CustomerService_GetDataExport_Handler,
+		},
+		{
+			MethodName: "GetLoyaltyBalance",
+			Handler:    _CustomerService_GetLoyaltyBalance_Handler,
+		},
+		{
+			MethodName: "ListLoyaltyTransactions",
+			Handler:    _CustomerService_ListLoyaltyTransactions_Handler,
+		},
+		{
+			MethodName: "RedeemLoyaltyPoints",
+			Handler:    _CustomerService_RedeemLoyaltyPoints_Handler,
+		},
+		{
+			MethodName: "ReverseLoyaltyRedemption",
+			Handler:    _CustomerService_ReverseLoyaltyRedemption_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _CustomerService_GetWallet_Handler,
+		},
+		{
+			MethodName: "ListWalletTransactions",
+			Handler:    _CustomerService_ListWalletTransactions_Handler,
+		},
+		{
+			MethodName: "RedeemGiftCard",
+			Handler:    _CustomerService_RedeemGiftCard_Handler,
+		},
+		{
+			MethodName: "TopUpWallet",
+			Handler:    _CustomerService_TopUpWallet_Handler,
+		},
+		{
+			MethodName: "IssueGiftCard",
+			Handler:    _CustomerService_IssueGiftCard_Handler,
+		},
+		{
+			MethodName: "PayWithWallet",
+			Handler:    _CustomerService_PayWithWallet_Handler,
+		},
+		{
+			MethodName: "RefundToWallet",
+			Handler:    _CustomerService_RefundToWallet_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _CustomerService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _CustomerService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _CustomerService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _CustomerService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _CustomerService_GetUnreadNotificationCount_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _CustomerService_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "customerservice.proto",
 }
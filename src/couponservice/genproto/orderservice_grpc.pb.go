@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	OrderService_ListOrderHistory_FullMethodName = "/ihavefood.OrderService/ListOrderHistory"
 	OrderService_CreatePlaceOrder_FullMethodName = "/ihavefood.OrderService/CreatePlaceOrder"
+	OrderService_CancelPlaceOrder_FullMethodName = "/ihavefood.OrderService/CancelPlaceOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -30,9 +31,12 @@ const (
 // ---------------------ORDER SERVICE------------------------------
 // Manages place order process.
 type OrderServiceClient interface {
-	// ListOrderHistory retrives Customer's place order history by CustomerID
+	//  ListOrderHistory retrives Customer's place order history by CustomerID
 	ListOrderHistory(ctx context.Context, in *ListOrderHistoryRequest, opts ...grpc.CallOption) (*ListOrderHistoryResponse, error)
 	CreatePlaceOrder(ctx context.Context, in *CreatePlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrder, error)
+	// CancelPlaceOrder cancels an order the merchant has not accepted yet.
+	// Redeemed loyalty points are given back.
+	CancelPlaceOrder(ctx context.Context, in *CancelPlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrder, error)
 }
 
 type orderServiceClient struct {
@@ -63,6 +67,16 @@ func (c *orderServiceClient) CreatePlaceOrder(ctx context.Context, in *CreatePla
 	return out, nil
 }
 
+func (c *orderServiceClient) CancelPlaceOrder(ctx context.Context, in *CancelPlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceOrder)
+	err := c.cc.Invoke(ctx, OrderService_CancelPlaceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -70,9 +84,12 @@ func (c *orderServiceClient) CreatePlaceOrder(ctx context.Context, in *CreatePla
 // ---------------------ORDER SERVICE------------------------------
 // Manages place order process.
 type OrderServiceServer interface {
-	// ListOrderHistory retrives Customer's place order history by CustomerID
+	//  ListOrderHistory retrives Customer's place order history by CustomerID
 	ListOrderHistory(context.Context, *ListOrderHistoryRequest) (*ListOrderHistoryResponse, error)
 	CreatePlaceOrder(context.Context, *CreatePlaceOrderRequest) (*PlaceOrder, error)
+	// CancelPlaceOrder cancels an order the merchant has not accepted yet.
+	// Redeemed loyalty points are given back.
+	CancelPlaceOrder(context.Context, *CancelPlaceOrderRequest) (*PlaceOrder, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -89,6 +106,9 @@ func (UnimplementedOrderServiceServer) ListOrderHistory(context.Context, *ListOr
 func (UnimplementedOrderServiceServer) CreatePlaceOrder(context.Context, *CreatePlaceOrderRequest) (*PlaceOrder, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaceOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) CancelPlaceOrder(context.Context, *CancelPlaceOrderRequest) (*PlaceOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPlaceOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -146,6 +166,24 @@ func _OrderService_CreatePlaceOrder_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CancelPlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelPlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelPlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelPlaceOrder(ctx, req.(*CancelPlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +199,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePlaceOrder",
 			Handler:    _OrderService_CreatePlaceOrder_Handler,
 		},
+		{
+			MethodName: "CancelPlaceOrder",
+			Handler:    _OrderService_CancelPlaceOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orderservice.proto",
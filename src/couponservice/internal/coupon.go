@@ -10,7 +10,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/pongsathonn/ihavefood/src/couponservice/genproto"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -69,11 +71,43 @@ func (x *CouponService) AddCoupon(ctx context.Context, in *pb.AddCouponRequest)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	return &pb.Coupon{
+	added := &pb.Coupon{
 		Code:          coupon.Code,
 		ExpiresInHour: coupon.Expiration.Unix(),
 		QuantityCount: coupon.Quantity,
-	}, nil
+	}
+	x.publishCouponAdded(ctx, added, in)
+
+	return added, nil
+}
+
+// publishCouponAdded announces the coupon to customers. The coupon is added
+// already, so failures are only logged.
+func (x *CouponService) publishCouponAdded(ctx context.Context, coupon *pb.Coupon, in *pb.AddCouponRequest) {
+
+	event := &pb.CouponAddedEvent{
+		Coupon:  proto.Clone(coupon).(*pb.Coupon),
+		AddTime: timestamppb.Now(),
+	}
+	switch dt := in.DiscountType.(type) {
+	case *pb.AddCouponRequest_PercentDiscount:
+		event.Coupon.CouponDetails = &pb.Coupon_PercentDiscount{PercentDiscount: dt.PercentDiscount}
+	case *pb.AddCouponRequest_FreeDelivery:
+		event.Coupon.CouponDetails = &pb.Coupon_FreeDelivery{FreeDelivery: dt.FreeDelivery}
+	}
+
+	body, err := proto.Marshal(event)
+	if err != nil {
+		slog.Error("protobuf marshal failed", "err", err)
+		return
+	}
+
+	if err := publish(ctx, x.rabbitmq, "coupon.added.event", amqp.Publishing{
+		Type: "ihavefood.CouponAddedEvent",
+		Body: body,
+	}); err != nil {
+		slog.Error("rabbitmq publish", "err", err, "code", coupon.Code)
+	}
 }
 
 func (x *CouponService) GetCoupon(ctx context.Context, in *pb.GetCouponRequest) (*pb.Coupon, error) {
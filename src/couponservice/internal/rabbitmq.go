@@ -1,4 +1,37 @@
 package internal
 
-func TODO() {
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func publish(ctx context.Context, conn *amqp.Connection, routingKey string, msg amqp.Publishing) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	err = ch.ExchangeDeclare(
+		"my_exchange", // name
+		"direct",      // type
+		true,          // durable
+		false,         // auto-deleted
+		false,         // internal
+		false,         // no-wait
+		nil,           // arguments
+	)
+	if err != nil {
+		return err
+	}
+
+	return ch.PublishWithContext(
+		ctx,
+		"my_exchange", // exchange
+		routingKey,    // routing key
+		false,         // mandatory
+		false,         // immediate
+		msg,
+	)
 }
@@ -191,6 +191,221 @@ func (x *MerchantAcceptedEvent) GetAcceptTime() *timestamppb.Timestamp {
 	return nil
 }
 
+// Routing key is "merchant.updated.event", published with the whole merchant
+// after its profile or menu changed.
+type MerchantUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantUpdatedEvent) Reset() {
+	*x = MerchantUpdatedEvent{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantUpdatedEvent) ProtoMessage() {}
+
+func (x *MerchantUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*MerchantUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MerchantUpdatedEvent) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+func (x *MerchantUpdatedEvent) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Routing key is "order.delivered.event", published by orders once the
+// order is delivered.
+type OrderDeliveredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *PlaceOrder            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	DeliverTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deliver_time,json=deliverTime,proto3" json:"deliver_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderDeliveredEvent) Reset() {
+	*x = OrderDeliveredEvent{}
+	mi := &file_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderDeliveredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderDeliveredEvent) ProtoMessage() {}
+
+func (x *OrderDeliveredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderDeliveredEvent.ProtoReflect.Descriptor instead.
+func (*OrderDeliveredEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderDeliveredEvent) GetOrder() *PlaceOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderDeliveredEvent) GetDeliverTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverTime
+	}
+	return nil
+}
+
+// Routing key is "order.cancelled.event".
+type OrderCancelledEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *PlaceOrder            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	CancelTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cancel_time,json=cancelTime,proto3" json:"cancel_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelledEvent) Reset() {
+	*x = OrderCancelledEvent{}
+	mi := &file_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelledEvent) ProtoMessage() {}
+
+func (x *OrderCancelledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelledEvent.ProtoReflect.Descriptor instead.
+func (*OrderCancelledEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderCancelledEvent) GetOrder() *PlaceOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderCancelledEvent) GetCancelTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelTime
+	}
+	return nil
+}
+
+// Routing key is "coupon.added.event", published by coupons when a coupon
+// is added.
+type CouponAddedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	AddTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponAddedEvent) Reset() {
+	*x = CouponAddedEvent{}
+	mi := &file_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponAddedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponAddedEvent) ProtoMessage() {}
+
+func (x *CouponAddedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponAddedEvent.ProtoReflect.Descriptor instead.
+func (*CouponAddedEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CouponAddedEvent) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *CouponAddedEvent) GetAddTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddTime
+	}
+	return nil
+}
+
 type RiderNotifiedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -201,7 +416,7 @@ type RiderNotifiedEvent struct {
 
 func (x *RiderNotifiedEvent) Reset() {
 	*x = RiderNotifiedEvent{}
-	mi := &file_events_proto_msgTypes[2]
+	mi := &file_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +428,7 @@ func (x *RiderNotifiedEvent) String() string {
 func (*RiderNotifiedEvent) ProtoMessage() {}
 
 func (x *RiderNotifiedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[2]
+	mi := &file_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +441,7 @@ func (x *RiderNotifiedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RiderNotifiedEvent.ProtoReflect.Descriptor instead.
 func (*RiderNotifiedEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{2}
+	return file_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RiderNotifiedEvent) GetOrderId() string {
@@ -254,7 +469,7 @@ type RiderAssignedEvent struct {
 
 func (x *RiderAssignedEvent) Reset() {
 	*x = RiderAssignedEvent{}
-	mi := &file_events_proto_msgTypes[3]
+	mi := &file_events_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -266,7 +481,7 @@ func (x *RiderAssignedEvent) String() string {
 func (*RiderAssignedEvent) ProtoMessage() {}
 
 func (x *RiderAssignedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[3]
+	mi := &file_events_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +494,7 @@ func (x *RiderAssignedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RiderAssignedEvent.ProtoReflect.Descriptor instead.
 func (*RiderAssignedEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{3}
+	return file_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RiderAssignedEvent) GetOrderId() string {
@@ -314,7 +529,7 @@ type RiderPickedUpEvent struct {
 
 func (x *RiderPickedUpEvent) Reset() {
 	*x = RiderPickedUpEvent{}
-	mi := &file_events_proto_msgTypes[4]
+	mi := &file_events_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +541,7 @@ func (x *RiderPickedUpEvent) String() string {
 func (*RiderPickedUpEvent) ProtoMessage() {}
 
 func (x *RiderPickedUpEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[4]
+	mi := &file_events_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +554,7 @@ func (x *RiderPickedUpEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RiderPickedUpEvent.ProtoReflect.Descriptor instead.
 func (*RiderPickedUpEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{4}
+	return file_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RiderPickedUpEvent) GetOrderId() string {
@@ -374,7 +589,7 @@ type RiderDeliveredEvent struct {
 
 func (x *RiderDeliveredEvent) Reset() {
 	*x = RiderDeliveredEvent{}
-	mi := &file_events_proto_msgTypes[5]
+	mi := &file_events_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +601,7 @@ func (x *RiderDeliveredEvent) String() string {
 func (*RiderDeliveredEvent) ProtoMessage() {}
 
 func (x *RiderDeliveredEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[5]
+	mi := &file_events_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +614,7 @@ func (x *RiderDeliveredEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RiderDeliveredEvent.ProtoReflect.Descriptor instead.
 func (*RiderDeliveredEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{5}
+	return file_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RiderDeliveredEvent) GetOrderId() string {
@@ -425,17 +640,20 @@ func (x *RiderDeliveredEvent) GetDeliverTime() *timestamppb.Timestamp {
 
 // ============================ SYNC ========================
 type SyncCustomerCreated struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Set for guests, who have no real email.
+	PhoneNumber   string `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Guest         bool   `protobuf:"varint,6,opt,name=guest,proto3" json:"guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncCustomerCreated) Reset() {
 	*x = SyncCustomerCreated{}
-	mi := &file_events_proto_msgTypes[6]
+	mi := &file_events_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +665,7 @@ func (x *SyncCustomerCreated) String() string {
 func (*SyncCustomerCreated) ProtoMessage() {}
 
 func (x *SyncCustomerCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[6]
+	mi := &file_events_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +678,7 @@ func (x *SyncCustomerCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncCustomerCreated.ProtoReflect.Descriptor instead.
 func (*SyncCustomerCreated) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{6}
+	return file_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SyncCustomerCreated) GetCustomerId() string {
@@ -484,6 +702,83 @@ func (x *SyncCustomerCreated) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SyncCustomerCreated) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *SyncCustomerCreated) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+// Routing key is "sync.customer.merged". The orders and addresses of the
+// guest source customer move to the target customer, and the source is
+// removed.
+type SyncCustomerMerged struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourceCustomerId string                 `protobuf:"bytes,1,opt,name=source_customer_id,json=sourceCustomerId,proto3" json:"source_customer_id,omitempty"`
+	TargetCustomerId string                 `protobuf:"bytes,2,opt,name=target_customer_id,json=targetCustomerId,proto3" json:"target_customer_id,omitempty"`
+	MergeTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=merge_time,json=mergeTime,proto3" json:"merge_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncCustomerMerged) Reset() {
+	*x = SyncCustomerMerged{}
+	mi := &file_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCustomerMerged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCustomerMerged) ProtoMessage() {}
+
+func (x *SyncCustomerMerged) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCustomerMerged.ProtoReflect.Descriptor instead.
+func (*SyncCustomerMerged) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SyncCustomerMerged) GetSourceCustomerId() string {
+	if x != nil {
+		return x.SourceCustomerId
+	}
+	return ""
+}
+
+func (x *SyncCustomerMerged) GetTargetCustomerId() string {
+	if x != nil {
+		return x.TargetCustomerId
+	}
+	return ""
+}
+
+func (x *SyncCustomerMerged) GetMergeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergeTime
+	}
+	return nil
+}
+
 type SyncRiderCreated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RiderId       string                 `protobuf:"bytes,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
@@ -495,7 +790,7 @@ type SyncRiderCreated struct {
 
 func (x *SyncRiderCreated) Reset() {
 	*x = SyncRiderCreated{}
-	mi := &file_events_proto_msgTypes[7]
+	mi := &file_events_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +802,7 @@ func (x *SyncRiderCreated) String() string {
 func (*SyncRiderCreated) ProtoMessage() {}
 
 func (x *SyncRiderCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[7]
+	mi := &file_events_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +815,7 @@ func (x *SyncRiderCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncRiderCreated.ProtoReflect.Descriptor instead.
 func (*SyncRiderCreated) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{7}
+	return file_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SyncRiderCreated) GetRiderId() string {
@@ -555,7 +850,7 @@ type SyncMerchantCreated struct {
 
 func (x *SyncMerchantCreated) Reset() {
 	*x = SyncMerchantCreated{}
-	mi := &file_events_proto_msgTypes[8]
+	mi := &file_events_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +862,7 @@ func (x *SyncMerchantCreated) String() string {
 func (*SyncMerchantCreated) ProtoMessage() {}
 
 func (x *SyncMerchantCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[8]
+	mi := &file_events_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +875,7 @@ func (x *SyncMerchantCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncMerchantCreated.ProtoReflect.Descriptor instead.
 func (*SyncMerchantCreated) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{8}
+	return file_events_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SyncMerchantCreated) GetMerchantId() string {
@@ -604,26 +899,535 @@ func (x *SyncMerchantCreated) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_events_proto protoreflect.FileDescriptor
+// Routing key is "sync.admin.created". Admins have no record in other
+// services, the event is kept for the audit trail of admin accounts.
+type SyncAdminCreated struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AdminId    string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role       Roles                  `protobuf:"varint,3,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The super admin who created the account.
+	CreatedBy     string `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_events_proto_rawDesc = "" +
-	"\n" +
-	"\fevents.proto\x12\tihavefood\x1a\x12orderservice.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
-	"\x10OrderPlacedEvent\x12+\n" +
-	"\x05order\x18\x01 \x01(\v2\x15.ihavefood.PlaceOrderR\x05order\"\x90\x01\n" +
-	"\x15MerchantAcceptedEvent\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
-	"\vmerchant_id\x18\x02 \x01(\tR\n" +
-	"merchantId\x12;\n" +
-	"\vaccept_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"acceptTime\"l\n" +
-	"\x12RiderNotifiedEvent\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\x12;\n" +
-	"\vnotify_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"notifyTime\"\x87\x01\n" +
-	"\x12RiderAssignedEvent\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x19\n" +
-	"\brider_id\x18\x02 \x01(\tR\ariderId\x12;\n" +
+func (x *SyncAdminCreated) Reset() {
+	*x = SyncAdminCreated{}
+	mi := &file_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAdminCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAdminCreated) ProtoMessage() {}
+
+func (x *SyncAdminCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAdminCreated.ProtoReflect.Descriptor instead.
+func (*SyncAdminCreated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SyncAdminCreated) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *SyncAdminCreated) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SyncAdminCreated) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncAdminCreated) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SyncAdminCreated) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// Account changes made by admins. Routing keys are "sync.<role>.disabled",
+// "sync.<role>.enabled" and "sync.<role>.deleted" where <role> is the
+// lowercase role name, e.g. "sync.customer.deleted".
+type SyncAccountStatusUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	Disabled      bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAccountStatusUpdated) Reset() {
+	*x = SyncAccountStatusUpdated{}
+	mi := &file_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAccountStatusUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAccountStatusUpdated) ProtoMessage() {}
+
+func (x *SyncAccountStatusUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAccountStatusUpdated.ProtoReflect.Descriptor instead.
+func (*SyncAccountStatusUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SyncAccountStatusUpdated) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SyncAccountStatusUpdated) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncAccountStatusUpdated) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *SyncAccountStatusUpdated) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type SyncAccountRoleUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	OldRole       Roles                  `protobuf:"varint,3,opt,name=old_role,json=oldRole,proto3,enum=ihavefood.Roles" json:"old_role,omitempty"`
+	NewRole       Roles                  `protobuf:"varint,4,opt,name=new_role,json=newRole,proto3,enum=ihavefood.Roles" json:"new_role,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAccountRoleUpdated) Reset() {
+	*x = SyncAccountRoleUpdated{}
+	mi := &file_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAccountRoleUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAccountRoleUpdated) ProtoMessage() {}
+
+func (x *SyncAccountRoleUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAccountRoleUpdated.ProtoReflect.Descriptor instead.
+func (*SyncAccountRoleUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SyncAccountRoleUpdated) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SyncAccountRoleUpdated) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SyncAccountRoleUpdated) GetOldRole() Roles {
+	if x != nil {
+		return x.OldRole
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncAccountRoleUpdated) GetNewRole() Roles {
+	if x != nil {
+		return x.NewRole
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncAccountRoleUpdated) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type SyncAccountDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	DeleteTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAccountDeleted) Reset() {
+	*x = SyncAccountDeleted{}
+	mi := &file_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAccountDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAccountDeleted) ProtoMessage() {}
+
+func (x *SyncAccountDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAccountDeleted.ProtoReflect.Descriptor instead.
+func (*SyncAccountDeleted) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SyncAccountDeleted) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SyncAccountDeleted) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncAccountDeleted) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+// Routing key is "sync.<role>.email.updated", e.g. "sync.customer.email.updated".
+type SyncEmailUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncEmailUpdated) Reset() {
+	*x = SyncEmailUpdated{}
+	mi := &file_events_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncEmailUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncEmailUpdated) ProtoMessage() {}
+
+func (x *SyncEmailUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncEmailUpdated.ProtoReflect.Descriptor instead.
+func (*SyncEmailUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SyncEmailUpdated) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SyncEmailUpdated) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncEmailUpdated) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SyncEmailUpdated) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Routing key is "sync.rider.approval.updated". Approved riders are also
+// published as "sync.rider.created".
+type SyncRiderApprovalUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RiderId       string                 `protobuf:"bytes,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Status        RiderApplicationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ihavefood.RiderApplicationStatus" json:"status,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,3,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRiderApprovalUpdated) Reset() {
+	*x = SyncRiderApprovalUpdated{}
+	mi := &file_events_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRiderApprovalUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRiderApprovalUpdated) ProtoMessage() {}
+
+func (x *SyncRiderApprovalUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRiderApprovalUpdated.ProtoReflect.Descriptor instead.
+func (*SyncRiderApprovalUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SyncRiderApprovalUpdated) GetRiderId() string {
+	if x != nil {
+		return x.RiderId
+	}
+	return ""
+}
+
+func (x *SyncRiderApprovalUpdated) GetStatus() RiderApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RiderApplicationStatus_RIDER_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *SyncRiderApprovalUpdated) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *SyncRiderApprovalUpdated) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Routing key is "sync.<role>.phone_number.updated". phone_number is empty
+// when the number was removed.
+type SyncPhoneNumberUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Role          Roles                  `protobuf:"varint,2,opt,name=role,proto3,enum=ihavefood.Roles" json:"role,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPhoneNumberUpdated) Reset() {
+	*x = SyncPhoneNumberUpdated{}
+	mi := &file_events_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPhoneNumberUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPhoneNumberUpdated) ProtoMessage() {}
+
+func (x *SyncPhoneNumberUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPhoneNumberUpdated.ProtoReflect.Descriptor instead.
+func (*SyncPhoneNumberUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SyncPhoneNumberUpdated) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SyncPhoneNumberUpdated) GetRole() Roles {
+	if x != nil {
+		return x.Role
+	}
+	return Roles_ROLES_UNSPECIFIED
+}
+
+func (x *SyncPhoneNumberUpdated) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *SyncPhoneNumberUpdated) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+var File_events_proto protoreflect.FileDescriptor
+
+const file_events_proto_rawDesc = "" +
+	"\n" +
+	"\fevents.proto\x12\tihavefood\x1a\x12orderservice.proto\x1a\x15merchantservice.proto\x1a\x13couponservice.proto\x1a\x11authservice.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
+	"\x10OrderPlacedEvent\x12+\n" +
+	"\x05order\x18\x01 \x01(\v2\x15.ihavefood.PlaceOrderR\x05order\"\x90\x01\n" +
+	"\x15MerchantAcceptedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\tR\n" +
+	"merchantId\x12;\n" +
+	"\vaccept_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptTime\"\x84\x01\n" +
+	"\x14MerchantUpdatedEvent\x12/\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x13.ihavefood.MerchantR\bmerchant\x12;\n" +
+	"\vupdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x81\x01\n" +
+	"\x13OrderDeliveredEvent\x12+\n" +
+	"\x05order\x18\x01 \x01(\v2\x15.ihavefood.PlaceOrderR\x05order\x12=\n" +
+	"\fdeliver_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliverTime\"\x7f\n" +
+	"\x13OrderCancelledEvent\x12+\n" +
+	"\x05order\x18\x01 \x01(\v2\x15.ihavefood.PlaceOrderR\x05order\x12;\n" +
+	"\vcancel_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"cancelTime\"t\n" +
+	"\x10CouponAddedEvent\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.ihavefood.CouponR\x06coupon\x125\n" +
+	"\badd_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aaddTime\"l\n" +
+	"\x12RiderNotifiedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12;\n" +
+	"\vnotify_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"notifyTime\"\x87\x01\n" +
+	"\x12RiderAssignedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x19\n" +
+	"\brider_id\x18\x02 \x01(\tR\ariderId\x12;\n" +
 	"\vassign_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"assignTime\"\x87\x01\n" +
 	"\x12RiderPickedUpEvent\x12\x19\n" +
@@ -634,13 +1438,20 @@ const file_events_proto_rawDesc = "" +
 	"\x13RiderDeliveredEvent\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x19\n" +
 	"\brider_id\x18\x02 \x01(\tR\ariderId\x12=\n" +
-	"\fdeliver_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliverTime\"\x89\x01\n" +
+	"\fdeliver_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliverTime\"\xc2\x01\n" +
 	"\x13SyncCustomerCreated\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12;\n" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\"\x80\x01\n" +
+	"createTime\x12!\n" +
+	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05guest\x18\x06 \x01(\bR\x05guest\"\xab\x01\n" +
+	"\x12SyncCustomerMerged\x12,\n" +
+	"\x12source_customer_id\x18\x01 \x01(\tR\x10sourceCustomerId\x12,\n" +
+	"\x12target_customer_id\x18\x02 \x01(\tR\x10targetCustomerId\x129\n" +
+	"\n" +
+	"merge_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tmergeTime\"\x80\x01\n" +
 	"\x10SyncRiderCreated\x12\x19\n" +
 	"\brider_id\x18\x01 \x01(\tR\ariderId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12;\n" +
@@ -651,7 +1462,51 @@ const file_events_proto_rawDesc = "" +
 	"merchantId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12;\n" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime*\xd7\x01\n" +
+	"createTime\"\xc5\x01\n" +
+	"\x10SyncAdminCreated\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"\xb2\x01\n" +
+	"\x18SyncAccountStatusUpdated\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xde\x01\n" +
+	"\x16SyncAccountRoleUpdated\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12+\n" +
+	"\bold_role\x18\x03 \x01(\x0e2\x10.ihavefood.RolesR\aoldRole\x12+\n" +
+	"\bnew_role\x18\x04 \x01(\x0e2\x10.ihavefood.RolesR\anewRole\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x90\x01\n" +
+	"\x12SyncAccountDeleted\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12;\n" +
+	"\vdelete_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTime\"\xa4\x01\n" +
+	"\x10SyncEmailUpdated\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xd2\x01\n" +
+	"\x18SyncRiderApprovalUpdated\x12\x19\n" +
+	"\brider_id\x18\x01 \x01(\tR\ariderId\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.ihavefood.RiderApplicationStatusR\x06status\x12#\n" +
+	"\rreject_reason\x18\x03 \x01(\tR\frejectReason\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xb7\x01\n" +
+	"\x16SyncPhoneNumberUpdated\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\tR\x06authId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.ihavefood.RolesR\x04role\x12!\n" +
+	"\fphone_number\x18\x03 \x01(\tR\vphoneNumber\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime*\xd7\x01\n" +
 	"\n" +
 	"OrderEvent\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\x16\n" +
@@ -676,36 +1531,76 @@ func file_events_proto_rawDescGZIP() []byte {
 }
 
 var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_events_proto_goTypes = []any{
-	(OrderEvent)(0),               // 0: ihavefood.OrderEvent
-	(*OrderPlacedEvent)(nil),      // 1: ihavefood.OrderPlacedEvent
-	(*MerchantAcceptedEvent)(nil), // 2: ihavefood.MerchantAcceptedEvent
-	(*RiderNotifiedEvent)(nil),    // 3: ihavefood.RiderNotifiedEvent
-	(*RiderAssignedEvent)(nil),    // 4: ihavefood.RiderAssignedEvent
-	(*RiderPickedUpEvent)(nil),    // 5: ihavefood.RiderPickedUpEvent
-	(*RiderDeliveredEvent)(nil),   // 6: ihavefood.RiderDeliveredEvent
-	(*SyncCustomerCreated)(nil),   // 7: ihavefood.SyncCustomerCreated
-	(*SyncRiderCreated)(nil),      // 8: ihavefood.SyncRiderCreated
-	(*SyncMerchantCreated)(nil),   // 9: ihavefood.SyncMerchantCreated
-	(*PlaceOrder)(nil),            // 10: ihavefood.PlaceOrder
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(OrderEvent)(0),                  // 0: ihavefood.OrderEvent
+	(*OrderPlacedEvent)(nil),         // 1: ihavefood.OrderPlacedEvent
+	(*MerchantAcceptedEvent)(nil),    // 2: ihavefood.MerchantAcceptedEvent
+	(*MerchantUpdatedEvent)(nil),     // 3: ihavefood.MerchantUpdatedEvent
+	(*OrderDeliveredEvent)(nil),      // 4: ihavefood.OrderDeliveredEvent
+	(*OrderCancelledEvent)(nil),      // 5: ihavefood.OrderCancelledEvent
+	(*CouponAddedEvent)(nil),         // 6: ihavefood.CouponAddedEvent
+	(*RiderNotifiedEvent)(nil),       // 7: ihavefood.RiderNotifiedEvent
+	(*RiderAssignedEvent)(nil),       // 8: ihavefood.RiderAssignedEvent
+	(*RiderPickedUpEvent)(nil),       // 9: ihavefood.RiderPickedUpEvent
+	(*RiderDeliveredEvent)(nil),      // 10: ihavefood.RiderDeliveredEvent
+	(*SyncCustomerCreated)(nil),      // 11: ihavefood.SyncCustomerCreated
+	(*SyncCustomerMerged)(nil),       // 12: ihavefood.SyncCustomerMerged
+	(*SyncRiderCreated)(nil),         // 13: ihavefood.SyncRiderCreated
+	(*SyncMerchantCreated)(nil),      // 14: ihavefood.SyncMerchantCreated
+	(*SyncAdminCreated)(nil),         // 15: ihavefood.SyncAdminCreated
+	(*SyncAccountStatusUpdated)(nil), // 16: ihavefood.SyncAccountStatusUpdated
+	(*SyncAccountRoleUpdated)(nil),   // 17: ihavefood.SyncAccountRoleUpdated
+	(*SyncAccountDeleted)(nil),       // 18: ihavefood.SyncAccountDeleted
+	(*SyncEmailUpdated)(nil),         // 19: ihavefood.SyncEmailUpdated
+	(*SyncRiderApprovalUpdated)(nil), // 20: ihavefood.SyncRiderApprovalUpdated
+	(*SyncPhoneNumberUpdated)(nil),   // 21: ihavefood.SyncPhoneNumberUpdated
+	(*PlaceOrder)(nil),               // 22: ihavefood.PlaceOrder
+	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
+	(*Merchant)(nil),                 // 24: ihavefood.Merchant
+	(*Coupon)(nil),                   // 25: ihavefood.Coupon
+	(Roles)(0),                       // 26: ihavefood.Roles
+	(RiderApplicationStatus)(0),      // 27: ihavefood.RiderApplicationStatus
 }
 var file_events_proto_depIdxs = []int32{
-	10, // 0: ihavefood.OrderPlacedEvent.order:type_name -> ihavefood.PlaceOrder
-	11, // 1: ihavefood.MerchantAcceptedEvent.accept_time:type_name -> google.protobuf.Timestamp
-	11, // 2: ihavefood.RiderNotifiedEvent.notify_time:type_name -> google.protobuf.Timestamp
-	11, // 3: ihavefood.RiderAssignedEvent.assign_time:type_name -> google.protobuf.Timestamp
-	11, // 4: ihavefood.RiderPickedUpEvent.pickup_time:type_name -> google.protobuf.Timestamp
-	11, // 5: ihavefood.RiderDeliveredEvent.deliver_time:type_name -> google.protobuf.Timestamp
-	11, // 6: ihavefood.SyncCustomerCreated.create_time:type_name -> google.protobuf.Timestamp
-	11, // 7: ihavefood.SyncRiderCreated.create_time:type_name -> google.protobuf.Timestamp
-	11, // 8: ihavefood.SyncMerchantCreated.create_time:type_name -> google.protobuf.Timestamp
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	22, // 0: ihavefood.OrderPlacedEvent.order:type_name -> ihavefood.PlaceOrder
+	23, // 1: ihavefood.MerchantAcceptedEvent.accept_time:type_name -> google.protobuf.Timestamp
+	24, // 2: ihavefood.MerchantUpdatedEvent.merchant:type_name -> ihavefood.Merchant
+	23, // 3: ihavefood.MerchantUpdatedEvent.update_time:type_name -> google.protobuf.Timestamp
+	22, // 4: ihavefood.OrderDeliveredEvent.order:type_name -> ihavefood.PlaceOrder
+	23, // 5: ihavefood.OrderDeliveredEvent.deliver_time:type_name -> google.protobuf.Timestamp
+	22, // 6: ihavefood.OrderCancelledEvent.order:type_name -> ihavefood.PlaceOrder
+	23, // 7: ihavefood.OrderCancelledEvent.cancel_time:type_name -> google.protobuf.Timestamp
+	25, // 8: ihavefood.CouponAddedEvent.coupon:type_name -> ihavefood.Coupon
+	23, // 9: ihavefood.CouponAddedEvent.add_time:type_name -> google.protobuf.Timestamp
+	23, // 10: ihavefood.RiderNotifiedEvent.notify_time:type_name -> google.protobuf.Timestamp
+	23, // 11: ihavefood.RiderAssignedEvent.assign_time:type_name -> google.protobuf.Timestamp
+	23, // 12: ihavefood.RiderPickedUpEvent.pickup_time:type_name -> google.protobuf.Timestamp
+	23, // 13: ihavefood.RiderDeliveredEvent.deliver_time:type_name -> google.protobuf.Timestamp
+	23, // 14: ihavefood.SyncCustomerCreated.create_time:type_name -> google.protobuf.Timestamp
+	23, // 15: ihavefood.SyncCustomerMerged.merge_time:type_name -> google.protobuf.Timestamp
+	23, // 16: ihavefood.SyncRiderCreated.create_time:type_name -> google.protobuf.Timestamp
+	23, // 17: ihavefood.SyncMerchantCreated.create_time:type_name -> google.protobuf.Timestamp
+	26, // 18: ihavefood.SyncAdminCreated.role:type_name -> ihavefood.Roles
+	23, // 19: ihavefood.SyncAdminCreated.create_time:type_name -> google.protobuf.Timestamp
+	26, // 20: ihavefood.SyncAccountStatusUpdated.role:type_name -> ihavefood.Roles
+	23, // 21: ihavefood.SyncAccountStatusUpdated.update_time:type_name -> google.protobuf.Timestamp
+	26, // 22: ihavefood.SyncAccountRoleUpdated.old_role:type_name -> ihavefood.Roles
+	26, // 23: ihavefood.SyncAccountRoleUpdated.new_role:type_name -> ihavefood.Roles
+	23, // 24: ihavefood.SyncAccountRoleUpdated.update_time:type_name -> google.protobuf.Timestamp
+	26, // 25: ihavefood.SyncAccountDeleted.role:type_name -> ihavefood.Roles
+	23, // 26: ihavefood.SyncAccountDeleted.delete_time:type_name -> google.protobuf.Timestamp
+	26, // 27: ihavefood.SyncEmailUpdated.role:type_name -> ihavefood.Roles
+	23, // 28: ihavefood.SyncEmailUpdated.update_time:type_name -> google.protobuf.Timestamp
+	27, // 29: ihavefood.SyncRiderApprovalUpdated.status:type_name -> ihavefood.RiderApplicationStatus
+	23, // 30: ihavefood.SyncRiderApprovalUpdated.update_time:type_name -> google.protobuf.Timestamp
+	26, // 31: ihavefood.SyncPhoneNumberUpdated.role:type_name -> ihavefood.Roles
+	23, // 32: ihavefood.SyncPhoneNumberUpdated.update_time:type_name -> google.protobuf.Timestamp
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -714,13 +1609,16 @@ func file_events_proto_init() {
 		return
 	}
 	file_orderservice_proto_init()
+	file_merchantservice_proto_init()
+	file_couponservice_proto_init()
+	file_authservice_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	maxAddressDetailLength       = 255
+	maxAddressLandmarkLength     = 255
+	maxDeliveryInstructionLength = 500
+)
+
+// setAddressLocation checks the pin and delivery details of the address and
+// sets its coordinates. Both coordinates 0 mean no pin, which leaves them
+// nil.
+func setAddressLocation(addr *dbAddress, latitude, longitude float64) error {
+
+	switch {
+	case len(safeDeref(addr.Detail)) > maxAddressDetailLength:
+		return fmt.Errorf("detail must be at most %d characters", maxAddressDetailLength)
+	case len(safeDeref(addr.Landmark)) > maxAddressLandmarkLength:
+		return fmt.Errorf("landmark must be at most %d characters", maxAddressLandmarkLength)
+	case len(safeDeref(addr.DeliveryInstructions)) > maxDeliveryInstructionLength:
+		return fmt.Errorf("delivery instructions must be at most %d characters", maxDeliveryInstructionLength)
+	}
+
+	if latitude == 0 && longitude == 0 {
+		return nil
+	}
+
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+
+	addr.Latitude, addr.Longitude = &latitude, &longitude
+	return nil
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// callerFromContext returns the auth ID and role of the caller. The api-gateway
+// forwards them as "auth-id" and "auth-role" metadata after verifying the token.
+func callerFromContext(ctx context.Context) (authID string, role pb.Roles, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	ids, roles := md.Get("auth-id"), md.Get("auth-role")
+	if len(ids) == 0 || ids[0] == "" || len(roles) == 0 {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	return ids[0], pb.Roles(pb.Roles_value[roles[0]]), true
+}
+
+// Admins are authorized by the permissions of their access roles, managed by
+// authservice, not by their role.
+const (
+	permCustomersRead  = "customers:read"
+	permCustomersWrite = "customers:write"
+)
+
+// permissionsFromContext returns the effective permissions of the caller,
+// forwarded by the api-gateway from the token as space separated
+// "auth-permissions" metadata.
+func permissionsFromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get("auth-permissions")
+	if len(values) == 0 {
+		return nil
+	}
+
+	return strings.Fields(values[0])
+}
+
+// authorizeCustomer checks that the caller is the customer, whose auth ID is
+// the customer ID, or holds the permission.
+func authorizeCustomer(ctx context.Context, customerID, permission string) error {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	if callerID != customerID && !slices.Contains(permissionsFromContext(ctx), permission) {
+		return status.Error(codes.PermissionDenied, "cannot access another customer")
+	}
+
+	return nil
+}
+
+// authorizeSelf checks that the caller is the customer, for what even an
+// admin may not do on behalf of a customer.
+func authorizeSelf(ctx context.Context, customerID string) error {
+
+	callerID, _, ok := callerFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+
+	if callerID != customerID {
+		return status.Error(codes.PermissionDenied, "cannot act for another customer")
+	}
+
+	return nil
+}
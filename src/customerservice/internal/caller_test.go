@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testCustomerID = "5f0d6c39-7c1e-4a4a-9f57-3f1d2b0c6a11"
+	testOtherID    = "8b2e4d1a-0f3c-4e6b-a7d9-1c5e3f2a4b60"
+)
+
+// callerContext is the context of a request forwarded by the api-gateway.
+func callerContext(authID, role string, pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(append([]string{"auth-id", authID, "auth-role", role}, pairs...)...))
+}
+
+func TestAuthorizeCustomer(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"customer", callerContext(testCustomerID, "ROLES_CUSTOMER"), codes.OK},
+		{"admin with the permission", callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "accounts:read customers:read"), codes.OK},
+		{"admin with another permission", callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "customers:write"), codes.PermissionDenied},
+		{"admin without permissions", callerContext(testOtherID, "ROLES_ADMIN"), codes.PermissionDenied},
+		{"super admin without permissions", callerContext(testOtherID, "ROLES_SUPER_ADMIN"), codes.PermissionDenied},
+		{"other customer", callerContext(testOtherID, "ROLES_CUSTOMER"), codes.PermissionDenied},
+		{"merchant", callerContext(testOtherID, "ROLES_MERCHANT"), codes.PermissionDenied},
+		{"no identity", context.Background(), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(authorizeCustomer(tt.ctx, testCustomerID, permCustomersRead)); got != tt.want {
+				t.Errorf("authorizeCustomer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSelf(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"customer", callerContext(testCustomerID, "ROLES_CUSTOMER"), codes.OK},
+		{"admin", callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "customers:read customers:write"), codes.PermissionDenied},
+		{"other customer", callerContext(testOtherID, "ROLES_CUSTOMER"), codes.PermissionDenied},
+		{"no identity", context.Background(), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(authorizeSelf(tt.ctx, testCustomerID)); got != tt.want {
+				t.Errorf("authorizeSelf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
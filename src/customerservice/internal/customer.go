@@ -1,13 +1,19 @@
 package internal
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -24,29 +30,164 @@ type CustomerService struct {
 
 	rabbitmq *RabbitMQ
 	store    *customerStorage
+	geocoder Geocoder
+	merchant pb.MerchantServiceClient
+	loyalty  *LoyaltyRules
+	blobs    BlobStore
+	// giftCardKey keys the hash gift card codes are stored as.
+	giftCardKey []byte
+	// services authenticates the services calling the RPCs without an
+	// HTTP route.
+	services *ServiceAuthenticator
 }
 
-func NewCustomerService(rabbitmq *RabbitMQ, store *customerStorage) *CustomerService {
+func NewCustomerService(
+	rabbitmq *RabbitMQ,
+	store *customerStorage,
+	geocoder Geocoder,
+	merchant pb.MerchantServiceClient,
+	loyalty *LoyaltyRules,
+	blobs BlobStore,
+	giftCardKey []byte,
+	services *ServiceAuthenticator,
+) *CustomerService {
 	return &CustomerService{
-		rabbitmq: rabbitmq,
-		store:    store,
+		rabbitmq:    rabbitmq,
+		store:       store,
+		geocoder:    geocoder,
+		merchant:    merchant,
+		loyalty:     loyalty,
+		blobs:       blobs,
+		giftCardKey: giftCardKey,
+		services:    services,
 	}
 }
 
+const maxCustomerPrefixLength = 255
+
+// ListCustomers pages through the customers. The page token carries the sort
+// column of the last customer, so pages stay stable while customers are added.
 func (x *CustomerService) ListCustomers(ctx context.Context, in *pb.ListCustomersRequest) (*pb.ListCustomersResponse, error) {
 
-	results, err := x.store.listCustomers(ctx)
+	if _, ok := pb.CustomerSort_name[int32(in.Sort)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown sort")
+	}
+	if len(in.EmailPrefix) > maxCustomerPrefixLength || len(in.PhonePrefix) > maxCustomerPrefixLength {
+		return nil, status.Errorf(codes.InvalidArgument, "prefixes must be at most %d characters", maxCustomerPrefixLength)
+	}
+
+	filter := &dbCustomerFilter{
+		EmailPrefix: strings.TrimSpace(in.EmailPrefix),
+		PhonePrefix: strings.TrimSpace(in.PhonePrefix),
+		Sort:        dbCustomerSort(in.Sort),
+		Limit:       int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 50
+	case filter.Limit > 200:
+		filter.Limit = 200
+	}
+
+	if in.CreateTimeFrom != nil {
+		from := in.CreateTimeFrom.AsTime()
+		filter.CreateTimeFrom = &from
+	}
+	if in.CreateTimeTo != nil {
+		to := in.CreateTimeTo.AsTime()
+		filter.CreateTimeTo = &to
+	}
+	if filter.CreateTimeFrom != nil && filter.CreateTimeTo != nil && !filter.CreateTimeFrom.Before(*filter.CreateTimeTo) {
+		return nil, status.Error(codes.InvalidArgument, "create_time_from must be before create_time_to")
+	}
+
+	switch in.Orders {
+	case pb.CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_WITH_ORDERS:
+		filter.HasOrders = boolPtr(true)
+	case pb.CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS:
+		filter.HasOrders = boolPtr(false)
+	}
+
+	if in.PageToken != "" {
+		after, err := decodeCustomerCursor(in.PageToken, filter.Sort)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = after
+	}
+
+	results, err := x.store.listCustomers(ctx, filter)
 	if err != nil {
 		slog.Error("storage list customers", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	var customers []*pb.Customer
+	resp := &pb.ListCustomersResponse{}
 	for _, customer := range results {
-		customers = append(customers, dbToProto(customer))
+		resp.Customers = append(resp.Customers, dbToProto(customer))
+	}
+
+	if len(results) == filter.Limit {
+		resp.NextPageToken = encodeCustomerCursor(filter.Sort, results[len(results)-1])
+	}
+
+	return resp, nil
+}
+
+// customerCursor is the page token of ListCustomers. Times are in Unix
+// nanoseconds so that they round-trip exactly.
+type customerCursor struct {
+	Sort       dbCustomerSort `json:"s"`
+	Time       int64          `json:"t,omitempty"`
+	Username   string         `json:"u,omitempty"`
+	CustomerID string         `json:"id"`
+}
+
+func encodeCustomerCursor(sort dbCustomerSort, last *dbCustomer) string {
+
+	cursor := customerCursor{Sort: sort, CustomerID: last.CustomerID}
+	switch sort {
+	case customerSortUsernameAsc, customerSortUsernameDesc:
+		cursor.Username = last.Username
+	case customerSortLastOrderTimeDesc:
+		// Matches the COALESCE of the sort column.
+		cursor.Time = time.Unix(0, 0).UnixNano()
+		if last.LastOrderTime != nil {
+			cursor.Time = last.LastOrderTime.UnixNano()
+		}
+	default:
+		cursor.Time = last.CreateTime.UnixNano()
+	}
+
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCustomerCursor(token string, sort dbCustomerSort) (*dbCustomerCursor, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+
+	var cursor customerCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	if _, err := uuid.Parse(cursor.CustomerID); err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	if cursor.Sort != sort {
+		return nil, errors.New("page token is for another sort")
 	}
 
-	return &pb.ListCustomersResponse{Customers: customers}, nil
+	switch sort {
+	case customerSortUsernameAsc, customerSortUsernameDesc:
+		return &dbCustomerCursor{Key: cursor.Username, CustomerID: cursor.CustomerID}, nil
+	default:
+		return &dbCustomerCursor{Key: time.Unix(0, cursor.Time).UTC(), CustomerID: cursor.CustomerID}, nil
+	}
 }
 
 func (x *CustomerService) GetCustomer(ctx context.Context, in *pb.GetCustomerRequest) (*pb.Customer, error) {
@@ -79,13 +220,33 @@ func (x *CustomerService) CreateAddress(ctx context.Context, in *pb.CreateAddres
 		return nil, status.Errorf(codes.ResourceExhausted, "customer has reached the limit of %d addresses", maxAddr)
 	}
 
-	addressID, err := x.store.createAddress(ctx, in.CustomerId, &dbAddress{
-		AddressName: &in.Address.AddressName,
-		SubDistrict: &in.Address.SubDistrict,
-		District:    &in.Address.District,
-		Province:    &in.Address.Province,
-		PostalCode:  &in.Address.PostalCode,
-	})
+	area, err := thaiAddresses.normalize(in.Address.SubDistrict, in.Address.District, in.Address.Province, in.Address.PostalCode)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create address: %v", err)
+	}
+
+	newAddress := &dbAddress{
+		AddressName:          &in.Address.AddressName,
+		SubDistrict:          &area.SubDistrictEN,
+		District:             &area.DistrictEN,
+		Province:             &area.ProvinceEN,
+		PostalCode:           &area.PostalCode,
+		Detail:               stringPtr(strings.TrimSpace(in.Address.Detail)),
+		Landmark:             stringPtr(strings.TrimSpace(in.Address.Landmark)),
+		DeliveryInstructions: stringPtr(strings.TrimSpace(in.Address.DeliveryInstructions)),
+		IsDefault:            in.Address.IsDefault,
+	}
+	if err := setAddressLocation(newAddress, in.Address.Latitude, in.Address.Longitude); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create address: %v", err)
+	}
+	if newAddress.Latitude == nil {
+		if err := x.geocodeAddress(ctx, newAddress); err != nil {
+			slog.Error("geocode address", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	addressID, err := x.store.createAddress(ctx, in.CustomerId, newAddress)
 	if err != nil {
 		slog.Error("store create address", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
@@ -101,20 +262,13 @@ func (x *CustomerService) CreateAddress(ctx context.Context, in *pb.CreateAddres
 		return nil, status.Errorf(codes.NotFound, "address %s not found for customer %s", addressID, in.CustomerId)
 	}
 
-	return &pb.Address{
-		AddressId:   addr.AddressID,
-		AddressName: safeDeref(addr.AddressName),
-		SubDistrict: safeDeref(addr.SubDistrict),
-		District:    safeDeref(addr.District),
-		Province:    safeDeref(addr.Province),
-		PostalCode:  safeDeref(addr.PostalCode),
-	}, nil
+	return toPbAddress(addr), nil
 
 }
 
 func (x *CustomerService) UpdateCustomerInfo(ctx context.Context, in *pb.UpdateCustomerInfoRequest) (*pb.Customer, error) {
 
-	customerID, err := x.store.updateCustomerInfo(ctx, in.CustomerId, in.NewUsername, in.NewPhone)
+	customerID, err := x.store.updateCustomerInfo(ctx, in.CustomerId, in.NewUsername)
 	if err != nil {
 		slog.Error("store update customer info", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
@@ -160,15 +314,54 @@ func (x *CustomerService) UpdateCustomerAddress(ctx context.Context, in *pb.Upda
 		return nil, status.Error(codes.InvalidArgument, "address is required")
 	}
 
-	addressID, err := x.store.updateCustomerAddress(ctx, in.CustomerId,
-		in.AddressId,
-		&dbAddress{
-			AddressName: &in.Address.AddressName,
-			SubDistrict: &in.Address.SubDistrict,
-			District:    &in.Address.District,
-			Province:    &in.Address.Province,
-			PostalCode:  &in.Address.PostalCode,
-		})
+	update := &dbAddress{
+		AddressName:          &in.Address.AddressName,
+		Detail:               stringPtr(strings.TrimSpace(in.Address.Detail)),
+		Landmark:             stringPtr(strings.TrimSpace(in.Address.Landmark)),
+		DeliveryInstructions: stringPtr(strings.TrimSpace(in.Address.DeliveryInstructions)),
+		IsDefault:            in.Address.IsDefault,
+	}
+	if err := setAddressLocation(update, in.Address.Latitude, in.Address.Longitude); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update address: %v", err)
+	}
+
+	// A changed part is checked together with the names kept from the
+	// current address. The postal code is looked up again unless given.
+	if in.Address.SubDistrict != "" || in.Address.District != "" || in.Address.Province != "" || in.Address.PostalCode != "" {
+		current, err := x.store.getAddress(ctx, in.CustomerId, in.AddressId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, status.Error(codes.NotFound, "address not found")
+			}
+			slog.Error("store get address", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		area, err := thaiAddresses.normalize(
+			cmp.Or(in.Address.SubDistrict, safeDeref(current.SubDistrict)),
+			cmp.Or(in.Address.District, safeDeref(current.District)),
+			cmp.Or(in.Address.Province, safeDeref(current.Province)),
+			in.Address.PostalCode,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to update address: %v", err)
+		}
+
+		update.SubDistrict = &area.SubDistrictEN
+		update.District = &area.DistrictEN
+		update.Province = &area.ProvinceEN
+		update.PostalCode = &area.PostalCode
+
+		// A geocoded location moves with the area, a pin stays.
+		if update.Latitude == nil && (current.Latitude == nil || current.LocationApproximate) {
+			if err := x.geocodeAddress(ctx, update); err != nil {
+				slog.Error("geocode address", "err", err)
+				return nil, status.Error(codes.Internal, "internal server error")
+			}
+		}
+	}
+
+	addressID, err := x.store.updateCustomerAddress(ctx, in.CustomerId, in.AddressId, update)
 	if err != nil {
 		slog.Error("store update customer address", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
@@ -184,14 +377,7 @@ func (x *CustomerService) UpdateCustomerAddress(ctx context.Context, in *pb.Upda
 		return nil, status.Errorf(codes.NotFound, "address %s not found for customer %s", addressID, in.CustomerId)
 	}
 
-	return &pb.Address{
-		AddressId:   addr.AddressID,
-		AddressName: safeDeref(addr.AddressName),
-		SubDistrict: safeDeref(addr.SubDistrict),
-		District:    safeDeref(addr.District),
-		Province:    safeDeref(addr.Province),
-		PostalCode:  safeDeref(addr.PostalCode),
-	}, nil
+	return toPbAddress(addr), nil
 }
 
 func (x *CustomerService) DeleteCustomer(ctx context.Context, in *pb.DeleteCustomerRequest) (*emptypb.Empty, error) {
@@ -215,6 +401,37 @@ func (x *CustomerService) DeleteCustomerAddress(ctx context.Context, in *pb.Dele
 	return &emptypb.Empty{}, nil
 }
 
+// SuggestAddresses completes a partial address from the embedded dataset.
+func (x *CustomerService) SuggestAddresses(ctx context.Context, in *pb.SuggestAddressesRequest) (*pb.SuggestAddressesResponse, error) {
+
+	if strings.TrimSpace(in.Query) == "" && strings.TrimSpace(in.District) == "" && strings.TrimSpace(in.Province) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query, district or province is required")
+	}
+
+	limit := int(in.PageSize)
+	switch {
+	case limit <= 0:
+		limit = 10
+	case limit > 50:
+		limit = 50
+	}
+
+	resp := &pb.SuggestAddressesResponse{}
+	for _, sd := range thaiAddresses.suggest(in.Query, in.District, in.Province, limit) {
+		resp.Suggestions = append(resp.Suggestions, &pb.AddressSuggestion{
+			SubDistrict:   sd.SubDistrictEN,
+			District:      sd.DistrictEN,
+			Province:      sd.ProvinceEN,
+			PostalCode:    sd.PostalCode,
+			SubDistrictTh: sd.SubDistrictTH,
+			DistrictTh:    sd.DistrictTH,
+			ProvinceTh:    sd.ProvinceTH,
+		})
+	}
+
+	return resp, nil
+}
+
 func (x *CustomerService) HandleCustomerCreation(msg amqp.Delivery) error {
 
 	var newCustomer pb.SyncCustomerCreated
@@ -235,6 +452,7 @@ func (x *CustomerService) HandleCustomerCreation(msg amqp.Delivery) error {
 		CustomerID: customerId,
 		Username:   defaultUsername,
 		Email:      newCustomer.Email,
+		Phone:      stringPtr(newCustomer.PhoneNumber),
 		CreateTime: newCustomer.CreateTime.AsTime(),
 	})
 	if err != nil {
@@ -245,6 +463,134 @@ func (x *CustomerService) HandleCustomerCreation(msg amqp.Delivery) error {
 	return nil
 }
 
+// HandleCustomerDeletion anonymises the customer when the account is deleted
+// in auth.
+func (x *CustomerService) HandleCustomerDeletion(msg amqp.Delivery) error {
+
+	var deleted pb.SyncAccountDeleted
+	if err := proto.Unmarshal(msg.Body, &deleted); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(deleted.AuthId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	picture, err := x.store.setPicture(context.TODO(), deleted.AuthId, nil)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err := x.store.anonymize(context.TODO(), deleted.AuthId); err != nil {
+		return err
+	}
+
+	if picture != nil {
+		x.deletePicture(context.TODO(), picture)
+	}
+
+	slog.Info("anonymized a deleted customer", "customerID", deleted.AuthId)
+	return nil
+}
+
+// HandleCustomerEmailUpdated applies an email change made in auth.
+func (x *CustomerService) HandleCustomerEmailUpdated(msg amqp.Delivery) error {
+
+	var updated pb.SyncEmailUpdated
+	if err := proto.Unmarshal(msg.Body, &updated); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(updated.AuthId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	if err := x.store.updateEmail(context.TODO(), updated.AuthId, updated.Email); err != nil {
+		return err
+	}
+
+	slog.Info("updated customer email", "customerID", updated.AuthId)
+	return nil
+}
+
+// HandleCustomerPhoneNumberUpdated applies a phone number change made in auth.
+func (x *CustomerService) HandleCustomerPhoneNumberUpdated(msg amqp.Delivery) error {
+
+	var updated pb.SyncPhoneNumberUpdated
+	if err := proto.Unmarshal(msg.Body, &updated); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(updated.AuthId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	if err := x.store.updatePhoneNumber(context.TODO(), updated.AuthId, updated.PhoneNumber); err != nil {
+		return err
+	}
+
+	slog.Info("updated customer phone number", "customerID", updated.AuthId)
+	return nil
+}
+
+// HandleCustomerMerged moves the addresses and favourites of a guest to the
+// customer account it merged into.
+func (x *CustomerService) HandleCustomerMerged(msg amqp.Delivery) error {
+
+	var merged pb.SyncCustomerMerged
+	if err := proto.Unmarshal(msg.Body, &merged); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(merged.SourceCustomerId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+	if _, err := uuid.Parse(merged.TargetCustomerId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	if err := x.store.merge(context.TODO(), merged.SourceCustomerId, merged.TargetCustomerId); err != nil {
+		return err
+	}
+
+	slog.Info("merged a guest into a customer",
+		"guestID", merged.SourceCustomerId,
+		"customerID", merged.TargetCustomerId,
+	)
+	return nil
+}
+
+// HandleOrderPlaced records that the customer of the order has ordered, for
+// the has-orders filter of ListCustomers.
+func (x *CustomerService) HandleOrderPlaced(msg amqp.Delivery) error {
+
+	var order pb.PlaceOrder
+	if err := proto.Unmarshal(msg.Body, &order); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(order.CustomerId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	orderTime := time.Now()
+	if placed := order.GetTimestamps().GetOrderPlacedTime(); placed != nil {
+		orderTime = placed.AsTime()
+	}
+
+	if err := x.store.markOrdered(context.TODO(), order.CustomerId, orderTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func safeDeref(s *string) string {
 	if s == nil {
 		return ""
@@ -252,6 +598,10 @@ func safeDeref(s *string) string {
 	return *s
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 func stringPtr(s string) *string {
 	if s == "" {
 		return nil
@@ -269,14 +619,23 @@ func protoToDb(customer *pb.Customer) *dbCustomer {
 		if a == nil {
 			continue
 		}
-		addresses = append(addresses, &dbAddress{
-			AddressID:   a.AddressId,
-			AddressName: stringPtr(a.AddressName),
-			SubDistrict: stringPtr(a.SubDistrict),
-			District:    stringPtr(a.District),
-			Province:    stringPtr(a.Province),
-			PostalCode:  stringPtr(a.PostalCode),
-		})
+		addr := &dbAddress{
+			AddressID:            a.AddressId,
+			AddressName:          stringPtr(a.AddressName),
+			SubDistrict:          stringPtr(a.SubDistrict),
+			District:             stringPtr(a.District),
+			Province:             stringPtr(a.Province),
+			PostalCode:           stringPtr(a.PostalCode),
+			Detail:               stringPtr(a.Detail),
+			Landmark:             stringPtr(a.Landmark),
+			DeliveryInstructions: stringPtr(a.DeliveryInstructions),
+			IsDefault:            a.IsDefault,
+		}
+		if a.Latitude != 0 || a.Longitude != 0 {
+			addr.Latitude, addr.Longitude = &a.Latitude, &a.Longitude
+			addr.LocationApproximate = a.LocationApproximate
+		}
+		addresses = append(addresses, addr)
 	}
 
 	var social dbSocial
@@ -300,6 +659,29 @@ func protoToDb(customer *pb.Customer) *dbCustomer {
 	}
 }
 
+func toPbAddress(addr *dbAddress) *pb.Address {
+
+	pbAddress := &pb.Address{
+		AddressId:            addr.AddressID,
+		AddressName:          safeDeref(addr.AddressName),
+		SubDistrict:          safeDeref(addr.SubDistrict),
+		District:             safeDeref(addr.District),
+		Province:             safeDeref(addr.Province),
+		PostalCode:           safeDeref(addr.PostalCode),
+		Detail:               safeDeref(addr.Detail),
+		Landmark:             safeDeref(addr.Landmark),
+		DeliveryInstructions: safeDeref(addr.DeliveryInstructions),
+		IsDefault:            addr.IsDefault,
+	}
+	if addr.Latitude != nil && addr.Longitude != nil {
+		pbAddress.Latitude = *addr.Latitude
+		pbAddress.Longitude = *addr.Longitude
+		pbAddress.LocationApproximate = addr.LocationApproximate
+	}
+
+	return pbAddress
+}
+
 func dbToProto(customer *dbCustomer) *pb.Customer {
 	if customer == nil {
 		return nil
@@ -310,17 +692,10 @@ func dbToProto(customer *dbCustomer) *pb.Customer {
 		if a == nil {
 			continue
 		}
-		addresses = append(addresses, &pb.Address{
-			AddressId:   a.AddressID,
-			AddressName: safeDeref(a.AddressName),
-			SubDistrict: safeDeref(a.SubDistrict),
-			District:    safeDeref(a.District),
-			Province:    safeDeref(a.Province),
-			PostalCode:  safeDeref(a.PostalCode),
-		})
+		addresses = append(addresses, toPbAddress(a))
 	}
 
-	return &pb.Customer{
+	pbCustomer := &pb.Customer{
 		CustomerId: customer.CustomerID,
 		Username:   customer.Username,
 		Email:      customer.Email,
@@ -333,4 +708,12 @@ func dbToProto(customer *dbCustomer) *pb.Customer {
 		},
 		CreateTime: timestamppb.New(customer.CreateTime),
 	}
+	if customer.LastOrderTime != nil {
+		pbCustomer.LastOrderTime = timestamppb.New(*customer.LastOrderTime)
+	}
+	if customer.Picture != nil {
+		pbCustomer.Picture = toPbCustomerPicture(customer.Picture)
+	}
+
+	return pbCustomer
 }
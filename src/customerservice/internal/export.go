@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// RequestDataExport queues an export of the personal data of the customer,
+// which the DataExporter collects in the background. Only the customer and
+// holders of customers:read can export and download the data.
+func (x *CustomerService) RequestDataExport(ctx context.Context, in *pb.RequestDataExportRequest) (*pb.DataExport, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	if _, err := x.store.getCustomer(ctx, in.CustomerId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store get customer", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	export, err := x.store.createDataExport(ctx, in.CustomerId)
+	if err != nil {
+		slog.Error("store create data export", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbDataExport(export), nil
+}
+
+func (x *CustomerService) GetDataExport(ctx context.Context, in *pb.GetDataExportRequest) (*pb.DataExport, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(in.ExportId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for export id")
+	}
+
+	export, err := x.store.getDataExport(ctx, in.CustomerId, in.ExportId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "data export not found")
+		}
+		slog.Error("store get data export", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbDataExport(export), nil
+}
+
+// dataExportChunkSize bounds the content of a DataExportArchive message, well
+// below the 4 MiB gRPC clients receive by default.
+const dataExportChunkSize = 1 << 20
+
+// DownloadDataExport streams the ZIP archive of a succeeded export. Archives
+// past their expire time are refused even before the DataExporter deletes
+// them.
+func (x *CustomerService) DownloadDataExport(in *pb.DownloadDataExportRequest, stream grpc.ServerStreamingServer[pb.DataExportArchive]) error {
+
+	ctx := stream.Context()
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(in.ExportId); err != nil {
+		return status.Error(codes.InvalidArgument, "uuid invalid for export id")
+	}
+
+	export, archive, err := x.store.getDataExportArchive(ctx, in.CustomerId, in.ExportId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "data export not found")
+		}
+		slog.Error("store get data export archive", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	switch {
+	case export.State == dataExportStateExpired,
+		export.State == dataExportStateSucceeded && !time.Now().Before(*export.ExpireTime):
+		return status.Error(codes.FailedPrecondition, "data export has expired")
+	case export.State != dataExportStateSucceeded:
+		return status.Error(codes.FailedPrecondition, "data export is not ready")
+	}
+
+	return sendArchive(stream, &pb.DataExportArchive{
+		Filename:    fmt.Sprintf("ihavefood-data-export-%s.zip", export.CreateTime.Format("20060102")),
+		ContentType: "application/zip",
+	}, archive)
+}
+
+// sendArchive sends the archive in chunks of dataExportChunkSize, the first
+// with the filename and content type of head.
+func sendArchive(stream grpc.ServerStreamingServer[pb.DataExportArchive], head *pb.DataExportArchive, archive []byte) error {
+
+	chunk := head
+	for {
+		n := min(len(archive), dataExportChunkSize)
+		chunk.Content = archive[:n]
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+
+		archive = archive[n:]
+		if len(archive) == 0 {
+			return nil
+		}
+		chunk = &pb.DataExportArchive{}
+	}
+}
+
+func toPbDataExport(export *dbDataExport) *pb.DataExport {
+	pbExport := &pb.DataExport{
+		ExportId:   export.ExportID,
+		CustomerId: export.CustomerID,
+		State:      pb.DataExportState(export.State),
+		CreateTime: timestamppb.New(export.CreateTime),
+		SizeBytes:  export.SizeBytes,
+	}
+	if export.CompleteTime != nil {
+		pbExport.CompleteTime = timestamppb.New(*export.CompleteTime)
+	}
+	if export.ExpireTime != nil {
+		pbExport.ExpireTime = timestamppb.New(*export.ExpireTime)
+	}
+	return pbExport
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// archiveStream collects the chunks of a downloaded archive.
+type archiveStream struct {
+	grpc.ServerStreamingServer[pb.DataExportArchive]
+
+	ctx    context.Context
+	chunks []*pb.DataExportArchive
+}
+
+func (s *archiveStream) Context() context.Context { return s.ctx }
+
+func (s *archiveStream) Send(chunk *pb.DataExportArchive) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestDataExportOfAnotherCustomer(t *testing.T) {
+
+	// The store is never reached, the caller is refused first.
+	x := &CustomerService{}
+	ctx := callerContext(testOtherID, "ROLES_CUSTOMER")
+	exportID := "0c9a3e7f-5b2d-4f61-8e4a-6d1b9c2f7a35"
+
+	calls := map[string]func(context.Context) error{
+		"request": func(ctx context.Context) error {
+			_, err := x.RequestDataExport(ctx, &pb.RequestDataExportRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"get": func(ctx context.Context) error {
+			_, err := x.GetDataExport(ctx, &pb.GetDataExportRequest{CustomerId: testCustomerID, ExportId: exportID})
+			return err
+		},
+		"download": func(ctx context.Context) error {
+			return x.DownloadDataExport(&pb.DownloadDataExportRequest{CustomerId: testCustomerID, ExportId: exportID},
+				&archiveStream{ctx: ctx})
+		},
+	}
+
+	for name, call := range calls {
+		if got := status.Code(call(ctx)); got != codes.PermissionDenied {
+			t.Errorf("%s: got %v, want PermissionDenied", name, got)
+		}
+		if got := status.Code(call(context.Background())); got != codes.Unauthenticated {
+			t.Errorf("%s without identity: got %v, want Unauthenticated", name, got)
+		}
+	}
+}
+
+func TestSendArchive(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		size   int
+		chunks int
+	}{
+		{"small", 100, 1},
+		{"one chunk", dataExportChunkSize, 1},
+		{"over the gRPC limit", 4*dataExportChunkSize + 1, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := bytes.Repeat([]byte{'z'}, tt.size)
+			stream := &archiveStream{ctx: context.Background()}
+
+			if err := sendArchive(stream, &pb.DataExportArchive{Filename: "export.zip", ContentType: "application/zip"}, archive); err != nil {
+				t.Fatal(err)
+			}
+			if len(stream.chunks) != tt.chunks {
+				t.Fatalf("sent %d chunks, want %d", len(stream.chunks), tt.chunks)
+			}
+			if stream.chunks[0].Filename != "export.zip" || stream.chunks[0].ContentType != "application/zip" {
+				t.Errorf("first chunk without the filename and content type: %v", stream.chunks[0])
+			}
+
+			var got []byte
+			for i, chunk := range stream.chunks {
+				if len(chunk.Content) > dataExportChunkSize {
+					t.Errorf("chunk %d of %d bytes, over %d", i, len(chunk.Content), dataExportChunkSize)
+				}
+				if i > 0 && chunk.Filename != "" {
+					t.Errorf("chunk %d repeats the filename", i)
+				}
+				got = append(got, chunk.Content...)
+			}
+			if !bytes.Equal(got, archive) {
+				t.Error("the chunks do not add up to the archive")
+			}
+		})
+	}
+}
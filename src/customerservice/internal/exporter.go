@@ -0,0 +1,239 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// exportStaleAfter is how long an export may run before another worker takes
+// it over.
+const exportStaleAfter = 15 * time.Minute
+
+// DataExporter collects the personal data of a customer from this service,
+// auth and orders into a ZIP archive of JSON files. Archives are kept until
+// their expire time.
+type DataExporter struct {
+	store *customerStorage
+	auth  pb.AuthServiceClient
+	order pb.OrderServiceClient
+	ttl   time.Duration
+}
+
+func NewDataExporter(store *customerStorage, auth pb.AuthServiceClient, order pb.OrderServiceClient, ttl time.Duration) *DataExporter {
+	return &DataExporter{
+		store: store,
+		auth:  auth,
+		order: order,
+		ttl:   ttl,
+	}
+}
+
+// Run exports the pending exports and expires old archives once per interval
+// until ctx is done.
+func (e *DataExporter) Run(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := e.store.expireDataExports(ctx)
+			if err != nil {
+				slog.Error("store expire data exports", "err", err)
+			} else if expired > 0 {
+				slog.Info("data exports expired", "count", expired)
+			}
+
+			for {
+				export, err := e.store.claimDataExport(ctx, exportStaleAfter)
+				if err != nil {
+					if !errors.Is(err, pgx.ErrNoRows) {
+						slog.Error("store claim data export", "err", err)
+					}
+					break
+				}
+				e.process(ctx, export)
+			}
+		}
+	}
+}
+
+func (e *DataExporter) process(ctx context.Context, export *dbDataExport) {
+
+	archive, err := e.Export(ctx, export.CustomerID)
+	if err != nil {
+		slog.Error("export customer data", "exportID", export.ExportID, "err", err)
+		if err := e.store.failDataExport(ctx, export.ExportID, err.Error()); err != nil {
+			slog.Error("store fail data export", "err", err)
+		}
+		return
+	}
+
+	if err := e.store.completeDataExport(ctx, export.ExportID, archive, time.Now().Add(e.ttl)); err != nil {
+		slog.Error("store complete data export", "err", err)
+		return
+	}
+
+	slog.Info("data export succeeded", "exportID", export.ExportID, "sizeBytes", len(archive))
+}
+
+// Export builds the archive of the customer. It holds:
+//
+//	customer.json                  profile, addresses and social links
+//	favourites.json                favourite merchants and menu items
+//	loyalty.json                   loyalty points balance
+//	loyalty_transactions.json      loyalty points ledger
+//	wallet.json                    wallet balance
+//	wallet_transactions.json       wallet ledger
+//	notification_preferences.json  notification preferences
+//	notifications.json             in-app inbox
+//	account.json                   credentials metadata from auth
+//	orders.json                    order history from orders
+func (e *DataExporter) Export(ctx context.Context, customerID string) ([]byte, error) {
+
+	customer, err := e.store.getCustomer(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("get customer: %w", err)
+	}
+
+	merchants, err := e.store.listFavouriteMerchants(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("list favourite merchants: %w", err)
+	}
+	menuItems, err := e.store.listFavouriteMenuItems(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("list favourite menu items: %w", err)
+	}
+	favourites := &pb.ListFavouritesResponse{}
+	for _, m := range merchants {
+		favourites.Merchants = append(favourites.Merchants, toPbFavouriteMerchant(m))
+	}
+	for _, item := range menuItems {
+		favourites.MenuItems = append(favourites.MenuItems, toPbFavouriteMenuItem(item))
+	}
+
+	balance, err := e.store.getLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("get loyalty balance: %w", err)
+	}
+	ledger := &pb.ListLoyaltyTransactionsResponse{}
+	filter := &dbLoyaltyFilter{CustomerID: customerID, Limit: 500}
+	for {
+		transactions, err := e.store.listLoyaltyTransactions(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("list loyalty transactions: %w", err)
+		}
+		for _, t := range transactions {
+			ledger.Transactions = append(ledger.Transactions, toPbLoyaltyTransaction(t))
+		}
+		if len(transactions) < filter.Limit {
+			break
+		}
+		last := transactions[len(transactions)-1]
+		filter.BeforeTime = &last.CreateTime
+		filter.BeforeID = last.TransactionID
+	}
+
+	walletBalance, err := e.store.getWalletBalance(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("get wallet balance: %w", err)
+	}
+	walletLedger := &pb.ListWalletTransactionsResponse{}
+	walletFilter := &dbWalletFilter{CustomerID: customerID, Limit: 500}
+	for {
+		transactions, err := e.store.listWalletTransactions(ctx, walletFilter)
+		if err != nil {
+			return nil, fmt.Errorf("list wallet transactions: %w", err)
+		}
+		for _, t := range transactions {
+			walletLedger.Transactions = append(walletLedger.Transactions, toPbWalletTransaction(t))
+		}
+		if len(transactions) < walletFilter.Limit {
+			break
+		}
+		last := transactions[len(transactions)-1]
+		walletFilter.BeforeTime = &last.CreateTime
+		walletFilter.BeforeID = last.TransactionID
+	}
+
+	prefs, err := e.store.listNotificationPreferences(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("list notification preferences: %w", err)
+	}
+	inbox := &pb.ListNotificationsResponse{}
+	inboxFilter := &dbNotificationFilter{CustomerID: customerID, Limit: 500}
+	for {
+		notifications, err := e.store.listNotifications(ctx, inboxFilter)
+		if err != nil {
+			return nil, fmt.Errorf("list notifications: %w", err)
+		}
+		for _, n := range notifications {
+			inbox.Notifications = append(inbox.Notifications, toPbNotification(n))
+		}
+		if len(notifications) < inboxFilter.Limit {
+			break
+		}
+		last := notifications[len(notifications)-1]
+		inboxFilter.BeforeTime = &last.CreateTime
+		inboxFilter.BeforeID = last.NotificationID
+	}
+
+	account, err := e.auth.ExportAuthData(ctx, &pb.ExportAuthDataRequest{AuthId: customerID})
+	if err != nil {
+		return nil, fmt.Errorf("export auth data: %w", err)
+	}
+
+	orders, err := e.order.ListOrderHistory(ctx, &pb.ListOrderHistoryRequest{CustomerId: customerID})
+	if err != nil {
+		return nil, fmt.Errorf("list order history: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range []struct {
+		name string
+		msg  proto.Message
+	}{
+		{"customer.json", dbToProto(customer)},
+		{"favourites.json", favourites},
+		{"loyalty.json", toPbLoyaltyBalance(customerID, balance)},
+		{"loyalty_transactions.json", ledger},
+		{"wallet.json", &pb.Wallet{CustomerId: customerID, Balance: walletBalance}},
+		{"wallet_transactions.json", walletLedger},
+		{"notification_preferences.json", toPbNotificationPreferences(customerID, prefs)},
+		{"notifications.json", inbox},
+		{"account.json", account},
+		{"orders.json", orders},
+	} {
+		data, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(file.msg)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", file.name, err)
+		}
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// maxFavourites limits the favourite merchants and, separately, the favourite
+// menu items of a customer.
+const maxFavourites = 100
+
+func (x *CustomerService) ListFavourites(ctx context.Context, in *pb.ListFavouritesRequest) (*pb.ListFavouritesResponse, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	merchants, err := x.store.listFavouriteMerchants(ctx, in.CustomerId)
+	if err != nil {
+		slog.Error("store list favourite merchants", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	menuItems, err := x.store.listFavouriteMenuItems(ctx, in.CustomerId)
+	if err != nil {
+		slog.Error("store list favourite menu items", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListFavouritesResponse{}
+	for _, m := range merchants {
+		resp.Merchants = append(resp.Merchants, toPbFavouriteMerchant(m))
+	}
+	for _, item := range menuItems {
+		resp.MenuItems = append(resp.MenuItems, toPbFavouriteMenuItem(item))
+	}
+
+	return resp, nil
+}
+
+// AddFavouriteMerchant adds a merchant to the favourites, with a copy of its
+// name and image.
+func (x *CustomerService) AddFavouriteMerchant(ctx context.Context, in *pb.AddFavouriteMerchantRequest) (*pb.FavouriteMerchant, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(in.MerchantId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for merchant id")
+	}
+
+	if err := x.checkFavouriteLimit(ctx, in.CustomerId, false); err != nil {
+		return nil, err
+	}
+
+	merchant, err := x.getMerchant(ctx, in.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	fav, err := x.store.addFavouriteMerchant(ctx, in.CustomerId, &dbFavouriteMerchant{
+		MerchantID:   merchant.MerchantId,
+		MerchantName: merchant.MerchantName,
+		ImageURL:     merchant.GetImageInfo().GetUrl(),
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store add favourite merchant", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbFavouriteMerchant(fav), nil
+}
+
+func (x *CustomerService) RemoveFavouriteMerchant(ctx context.Context, in *pb.RemoveFavouriteMerchantRequest) (*emptypb.Empty, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(in.MerchantId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for merchant id")
+	}
+
+	if err := x.store.removeFavouriteMerchant(ctx, in.CustomerId, in.MerchantId); err != nil {
+		slog.Error("store remove favourite merchant", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AddFavouriteMenuItem adds a menu item to the favourites, with a copy of its
+// name, image and merchant name.
+func (x *CustomerService) AddFavouriteMenuItem(ctx context.Context, in *pb.AddFavouriteMenuItemRequest) (*pb.FavouriteMenuItem, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(in.MerchantId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for merchant id")
+	}
+	if _, err := uuid.Parse(in.ItemId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for item id")
+	}
+
+	if err := x.checkFavouriteLimit(ctx, in.CustomerId, true); err != nil {
+		return nil, err
+	}
+
+	merchant, err := x.getMerchant(ctx, in.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *pb.MenuItem
+	for _, m := range merchant.Menu {
+		if m.ItemId == in.ItemId {
+			item = m
+			break
+		}
+	}
+	if item == nil {
+		return nil, status.Error(codes.NotFound, "menu item not found")
+	}
+
+	fav, err := x.store.addFavouriteMenuItem(ctx, in.CustomerId, &dbFavouriteMenuItem{
+		MerchantID:   merchant.MerchantId,
+		ItemID:       item.ItemId,
+		FoodName:     item.FoodName,
+		MerchantName: merchant.MerchantName,
+		ImageURL:     item.GetImageInfo().GetUrl(),
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store add favourite menu item", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbFavouriteMenuItem(fav), nil
+}
+
+func (x *CustomerService) RemoveFavouriteMenuItem(ctx context.Context, in *pb.RemoveFavouriteMenuItemRequest) (*emptypb.Empty, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(in.ItemId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "uuid invalid for item id")
+	}
+
+	if err := x.store.removeFavouriteMenuItem(ctx, in.CustomerId, in.ItemId); err != nil {
+		slog.Error("store remove favourite menu item", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// HandleMerchantUpdated refreshes the copies of the merchant in favourites.
+func (x *CustomerService) HandleMerchantUpdated(msg amqp.Delivery) error {
+
+	var event pb.MerchantUpdatedEvent
+	if err := proto.Unmarshal(msg.Body, &event); err != nil {
+		return err
+	}
+	if event.Merchant == nil {
+		return errors.New("merchant updated event without merchant")
+	}
+
+	merchant := &dbMerchantCopy{
+		MerchantID:   event.Merchant.MerchantId,
+		MerchantName: event.Merchant.MerchantName,
+		ImageURL:     event.Merchant.GetImageInfo().GetUrl(),
+	}
+	for _, item := range event.Merchant.Menu {
+		merchant.Menu = append(merchant.Menu, &dbMenuItemCopy{
+			ItemID:   item.ItemId,
+			FoodName: item.FoodName,
+			ImageURL: item.GetImageInfo().GetUrl(),
+		})
+	}
+
+	if err := x.store.refreshFavourites(context.TODO(), merchant); err != nil {
+		return err
+	}
+
+	slog.Info("refreshed favourites of a merchant", "merchantID", merchant.MerchantID)
+	return nil
+}
+
+// checkFavouriteLimit fails once the customer has maxFavourites merchants, or
+// menu items when menuItem is set.
+func (x *CustomerService) checkFavouriteLimit(ctx context.Context, customerID string, menuItem bool) error {
+
+	merchants, menuItems, err := x.store.countFavourites(ctx, customerID)
+	if err != nil {
+		slog.Error("store count favourites", "err", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	n := merchants
+	if menuItem {
+		n = menuItems
+	}
+	if n >= maxFavourites {
+		return status.Errorf(codes.ResourceExhausted, "customer has reached the limit of %d favourites", maxFavourites)
+	}
+	return nil
+}
+
+func (x *CustomerService) getMerchant(ctx context.Context, merchantID string) (*pb.Merchant, error) {
+
+	merchant, err := x.merchant.GetMerchant(ctx, &pb.GetMerchantRequest{MerchantId: merchantID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "merchant not found")
+		}
+		slog.Error("merchant get merchant", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return merchant, nil
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+func toPbFavouriteMerchant(fav *dbFavouriteMerchant) *pb.FavouriteMerchant {
+	return &pb.FavouriteMerchant{
+		MerchantId:   fav.MerchantID,
+		MerchantName: fav.MerchantName,
+		ImageUrl:     fav.ImageURL,
+		CreateTime:   timestamppb.New(fav.CreateTime),
+	}
+}
+
+func toPbFavouriteMenuItem(fav *dbFavouriteMenuItem) *pb.FavouriteMenuItem {
+	return &pb.FavouriteMenuItem{
+		MerchantId:   fav.MerchantID,
+		ItemId:       fav.ItemID,
+		FoodName:     fav.FoodName,
+		MerchantName: fav.MerchantName,
+		ImageUrl:     fav.ImageURL,
+		Available:    fav.Available,
+		CreateTime:   timestamppb.New(fav.CreateTime),
+	}
+}
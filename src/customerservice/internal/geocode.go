@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoLocation is returned by a Geocoder for an address it cannot place.
+var ErrNoLocation = errors.New("no location for address")
+
+// Geocoder resolves a Thai address to coordinates. The default is
+// NewCentroidGeocoder, which works offline; a provider with street level
+// results can replace it.
+type Geocoder interface {
+	Geocode(ctx context.Context, addr *GeoAddress) (*GeoPoint, error)
+}
+
+type GeoAddress struct {
+	SubDistrict string
+	District    string
+	Province    string
+	PostalCode  string
+}
+
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+	// Approximate points are a centre of an area rather than the address
+	// itself.
+	Approximate bool
+}
+
+type centroidGeocoder struct {
+	book *thaiAddressBook
+}
+
+// NewCentroidGeocoder places an address at the centroid of its sub-district,
+// from the embedded address dataset.
+func NewCentroidGeocoder() Geocoder {
+	return &centroidGeocoder{book: thaiAddresses}
+}
+
+func (g *centroidGeocoder) Geocode(ctx context.Context, addr *GeoAddress) (*GeoPoint, error) {
+
+	sd, err := g.book.normalize(addr.SubDistrict, addr.District, addr.Province, addr.PostalCode)
+	if err != nil {
+		if errors.Is(err, errUnknownAddress) {
+			return nil, fmt.Errorf("%w: %v", ErrNoLocation, err)
+		}
+		return nil, err
+	}
+	if !sd.Located {
+		return nil, fmt.Errorf("%w: no centroid for %s, %s", ErrNoLocation, sd.SubDistrictEN, sd.DistrictEN)
+	}
+
+	return &GeoPoint{
+		Latitude:    sd.Latitude,
+		Longitude:   sd.Longitude,
+		Approximate: true,
+	}, nil
+}
+
+// geocodeAddress sets the coordinates of an address without a pin. An
+// address the geocoder cannot place is kept without coordinates.
+func (x *CustomerService) geocodeAddress(ctx context.Context, addr *dbAddress) error {
+
+	point, err := x.geocoder.Geocode(ctx, &GeoAddress{
+		SubDistrict: safeDeref(addr.SubDistrict),
+		District:    safeDeref(addr.District),
+		Province:    safeDeref(addr.Province),
+		PostalCode:  safeDeref(addr.PostalCode),
+	})
+	if err != nil {
+		if errors.Is(err, ErrNoLocation) {
+			return nil
+		}
+		return err
+	}
+
+	addr.Latitude, addr.Longitude = &point.Latitude, &point.Longitude
+	addr.LocationApproximate = point.Approximate
+	return nil
+}
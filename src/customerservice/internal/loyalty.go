@@ -0,0 +1,352 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// LoyaltyRules are how loyalty points are earned and redeemed. Amounts are in
+// baht.
+type LoyaltyRules struct {
+	// EarnRate is the order total that earns one point.
+	EarnRate int32
+	// MinOrderTotal is the smallest order total earning points.
+	MinOrderTotal int32
+	// PointValue is the discount of one redeemed point.
+	PointValue int32
+	// MaxRedeemPercent caps the discount at a percentage of the order amount.
+	MaxRedeemPercent int32
+	// ExpireAfter is how long points are valid after they were earned.
+	ExpireAfter time.Duration
+}
+
+// DefaultLoyaltyRules give one point per 25 baht on orders of 100 baht or
+// more. A point is worth one baht and pays for at most half an order.
+var DefaultLoyaltyRules = LoyaltyRules{
+	EarnRate:         25,
+	MinOrderTotal:    100,
+	PointValue:       1,
+	MaxRedeemPercent: 50,
+	ExpireAfter:      365 * 24 * time.Hour,
+}
+
+// LoyaltyRulesFromEnv returns DefaultLoyaltyRules overridden by the
+// LOYALTY_EARN_RATE, LOYALTY_MIN_ORDER_TOTAL, LOYALTY_POINT_VALUE,
+// LOYALTY_MAX_REDEEM_PERCENT and LOYALTY_POINTS_TTL environment variables.
+func LoyaltyRulesFromEnv() (*LoyaltyRules, error) {
+
+	rules := DefaultLoyaltyRules
+	for env, v := range map[string]*int32{
+		"LOYALTY_EARN_RATE":          &rules.EarnRate,
+		"LOYALTY_MIN_ORDER_TOTAL":    &rules.MinOrderTotal,
+		"LOYALTY_POINT_VALUE":        &rules.PointValue,
+		"LOYALTY_MAX_REDEEM_PERCENT": &rules.MaxRedeemPercent,
+	} {
+		s := os.Getenv(env)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", env, err)
+		}
+		*v = int32(n)
+	}
+
+	if s := os.Getenv("LOYALTY_POINTS_TTL"); s != "" {
+		ttl, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid LOYALTY_POINTS_TTL: %w", err)
+		}
+		rules.ExpireAfter = ttl
+	}
+
+	switch {
+	case rules.EarnRate <= 0:
+		return nil, errors.New("LOYALTY_EARN_RATE must be positive")
+	case rules.PointValue <= 0:
+		return nil, errors.New("LOYALTY_POINT_VALUE must be positive")
+	case rules.MaxRedeemPercent <= 0 || rules.MaxRedeemPercent > 100:
+		return nil, errors.New("LOYALTY_MAX_REDEEM_PERCENT must be between 1 and 100")
+	case rules.ExpireAfter <= 0:
+		return nil, errors.New("LOYALTY_POINTS_TTL must be positive")
+	}
+
+	return &rules, nil
+}
+
+// earnedPoints returns the points an order total earns.
+func (r *LoyaltyRules) earnedPoints(total int32) int32 {
+	if total < r.MinOrderTotal {
+		return 0
+	}
+	return total / r.EarnRate
+}
+
+func (x *CustomerService) GetLoyaltyBalance(ctx context.Context, in *pb.GetLoyaltyBalanceRequest) (*pb.LoyaltyBalance, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	balance, err := x.store.getLoyaltyBalance(ctx, in.CustomerId)
+	if err != nil {
+		slog.Error("store get loyalty balance", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbLoyaltyBalance(in.CustomerId, balance), nil
+}
+
+func (x *CustomerService) ListLoyaltyTransactions(ctx context.Context, in *pb.ListLoyaltyTransactionsRequest) (*pb.ListLoyaltyTransactionsResponse, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	filter := &dbLoyaltyFilter{
+		CustomerID: in.CustomerId,
+		Limit:      int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 20
+	case filter.Limit > 100:
+		filter.Limit = 100
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeTransactionCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	transactions, err := x.store.listLoyaltyTransactions(ctx, filter)
+	if err != nil {
+		slog.Error("store list loyalty transactions", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListLoyaltyTransactionsResponse{}
+	for _, t := range transactions {
+		resp.Transactions = append(resp.Transactions, toPbLoyaltyTransaction(t))
+	}
+
+	if len(transactions) == filter.Limit {
+		last := transactions[len(transactions)-1]
+		resp.NextPageToken = encodeTransactionCursor(last.CreateTime, last.TransactionID)
+	}
+
+	return resp, nil
+}
+
+// RedeemLoyaltyPoints spends points for a discount on an order. The discount
+// may pay for at most MaxRedeemPercent of the order amount. Only the order
+// service can call it: points are redeemed while it places the order, before
+// the order exists, so the amount it computed from the menu is trusted.
+func (x *CustomerService) RedeemLoyaltyPoints(ctx context.Context, in *pb.RedeemLoyaltyPointsRequest) (*pb.RedeemLoyaltyPointsResponse, error) {
+
+	if err := x.services.authorize(ctx, serviceOrder); err != nil {
+		return nil, err
+	}
+
+	if in.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+	if in.Points <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "points must be positive")
+	}
+
+	maxDiscount := int64(in.OrderAmount) * int64(x.loyalty.MaxRedeemPercent) / 100
+	if int64(in.Points)*int64(x.loyalty.PointValue) > maxDiscount {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"points can pay for at most %d%% of the order", x.loyalty.MaxRedeemPercent)
+	}
+
+	redeemed, balance, err := x.store.redeemLoyaltyPoints(ctx, in.CustomerId, in.OrderId, in.Points)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, status.Error(codes.NotFound, "customer not found")
+		case errors.Is(err, errInsufficientPoints):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		slog.Error("store redeem loyalty points", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.RedeemLoyaltyPointsResponse{
+		Discount: redeemed * x.loyalty.PointValue,
+		Balance:  balance.Points,
+	}, nil
+}
+
+// ReverseLoyaltyRedemption gives back the points redeemed for a cancelled
+// order. They are valid for ExpireAfter again, from now. Only the order
+// service can call it.
+func (x *CustomerService) ReverseLoyaltyRedemption(ctx context.Context, in *pb.ReverseLoyaltyRedemptionRequest) (*pb.LoyaltyBalance, error) {
+
+	if err := x.services.authorize(ctx, serviceOrder); err != nil {
+		return nil, err
+	}
+
+	if in.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	balance, err := x.store.reverseLoyaltyRedemption(ctx, in.CustomerId, in.OrderId, time.Now().Add(x.loyalty.ExpireAfter))
+	if err != nil {
+		slog.Error("store reverse loyalty redemption", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbLoyaltyBalance(in.CustomerId, balance), nil
+}
+
+// HandleOrderDelivered credits the points a delivered order earns.
+func (x *CustomerService) HandleOrderDelivered(msg amqp.Delivery) error {
+
+	var event pb.OrderDeliveredEvent
+	if err := proto.Unmarshal(msg.Body, &event); err != nil {
+		return err
+	}
+	if event.Order == nil {
+		return errors.New("order delivered event without order")
+	}
+
+	order := event.Order
+	if _, err := uuid.Parse(order.CustomerId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	points := x.loyalty.earnedPoints(order.Total)
+	if points == 0 {
+		return nil
+	}
+
+	deliverTime := time.Now()
+	if event.DeliverTime != nil {
+		deliverTime = event.DeliverTime.AsTime()
+	}
+
+	earned, err := x.store.earnLoyaltyPoints(context.TODO(), order.CustomerId, order.OrderId,
+		points, deliverTime.Add(x.loyalty.ExpireAfter))
+	if err != nil {
+		return err
+	}
+
+	if earned {
+		slog.Info("earned loyalty points", "customerID", order.CustomerId, "orderID", order.OrderId, "points", points)
+	}
+	return nil
+}
+
+// LoyaltyExpirer expires loyalty points past their expire time.
+type LoyaltyExpirer struct {
+	store *customerStorage
+}
+
+func NewLoyaltyExpirer(store *customerStorage) *LoyaltyExpirer {
+	return &LoyaltyExpirer{store: store}
+}
+
+// Run expires points once per interval until ctx is done.
+func (e *LoyaltyExpirer) Run(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := e.store.expireLoyaltyPoints(ctx)
+			if err != nil {
+				slog.Error("store expire loyalty points", "err", err)
+				continue
+			}
+			if n > 0 {
+				slog.Info("loyalty points expired", "customers", n)
+			}
+		}
+	}
+}
+
+// transactionCursor is the page token of ListLoyaltyTransactions,
+// ListWalletTransactions and ListNotifications.
+type transactionCursor struct {
+	Time          int64  `json:"t"`
+	TransactionID string `json:"id"`
+}
+
+func encodeTransactionCursor(createTime time.Time, transactionID string) string {
+	b, _ := json.Marshal(transactionCursor{Time: createTime.UnixNano(), TransactionID: transactionID})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTransactionCursor(token string) (time.Time, string, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	var cursor transactionCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+	if _, err := uuid.Parse(cursor.TransactionID); err != nil {
+		return time.Time{}, "", errors.New("malformed page token")
+	}
+
+	return time.Unix(0, cursor.Time).UTC(), cursor.TransactionID, nil
+}
+
+func toPbLoyaltyBalance(customerID string, balance *dbLoyaltyBalance) *pb.LoyaltyBalance {
+	pbBalance := &pb.LoyaltyBalance{
+		CustomerId:         customerID,
+		Points:             balance.Points,
+		NextExpiringPoints: balance.NextExpiringPoints,
+	}
+	if balance.NextExpireTime != nil {
+		pbBalance.NextExpireTime = timestamppb.New(*balance.NextExpireTime)
+	}
+	return pbBalance
+}
+
+func toPbLoyaltyTransaction(t *dbLoyaltyTransaction) *pb.LoyaltyTransaction {
+	pbTransaction := &pb.LoyaltyTransaction{
+		TransactionId: t.TransactionID,
+		Type:          pb.LoyaltyTransactionType(t.Type),
+		Points:        t.Points,
+		OrderId:       t.OrderID,
+		CreateTime:    timestamppb.New(t.CreateTime),
+	}
+	if t.ExpireTime != nil {
+		pbTransaction.ExpireTime = timestamppb.New(*t.ExpireTime)
+	}
+	return pbTransaction
+}
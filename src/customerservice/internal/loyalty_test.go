@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// Points are only spent and given back by the order service. The store is
+// never reached, the caller is refused first.
+func TestLoyaltyServiceCalls(t *testing.T) {
+
+	x := &CustomerService{services: testServices()}
+
+	calls := map[string]func(context.Context) error{
+		"redeem": func(ctx context.Context) error {
+			_, err := x.RedeemLoyaltyPoints(ctx, &pb.RedeemLoyaltyPointsRequest{
+				CustomerId: testCustomerID, OrderId: "order-1", Points: 10, OrderAmount: 1_000_000,
+			})
+			return err
+		},
+		"reverse": func(ctx context.Context) error {
+			_, err := x.ReverseLoyaltyRedemption(ctx, &pb.ReverseLoyaltyRedemptionRequest{
+				CustomerId: testCustomerID, OrderId: "order-1",
+			})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		for caller, ctx := range map[string]context.Context{
+			"no identity":     context.Background(),
+			"customer":        callerContext(testCustomerID, "ROLES_CUSTOMER"),
+			"forged token":    serviceContext("forged"),
+			"payment service": serviceContext("payment"),
+		} {
+			if got := status.Code(call(ctx)); got != codes.PermissionDenied {
+				t.Errorf("%s by %s: got %v, want PermissionDenied", name, caller, got)
+			}
+		}
+	}
+}
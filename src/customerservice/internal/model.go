@@ -10,6 +10,8 @@ type dbNewCustomer struct {
 	CustomerID string
 	Username   string
 	Email      string
+	// Phone is only known at creation for guests.
+	Phone      *string
 	CreateTime time.Time
 }
 
@@ -18,11 +20,267 @@ type dbCustomer struct {
 	Username   string
 	Email      string
 	Phone      string
-	//Picture    []byte
+	// Picture is nil for customers without a profile picture.
+	Picture    *dbPicture
 	Social     dbSocial
 	Addresses  []*dbAddress
 	CreateTime time.Time
 	UpdateTime time.Time
+	// LastOrderTime is nil for customers without orders.
+	LastOrderTime *time.Time
+}
+
+// dbCustomerSort mirrors pb.CustomerSort.
+type dbCustomerSort int
+
+const (
+	customerSortCreateTimeDesc dbCustomerSort = iota
+	customerSortCreateTimeAsc
+	customerSortUsernameAsc
+	customerSortUsernameDesc
+	customerSortLastOrderTimeDesc
+)
+
+type dbCustomerFilter struct {
+	EmailPrefix    string
+	PhonePrefix    string
+	CreateTimeFrom *time.Time
+	CreateTimeTo   *time.Time
+	// HasOrders is nil for customers with or without orders.
+	HasOrders *bool
+	Sort      dbCustomerSort
+	// After is the last customer of the previous page.
+	After *dbCustomerCursor
+	Limit int
+}
+
+// dbCustomerCursor is the position of a customer in a sort. Key is the value
+// of the sort column, a time.Time or a string.
+type dbCustomerCursor struct {
+	Key        any
+	CustomerID string
+}
+
+// dbContact is the copy of the contact fields owned by auth.
+type dbContact struct {
+	CustomerID string
+	Email      string
+	Phone      string
+}
+
+// dbFavouriteMerchant holds a copy of the merchant name and image.
+type dbFavouriteMerchant struct {
+	MerchantID   string
+	MerchantName string
+	ImageURL     string
+	CreateTime   time.Time
+}
+
+type dbFavouriteMenuItem struct {
+	MerchantID   string
+	ItemID       string
+	FoodName     string
+	MerchantName string
+	ImageURL     string
+	Available    bool
+	CreateTime   time.Time
+}
+
+// dbMerchantCopy is what favourites copy from a merchant.
+type dbMerchantCopy struct {
+	MerchantID   string
+	MerchantName string
+	ImageURL     string
+	Menu         []*dbMenuItemCopy
+}
+
+type dbMenuItemCopy struct {
+	ItemID   string
+	FoodName string
+	ImageURL string
+}
+
+// dbDataExportState mirrors pb.DataExportState.
+type dbDataExportState int16
+
+const (
+	dataExportStateUnspecified dbDataExportState = iota
+	dataExportStatePending
+	dataExportStateRunning
+	dataExportStateSucceeded
+	dataExportStateFailed
+	dataExportStateExpired
+)
+
+// dbDataExport is an export without its archive.
+type dbDataExport struct {
+	ExportID   string
+	CustomerID string
+	State      dbDataExportState
+	SizeBytes  int64
+	CreateTime time.Time
+	// CompleteTime is set once the export succeeded or failed, ExpireTime once
+	// it succeeded.
+	CompleteTime *time.Time
+	ExpireTime   *time.Time
+}
+
+// dbLoyaltyTransactionType mirrors pb.LoyaltyTransactionType.
+type dbLoyaltyTransactionType int16
+
+const (
+	loyaltyTransactionUnspecified dbLoyaltyTransactionType = iota
+	loyaltyTransactionEarn
+	loyaltyTransactionRedeem
+	loyaltyTransactionReverse
+	loyaltyTransactionExpire
+)
+
+type dbLoyaltyTransaction struct {
+	TransactionID string
+	Type          dbLoyaltyTransactionType
+	Points        int32
+	OrderID       string
+	// ExpireTime is set for credits, earned and given back points.
+	ExpireTime *time.Time
+	CreateTime time.Time
+}
+
+type dbLoyaltyBalance struct {
+	Points             int32
+	NextExpiringPoints int32
+	NextExpireTime     *time.Time
+}
+
+type dbLoyaltyFilter struct {
+	CustomerID string
+	// BeforeTime and BeforeID are the last transaction of the previous page.
+	BeforeTime *time.Time
+	BeforeID   string
+	Limit      int
+}
+
+// dbWalletTransactionType mirrors pb.WalletTransactionType.
+type dbWalletTransactionType int16
+
+const (
+	walletTransactionUnspecified dbWalletTransactionType = iota
+	walletTransactionTopUp
+	walletTransactionGiftCard
+	walletTransactionPayment
+	walletTransactionRefund
+	walletTransactionTransfer
+	walletTransactionGiftCardIssue
+)
+
+// System accounts of the wallet ledger, money customers put in or took out
+// of their wallets.
+const (
+	walletAccountTopUps        = "top_ups"
+	walletAccountGiftCardSales = "gift_card_sales"
+	walletAccountGiftCards     = "gift_cards"
+	walletAccountOrderPayments = "order_payments"
+)
+
+// dbWalletTransfer moves Amount from one ledger account to another.
+type dbWalletTransfer struct {
+	IdempotencyKey string
+	Type           dbWalletTransactionType
+	Reference      string
+	FromAccountID  string
+	ToAccountID    string
+	Amount         int64
+}
+
+// dbWalletTransaction is a transaction as seen by one account, Amount is
+// the entry of that account.
+type dbWalletTransaction struct {
+	TransactionID string
+	Type          dbWalletTransactionType
+	Amount        int64
+	Reference     string
+	CreateTime    time.Time
+}
+
+type dbWalletFilter struct {
+	CustomerID string
+	// BeforeTime and BeforeID are the last transaction of the previous page.
+	BeforeTime *time.Time
+	BeforeID   string
+	Limit      int
+}
+
+type dbGiftCard struct {
+	GiftCardID string
+	// Code is only known when the gift card is issued, the store keeps
+	// CodeHash.
+	Code       string
+	CodeHash   []byte
+	Amount     int64
+	ExpireTime *time.Time
+	// RedeemedBy and RedeemTime are set once the gift card is redeemed.
+	RedeemedBy *string
+	RedeemTime *time.Time
+	CreateTime time.Time
+}
+
+// dbNotificationChannel mirrors pb.NotificationChannel.
+type dbNotificationChannel int16
+
+const (
+	notificationChannelUnspecified dbNotificationChannel = iota
+	notificationChannelPush
+	notificationChannelEmail
+	notificationChannelSMS
+	notificationChannelLine
+	notificationChannelInApp
+)
+
+// dbNotificationCategory mirrors pb.NotificationCategory.
+type dbNotificationCategory int16
+
+const (
+	notificationCategoryUnspecified dbNotificationCategory = iota
+	notificationCategoryOrderUpdates
+	notificationCategoryPromotions
+)
+
+type dbNotificationPreference struct {
+	Category dbNotificationCategory
+	Channel  dbNotificationChannel
+	Enabled  bool
+}
+
+type dbNotification struct {
+	NotificationID string
+	Category       dbNotificationCategory
+	Title          string
+	Body           string
+	Reference      string
+	// DedupKey is unique per customer, only used when creating.
+	DedupKey string
+	// ReadTime is nil for unread notifications.
+	ReadTime   *time.Time
+	CreateTime time.Time
+}
+
+type dbNotificationFilter struct {
+	CustomerID string
+	UnreadOnly bool
+	// BeforeTime and BeforeID are the last notification of the previous page.
+	BeforeTime *time.Time
+	BeforeID   string
+	Limit      int
+}
+
+// dbPicture is stored as JSON. Key is the prefix of the blob keys of its
+// variants.
+type dbPicture struct {
+	Key        string    `json:"key"`
+	SmallURL   string    `json:"small_url"`
+	MediumURL  string    `json:"medium_url"`
+	LargeURL   string    `json:"large_url"`
+	UpdateTime time.Time `json:"update_time"`
 }
 
 type dbSocial struct {
@@ -38,4 +296,13 @@ type dbAddress struct {
 	District    *string
 	Province    *string
 	PostalCode  *string
+	// Latitude and Longitude are both nil for addresses without a location.
+	// LocationApproximate is set when they were geocoded rather than pinned.
+	Latitude             *float64
+	Longitude            *float64
+	LocationApproximate  bool
+	Detail               *string
+	Landmark             *string
+	DeliveryInstructions *string
+	IsDefault            bool
 }
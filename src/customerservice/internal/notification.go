@@ -0,0 +1,358 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+var (
+	notificationCategories = []dbNotificationCategory{
+		notificationCategoryOrderUpdates,
+		notificationCategoryPromotions,
+	}
+	notificationChannels = []dbNotificationChannel{
+		notificationChannelPush,
+		notificationChannelEmail,
+		notificationChannelSMS,
+		notificationChannelLine,
+		notificationChannelInApp,
+	}
+)
+
+// defaultNotificationPreferences are the channels enabled for customers who
+// have not set a preference, the others are disabled.
+var defaultNotificationPreferences = map[dbNotificationCategory][]dbNotificationChannel{
+	notificationCategoryOrderUpdates: {notificationChannelPush, notificationChannelEmail, notificationChannelInApp},
+	notificationCategoryPromotions:   {notificationChannelInApp},
+}
+
+func notificationEnabledByDefault(category dbNotificationCategory, channel dbNotificationChannel) bool {
+	return slices.Contains(defaultNotificationPreferences[category], channel)
+}
+
+func (x *CustomerService) GetNotificationPreferences(ctx context.Context, in *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	return x.notificationPreferences(ctx, in.CustomerId)
+}
+
+func (x *CustomerService) UpdateNotificationPreferences(ctx context.Context, in *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	var prefs []*dbNotificationPreference
+	for _, p := range in.Preferences {
+		category := dbNotificationCategory(p.Category)
+		channel := dbNotificationChannel(p.Channel)
+		if !slices.Contains(notificationCategories, category) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown notification category %v", p.Category)
+		}
+		if !slices.Contains(notificationChannels, channel) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown notification channel %v", p.Channel)
+		}
+		prefs = append(prefs, &dbNotificationPreference{
+			Category: category,
+			Channel:  channel,
+			Enabled:  p.Enabled,
+		})
+	}
+
+	if err := x.store.updateNotificationPreferences(ctx, in.CustomerId, prefs); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store update notification preferences", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return x.notificationPreferences(ctx, in.CustomerId)
+}
+
+func (x *CustomerService) notificationPreferences(ctx context.Context, customerID string) (*pb.NotificationPreferences, error) {
+
+	prefs, err := x.store.listNotificationPreferences(ctx, customerID)
+	if err != nil {
+		slog.Error("store list notification preferences", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return toPbNotificationPreferences(customerID, prefs), nil
+}
+
+func (x *CustomerService) ListNotifications(ctx context.Context, in *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	filter := &dbNotificationFilter{
+		CustomerID: in.CustomerId,
+		UnreadOnly: in.UnreadOnly,
+		Limit:      int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 20
+	case filter.Limit > 100:
+		filter.Limit = 100
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeTransactionCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	notifications, err := x.store.listNotifications(ctx, filter)
+	if err != nil {
+		slog.Error("store list notifications", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListNotificationsResponse{}
+	for _, n := range notifications {
+		resp.Notifications = append(resp.Notifications, toPbNotification(n))
+	}
+
+	if len(notifications) == filter.Limit {
+		last := notifications[len(notifications)-1]
+		resp.NextPageToken = encodeTransactionCursor(last.CreateTime, last.NotificationID)
+	}
+
+	return resp, nil
+}
+
+// MarkNotificationsRead marks the given notifications as read, all of them
+// when none are given. Notifications read already keep their read time.
+func (x *CustomerService) MarkNotificationsRead(ctx context.Context, in *pb.MarkNotificationsReadRequest) (*pb.UnreadNotificationCount, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	for _, id := range in.NotificationIds {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid notification id %q", id)
+		}
+	}
+
+	var ids []string
+	if len(in.NotificationIds) > 0 {
+		ids = in.NotificationIds
+	}
+
+	if err := x.store.markNotificationsRead(ctx, in.CustomerId, ids); err != nil {
+		slog.Error("store mark notifications read", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return x.unreadNotificationCount(ctx, in.CustomerId)
+}
+
+func (x *CustomerService) GetUnreadNotificationCount(ctx context.Context, in *pb.GetUnreadNotificationCountRequest) (*pb.UnreadNotificationCount, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	return x.unreadNotificationCount(ctx, in.CustomerId)
+}
+
+func (x *CustomerService) unreadNotificationCount(ctx context.Context, customerID string) (*pb.UnreadNotificationCount, error) {
+
+	count, err := x.store.countUnreadNotifications(ctx, customerID)
+	if err != nil {
+		slog.Error("store count unread notifications", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.UnreadNotificationCount{UnreadCount: count}, nil
+}
+
+// HandleOrderPlacedNotification tells the customer that the order is placed.
+func (x *CustomerService) HandleOrderPlacedNotification(msg amqp.Delivery) error {
+
+	var order pb.PlaceOrder
+	if err := proto.Unmarshal(msg.Body, &order); err != nil {
+		return err
+	}
+
+	return x.notifyOrder(&order, "placed", "Order placed",
+		"Your order is sent to the merchant.")
+}
+
+// HandleOrderDeliveredNotification tells the customer that the order is
+// delivered.
+func (x *CustomerService) HandleOrderDeliveredNotification(msg amqp.Delivery) error {
+
+	var event pb.OrderDeliveredEvent
+	if err := proto.Unmarshal(msg.Body, &event); err != nil {
+		return err
+	}
+	if event.Order == nil {
+		return errors.New("order delivered event without order")
+	}
+
+	return x.notifyOrder(event.Order, "delivered", "Order delivered",
+		"Your order is delivered, enjoy your meal.")
+}
+
+// HandleOrderCancelledNotification tells the customer that the order is
+// cancelled, and refunded to the wallet when it was paid.
+func (x *CustomerService) HandleOrderCancelledNotification(msg amqp.Delivery) error {
+
+	var event pb.OrderCancelledEvent
+	if err := proto.Unmarshal(msg.Body, &event); err != nil {
+		return err
+	}
+	if event.Order == nil {
+		return errors.New("order cancelled event without order")
+	}
+
+	order := event.Order
+	body := "Your order is cancelled."
+	if order.PaymentStatus == pb.PaymentStatus_PAYMENT_STATUS_PAID {
+		body += fmt.Sprintf(" The payment of %d is refunded to your wallet.", int64(order.Total)+int64(order.DeliveryFee))
+	}
+
+	return x.notifyOrder(order, "cancelled", "Order cancelled", body)
+}
+
+// notifyOrder adds an order update to the inbox of the customer of the
+// order, once per order and event.
+func (x *CustomerService) notifyOrder(order *pb.PlaceOrder, event, title, body string) error {
+
+	if _, err := uuid.Parse(order.CustomerId); err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	created, err := x.store.createNotification(context.TODO(), order.CustomerId, &dbNotification{
+		Category:  notificationCategoryOrderUpdates,
+		Title:     title,
+		Body:      body,
+		Reference: order.OrderId,
+		DedupKey:  fmt.Sprintf("order:%s:%s", order.OrderId, event),
+	}, notificationEnabledByDefault(notificationCategoryOrderUpdates, notificationChannelInApp))
+	if err != nil {
+		return err
+	}
+
+	if created {
+		slog.Info("created notification", "customerID", order.CustomerId, "orderID", order.OrderId, "event", event)
+	}
+	return nil
+}
+
+// HandleCouponAdded announces a new coupon in the inbox of every customer
+// with promotions enabled.
+func (x *CustomerService) HandleCouponAdded(msg amqp.Delivery) error {
+
+	var event pb.CouponAddedEvent
+	if err := proto.Unmarshal(msg.Body, &event); err != nil {
+		return err
+	}
+	if event.Coupon == nil || event.Coupon.Code == "" {
+		return errors.New("coupon added event without coupon")
+	}
+
+	coupon := event.Coupon
+	addTime := time.Now()
+	if event.AddTime != nil {
+		addTime = event.AddTime.AsTime()
+	}
+
+	var body string
+	switch d := coupon.CouponDetails.(type) {
+	case *pb.Coupon_PercentDiscount:
+		body = fmt.Sprintf("Use code %s for %d%% off your next order.", coupon.Code, d.PercentDiscount.GetPercent())
+	case *pb.Coupon_FreeDelivery:
+		body = fmt.Sprintf("Use code %s for free delivery on your next order.", coupon.Code)
+	default:
+		body = fmt.Sprintf("Use code %s on your next order.", coupon.Code)
+	}
+
+	// The same code can be added again later, the add time tells them apart.
+	count, err := x.store.broadcastNotification(context.TODO(), &dbNotification{
+		Category:  notificationCategoryPromotions,
+		Title:     "New coupon",
+		Body:      body,
+		Reference: coupon.Code,
+		DedupKey:  fmt.Sprintf("coupon:%s:%d", coupon.Code, addTime.UnixNano()),
+	}, notificationEnabledByDefault(notificationCategoryPromotions, notificationChannelInApp))
+	if err != nil {
+		return err
+	}
+
+	slog.Info("announced coupon", "code", coupon.Code, "notifications", count)
+	return nil
+}
+
+// toPbNotificationPreferences returns the preference of every category and
+// channel, the default where the customer has not set one.
+func toPbNotificationPreferences(customerID string, prefs []*dbNotificationPreference) *pb.NotificationPreferences {
+
+	type pair struct {
+		category dbNotificationCategory
+		channel  dbNotificationChannel
+	}
+	enabled := make(map[pair]bool)
+	for _, p := range prefs {
+		enabled[pair{p.Category, p.Channel}] = p.Enabled
+	}
+
+	resp := &pb.NotificationPreferences{CustomerId: customerID}
+	for _, category := range notificationCategories {
+		for _, channel := range notificationChannels {
+			on, ok := enabled[pair{category, channel}]
+			if !ok {
+				on = notificationEnabledByDefault(category, channel)
+			}
+			resp.Preferences = append(resp.Preferences, &pb.NotificationPreference{
+				Category: pb.NotificationCategory(category),
+				Channel:  pb.NotificationChannel(channel),
+				Enabled:  on,
+			})
+		}
+	}
+
+	return resp
+}
+
+func toPbNotification(n *dbNotification) *pb.Notification {
+	pbNotification := &pb.Notification{
+		NotificationId: n.NotificationID,
+		Category:       pb.NotificationCategory(n.Category),
+		Title:          n.Title,
+		Body:           n.Body,
+		Reference:      n.Reference,
+		CreateTime:     timestamppb.New(n.CreateTime),
+	}
+	if n.ReadTime != nil {
+		pbNotification.ReadTime = timestamppb.New(*n.ReadTime)
+	}
+	return pbNotification
+}
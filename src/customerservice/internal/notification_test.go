@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+func TestNotificationsOfAnotherCustomer(t *testing.T) {
+
+	// The store is never reached, the caller is refused first.
+	x := &CustomerService{}
+	ctx := callerContext(testOtherID, "ROLES_CUSTOMER")
+
+	calls := map[string]func(context.Context) error{
+		"get preferences": func(ctx context.Context) error {
+			_, err := x.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"update preferences": func(ctx context.Context) error {
+			_, err := x.UpdateNotificationPreferences(ctx, &pb.UpdateNotificationPreferencesRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"list": func(ctx context.Context) error {
+			_, err := x.ListNotifications(ctx, &pb.ListNotificationsRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"mark read": func(ctx context.Context) error {
+			_, err := x.MarkNotificationsRead(ctx, &pb.MarkNotificationsReadRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"unread count": func(ctx context.Context) error {
+			_, err := x.GetUnreadNotificationCount(ctx, &pb.GetUnreadNotificationCountRequest{CustomerId: testCustomerID})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		if got := status.Code(call(ctx)); got != codes.PermissionDenied {
+			t.Errorf("%s: got %v, want PermissionDenied", name, got)
+		}
+		if got := status.Code(call(context.Background())); got != codes.Unauthenticated {
+			t.Errorf("%s without identity: got %v, want Unauthenticated", name, got)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+const maxPictureBytes = 5 << 20
+
+// MaxRecvMsgSize is the largest request the server accepts, a picture upload
+// and the rest of its request. gRPC refuses messages above 4 MiB by default.
+const MaxRecvMsgSize = maxPictureBytes + 1<<20
+
+// maxPicturePixels bounds the decoded size of a picture, a small file can
+// decode to a huge image.
+const maxPicturePixels = 16_000_000
+
+// pictureVariants are the sizes of CustomerPicture, in pixels.
+var pictureVariants = []struct {
+	name string
+	size int
+}{
+	{"small", 64},
+	{"medium", 256},
+	{"large", 1024},
+}
+
+var errInvalidPicture = errors.New("invalid picture")
+
+// UploadCustomerPicture replaces the profile picture of the customer. The
+// variants are stored before the customer so that it never points to
+// missing blobs, and the previous picture is deleted after.
+func (x *CustomerService) UploadCustomerPicture(ctx context.Context, in *pb.UploadCustomerPictureRequest) (*pb.CustomerPicture, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(in.Content) == 0:
+		return nil, status.Error(codes.InvalidArgument, "picture content is required")
+	case len(in.Content) > maxPictureBytes:
+		return nil, status.Errorf(codes.InvalidArgument, "picture must be at most %d MiB", maxPictureBytes>>20)
+	}
+
+	if _, err := x.store.getCustomer(ctx, in.CustomerId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store get customer", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	variants, err := processPicture(in.Content)
+	if err != nil {
+		if errors.Is(err, errInvalidPicture) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		slog.Error("process picture", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	picture := &dbPicture{
+		Key:        fmt.Sprintf("customers/%s/%s", in.CustomerId, uuid.NewString()),
+		UpdateTime: time.Now(),
+	}
+	urls := []*string{&picture.SmallURL, &picture.MediumURL, &picture.LargeURL}
+	for i, variant := range pictureVariants {
+		key := pictureBlobKey(picture.Key, variant.name)
+		if err := x.blobs.Put(ctx, key, "image/jpeg", variants[i]); err != nil {
+			slog.Error("blob put", "key", key, "err", err)
+			x.deletePicture(ctx, picture)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		*urls[i] = x.blobs.URL(key)
+	}
+
+	previous, err := x.store.setPicture(ctx, in.CustomerId, picture)
+	if err != nil {
+		x.deletePicture(ctx, picture)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store set picture", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if previous != nil {
+		x.deletePicture(ctx, previous)
+	}
+
+	return toPbCustomerPicture(picture), nil
+}
+
+func (x *CustomerService) DeleteCustomerPicture(ctx context.Context, in *pb.DeleteCustomerPictureRequest) (*emptypb.Empty, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersWrite); err != nil {
+		return nil, err
+	}
+
+	previous, err := x.store.setPicture(ctx, in.CustomerId, nil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		slog.Error("store set picture", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if previous != nil {
+		x.deletePicture(ctx, previous)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// deletePicture deletes the blobs of the picture. Failures only leave
+// unreferenced blobs behind, so they are logged.
+func (x *CustomerService) deletePicture(ctx context.Context, picture *dbPicture) {
+	for _, variant := range pictureVariants {
+		key := pictureBlobKey(picture.Key, variant.name)
+		if err := x.blobs.Delete(ctx, key); err != nil {
+			slog.Error("blob delete", "key", key, "err", err)
+		}
+	}
+}
+
+func pictureBlobKey(pictureKey, variant string) string {
+	return pictureKey + "-" + variant + ".jpg"
+}
+
+// processPicture returns the JPEGs of pictureVariants, cropped square. Only
+// the pixels are kept: re-encoding drops EXIF and other metadata, and the
+// EXIF orientation is applied first.
+func processPicture(data []byte) ([][]byte, error) {
+
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, fmt.Errorf("%w: %s is not a JPEG or PNG image", errInvalidPicture, contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidPicture, err)
+	}
+	if config.Width*config.Height > maxPicturePixels {
+		return nil, fmt.Errorf("%w: picture must be at most %d megapixels", errInvalidPicture, maxPicturePixels/1_000_000)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidPicture, err)
+	}
+
+	img := flatten(decoded)
+	if contentType == "image/jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+	img = cropSquare(img)
+
+	var variants [][]byte
+	for _, variant := range pictureVariants {
+		var buf bytes.Buffer
+		resized := resizeSquare(img, min(variant.size, img.Bounds().Dx()))
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, err
+		}
+		variants = append(variants, buf.Bytes())
+	}
+
+	return variants, nil
+}
+
+// flatten draws img on a white background at the origin, JPEG has no
+// transparency.
+func flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG, 1 when it has
+// none.
+func jpegOrientation(data []byte) int {
+
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			// EXIF comes before the image data.
+			return 1
+		}
+		n := int(binary.BigEndian.Uint16(data[i+2:]))
+		if n < 2 || i+2+n > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+n]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + n
+	}
+
+	return 1
+}
+
+// exifOrientation reads the orientation tag of the first IFD of EXIF data.
+func exifOrientation(tiff []byte) int {
+
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + 12*i
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+				return o
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+// orient turns an image stored with the EXIF orientation o upright.
+func orient(src *image.RGBA, o int) *image.RGBA {
+
+	if o <= 1 || o > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if o >= 5 {
+		// Orientations 5 to 8 swap width and height.
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch o {
+			case 2: // mirrored
+				sx, sy = w-1-x, y
+			case 3: // rotated 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // rotated 90° clockwise to display
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // rotated 90° counterclockwise to display
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):][:4], src.Pix[src.PixOffset(sx, sy):][:4])
+		}
+	}
+
+	return dst
+}
+
+// cropSquare keeps the centre square of img.
+func cropSquare(img *image.RGBA) *image.RGBA {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return img.SubImage(image.Rect(x0, y0, x0+side, y0+side)).(*image.RGBA)
+}
+
+// resizeSquare scales the square src down to size, each pixel the average of
+// the source pixels it covers.
+func resizeSquare(src *image.RGBA, size int) *image.RGBA {
+
+	b := src.Bounds()
+	side := b.Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	span := func(i int) (int, int) {
+		lo := i * side / size
+		return lo, max((i+1)*side/size, lo+1)
+	}
+
+	for y := 0; y < size; y++ {
+		y0, y1 := span(y)
+		for x := 0; x < size; x++ {
+			x0, x1 := span(x)
+
+			var sum [4]int
+			for sy := b.Min.Y + y0; sy < b.Min.Y+y1; sy++ {
+				i := src.PixOffset(b.Min.X+x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					for c := range sum {
+						sum[c] += int(src.Pix[i+c])
+					}
+					i += 4
+				}
+			}
+
+			n := (x1 - x0) * (y1 - y0)
+			o := dst.PixOffset(x, y)
+			for c := range sum {
+				dst.Pix[o+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+
+	return dst
+}
+
+func toPbCustomerPicture(picture *dbPicture) *pb.CustomerPicture {
+	return &pb.CustomerPicture{
+		SmallUrl:   picture.SmallURL,
+		MediumUrl:  picture.MediumURL,
+		LargeUrl:   picture.LargeURL,
+		UpdateTime: timestamppb.New(picture.UpdateTime),
+	}
+}
@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+func TestCustomerPictureOfAnotherCustomer(t *testing.T) {
+
+	// The store is never reached, the caller is refused first.
+	x := &CustomerService{}
+	ctx := callerContext(testOtherID, "ROLES_CUSTOMER")
+
+	calls := map[string]func(context.Context) error{
+		"upload": func(ctx context.Context) error {
+			_, err := x.UploadCustomerPicture(ctx, &pb.UploadCustomerPictureRequest{CustomerId: testCustomerID, Content: []byte{0xff, 0xd8}})
+			return err
+		},
+		"delete": func(ctx context.Context) error {
+			_, err := x.DeleteCustomerPicture(ctx, &pb.DeleteCustomerPictureRequest{CustomerId: testCustomerID})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		if got := status.Code(call(ctx)); got != codes.PermissionDenied {
+			t.Errorf("%s: got %v, want PermissionDenied", name, got)
+		}
+		if got := status.Code(call(context.Background())); got != codes.Unauthenticated {
+			t.Errorf("%s without identity: got %v, want Unauthenticated", name, got)
+		}
+		// Reading customers does not allow changing them.
+		readOnly := callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "customers:read")
+		if got := status.Code(call(readOnly)); got != codes.PermissionDenied {
+			t.Errorf("%s by admin with customers:read: got %v, want PermissionDenied", name, got)
+		}
+	}
+}
+
+// Pictures up to the size limit reach the handler, the transport does not
+// refuse them first.
+func TestCustomerPictureSizeLimit(t *testing.T) {
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(MaxRecvMsgSize))
+	pb.RegisterCustomerServiceServer(server, &CustomerService{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	client := pb.NewCustomerServiceClient(conn)
+
+	tests := []struct {
+		name   string
+		caller string
+		size   int
+		want   codes.Code
+	}{
+		// The store is never reached, the caller is refused after the
+		// request is received.
+		{"at the limit", testOtherID, maxPictureBytes, codes.PermissionDenied},
+		{"above the limit", testCustomerID, maxPictureBytes + 1, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "auth-id", tt.caller, "auth-role", "ROLES_CUSTOMER")
+			_, err := client.UploadCustomerPicture(ctx, &pb.UploadCustomerPictureRequest{
+				CustomerId: testCustomerID,
+				Content:    make([]byte, tt.size),
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("upload of %d bytes: got %v, want %v", tt.size, err, tt.want)
+			}
+		})
+	}
+}
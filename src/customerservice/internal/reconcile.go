@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+const contactPageSize = 500
+
+// ContactReconciler compares the email and phone of customers with auth, which
+// owns them, and reports drift. It does not change anything; the copies are
+// only updated by sync events.
+type ContactReconciler struct {
+	auth  pb.AuthServiceClient
+	store *customerStorage
+}
+
+func NewContactReconciler(auth pb.AuthServiceClient, store *customerStorage) *ContactReconciler {
+	return &ContactReconciler{
+		auth:  auth,
+		store: store,
+	}
+}
+
+// ContactDrift counts the differences found by one reconciliation.
+type ContactDrift struct {
+	Checked       int
+	Missing       int
+	EmailMismatch int
+	PhoneMismatch int
+	// Orphaned is the number of customers without an account in auth.
+	Orphaned int
+}
+
+func (d *ContactDrift) Drifted() bool {
+	return d.Missing+d.EmailMismatch+d.PhoneMismatch+d.Orphaned > 0
+}
+
+// Run reconciles once per interval until ctx is done.
+func (r *ContactReconciler) Run(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			drift, err := r.Reconcile(ctx)
+			if err != nil {
+				slog.Error("reconcile contacts", "err", err)
+				continue
+			}
+			if drift.Drifted() {
+				slog.Warn("customer contacts drifted from auth",
+					"checked", drift.Checked,
+					"missing", drift.Missing,
+					"emailMismatch", drift.EmailMismatch,
+					"phoneMismatch", drift.PhoneMismatch,
+					"orphaned", drift.Orphaned,
+				)
+			} else {
+				slog.Info("customer contacts are in sync with auth", "checked", drift.Checked)
+			}
+		}
+	}
+}
+
+// Reconcile pages through the customer accounts in auth and compares each
+// with the local copy. Every differing customer is logged.
+func (r *ContactReconciler) Reconcile(ctx context.Context) (*ContactDrift, error) {
+
+	var (
+		drift     ContactDrift
+		matched   int
+		pageToken string
+	)
+
+	for {
+		resp, err := r.auth.ListContacts(ctx, &pb.ListContactsRequest{
+			Role:      pb.Roles_ROLES_CUSTOMER,
+			PageSize:  contactPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, 0, len(resp.Contacts))
+		for _, c := range resp.Contacts {
+			ids = append(ids, c.AuthId)
+		}
+
+		local, err := r.store.listContacts(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, want := range resp.Contacts {
+			drift.Checked++
+
+			got, ok := local[want.AuthId]
+			if !ok {
+				drift.Missing++
+				slog.Warn("customer missing", "customerID", want.AuthId)
+				continue
+			}
+			matched++
+
+			if got.Email != want.Email {
+				drift.EmailMismatch++
+				slog.Warn("customer email drifted", "customerID", want.AuthId)
+			}
+			if got.Phone != want.PhoneNumber {
+				drift.PhoneMismatch++
+				slog.Warn("customer phone drifted", "customerID", want.AuthId)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	total, err := r.store.countCustomers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Customers created while paging can be counted here too, and are
+	// caught by the next run.
+	if total > matched {
+		drift.Orphaned = total - matched
+	}
+
+	return &drift, nil
+}
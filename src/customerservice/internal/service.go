@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/idtoken"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Services calling the RPCs without an HTTP route. Payments are confirmed by
+// the payment provider integration, which tops up wallets and issues gift
+// cards.
+const (
+	serviceOrder   = "order"
+	servicePayment = "payment"
+)
+
+// ServiceAuthenticator checks the Google ID token other services call with,
+// the same token Cloud Run checks before the request reaches the service. The
+// email of its service account names the calling service.
+type ServiceAuthenticator struct {
+	audience string
+	// accounts maps the email of a service account to the service.
+	accounts map[string]string
+	validate func(ctx context.Context, token, audience string) (*idtoken.Payload, error)
+}
+
+// NewServiceAuthenticator accepts ID tokens for the audience, the URL of this
+// service, from the service accounts in accounts.
+func NewServiceAuthenticator(audience string, accounts map[string]string) *ServiceAuthenticator {
+	return &ServiceAuthenticator{
+		audience: audience,
+		accounts: accounts,
+		validate: idtoken.Validate,
+	}
+}
+
+// ServiceAuthenticatorFromEnv accepts ID tokens for SERVICE_AUDIENCE, the URL
+// of this service, from the service accounts in ORDER_SERVICE_ACCOUNT and
+// PAYMENT_SERVICE_ACCOUNT. Without SERVICE_AUDIENCE every service call is
+// refused.
+func ServiceAuthenticatorFromEnv() *ServiceAuthenticator {
+
+	audience := os.Getenv("SERVICE_AUDIENCE")
+	if audience == "" {
+		slog.Warn("SERVICE_AUDIENCE is not set, calls from other services are refused")
+	}
+
+	accounts := make(map[string]string)
+	for env, service := range map[string]string{
+		"ORDER_SERVICE_ACCOUNT":   serviceOrder,
+		"PAYMENT_SERVICE_ACCOUNT": servicePayment,
+	} {
+		if email := os.Getenv(env); email != "" {
+			accounts[email] = service
+		} else {
+			slog.Warn("service account is not set, calls from the service are refused", "env", env, "service", service)
+		}
+	}
+
+	return NewServiceAuthenticator(audience, accounts)
+}
+
+// authorize checks that the request comes from one of the services. Requests
+// without a valid ID token of a known service account are refused, as are
+// all when no authenticator is configured.
+func (a *ServiceAuthenticator) authorize(ctx context.Context, services ...string) error {
+
+	if a == nil || a.audience == "" {
+		return status.Error(codes.PermissionDenied, "service identity required")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.PermissionDenied, "service identity required")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || token == "" {
+		return status.Error(codes.PermissionDenied, "service identity required")
+	}
+
+	payload, err := a.validate(ctx, token, a.audience)
+	if err != nil {
+		slog.Warn("invalid service identity token", "err", err)
+		return status.Error(codes.PermissionDenied, "invalid service identity")
+	}
+
+	email, _ := payload.Claims["email"].(string)
+	verified, _ := payload.Claims["email_verified"].(bool)
+	service, known := a.accounts[email]
+	if !verified || !known || !slices.Contains(services, service) {
+		return status.Error(codes.PermissionDenied, "caller is not allowed to call this method")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/idtoken"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testOrderAccount   = "orderservice@ihavefood.iam.gserviceaccount.com"
+	testPaymentAccount = "paymentservice@ihavefood.iam.gserviceaccount.com"
+)
+
+// testServices accepts the tokens "order", "payment" and "unverified" in
+// place of ID tokens.
+func testServices() *ServiceAuthenticator {
+
+	a := NewServiceAuthenticator("https://customerservice.example.com", map[string]string{
+		testOrderAccount:   serviceOrder,
+		testPaymentAccount: servicePayment,
+	})
+	a.validate = func(ctx context.Context, token, audience string) (*idtoken.Payload, error) {
+		claims := map[string]any{"email_verified": true}
+		switch token {
+		case "order":
+			claims["email"] = testOrderAccount
+		case "payment":
+			claims["email"] = testPaymentAccount
+		case "unverified":
+			claims["email"] = testOrderAccount
+			claims["email_verified"] = false
+		default:
+			return nil, errors.New("invalid token")
+		}
+		return &idtoken.Payload{Audience: audience, Claims: claims}, nil
+	}
+	return a
+}
+
+// serviceContext is the context of a call by another service with the token.
+func serviceContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestServiceAuthenticator(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		services *ServiceAuthenticator
+		ctx      context.Context
+		want     codes.Code
+	}{
+		{"allowed service", testServices(), serviceContext("order"), codes.OK},
+		{"other service", testServices(), serviceContext("payment"), codes.PermissionDenied},
+		{"unverified email", testServices(), serviceContext("unverified"), codes.PermissionDenied},
+		{"invalid token", testServices(), serviceContext("forged"), codes.PermissionDenied},
+		{"customer", testServices(), callerContext(testCustomerID, "ROLES_CUSTOMER"), codes.PermissionDenied},
+		{"no identity", testServices(), context.Background(), codes.PermissionDenied},
+		{"not configured", NewServiceAuthenticator("", nil), serviceContext("order"), codes.PermissionDenied},
+		{"no authenticator", nil, serviceContext("order"), codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.services.authorize(tt.ctx, serviceOrder)); got != tt.want {
+				t.Errorf("authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
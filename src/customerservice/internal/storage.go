@@ -2,8 +2,13 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"log/slog"
 )
 
 func NewCustomerStorage(pool *pgxpool.Pool) *customerStorage {
@@ -14,41 +19,127 @@ type customerStorage struct {
 	pool *pgxpool.Pool
 }
 
-func (s *customerStorage) listCustomers(ctx context.Context) ([]*dbCustomer, error) {
-	customerRows, err := s.pool.Query(ctx, `
-		SELECT customer_id, username, facebook, instagram, line, create_time, update_time
-		FROM customers
-	`)
+// customerSortColumns are the ORDER BY expression and direction of each sort.
+// Ties are broken by customer_id in the same direction.
+var customerSortColumns = map[dbCustomerSort]struct {
+	Expr string
+	Desc bool
+}{
+	customerSortCreateTimeDesc:    {"create_time", true},
+	customerSortCreateTimeAsc:     {"create_time", false},
+	customerSortUsernameAsc:       {"username", false},
+	customerSortUsernameDesc:      {"username", true},
+	customerSortLastOrderTimeDesc: {"COALESCE(last_order_time, 'epoch')", true},
+}
+
+// listCustomers returns a page of the customers matching the filter, with
+// their addresses.
+func (s *customerStorage) listCustomers(ctx context.Context, filter *dbCustomerFilter) ([]*dbCustomer, error) {
+
+	sort, ok := customerSortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown customer sort %d", filter.Sort)
+	}
+	direction, compare := "ASC", ">"
+	if sort.Desc {
+		direction, compare = "DESC", "<"
+	}
+
+	args := []any{
+		escapeLike(strings.ToLower(filter.EmailPrefix)),
+		escapeLike(filter.PhonePrefix),
+		filter.CreateTimeFrom,
+		filter.CreateTimeTo,
+		filter.HasOrders,
+		filter.Limit,
+	}
+
+	after := ""
+	if filter.After != nil {
+		after = fmt.Sprintf("AND (%s, customer_id) %s ($7, $8::uuid)", sort.Expr, compare)
+		args = append(args, filter.After.Key, filter.After.CustomerID)
+	}
+
+	rows, err := s.pool.Query(ctx, fmt.Sprintf(`
+		SELECT
+			customer_id,
+			username,
+			email,
+			COALESCE(phone, ''),
+			facebook,
+			instagram,
+			line,
+			create_time,
+			update_time,
+			last_order_time,
+			picture
+		FROM
+			customers
+		WHERE
+			delete_time IS NULL AND
+			($1 = '' OR lower(email) LIKE $1 || '%%') AND
+			($2 = '' OR phone LIKE $2 || '%%') AND
+			($3::timestamp IS NULL OR create_time >= $3) AND
+			($4::timestamp IS NULL OR create_time < $4) AND
+			($5::boolean IS NULL OR (last_order_time IS NOT NULL) = $5)
+			%s
+		ORDER BY
+			%s %s, customer_id %s
+		LIMIT $6
+	`, after, sort.Expr, direction, direction), args...)
 	if err != nil {
 		return nil, err
 	}
-	defer customerRows.Close()
+	defer rows.Close()
 
-	customersMap := make(map[string]*dbCustomer)
-	var customerIDs []string
-
-	for customerRows.Next() {
-		var p dbCustomer
-		err := customerRows.Scan(
-			&p.CustomerID, &p.Username, &p.Social.Facebook,
-			&p.Social.Instagram, &p.Social.Line, &p.CreateTime, &p.UpdateTime,
-		)
-		if err != nil {
+	var (
+		customers   []*dbCustomer
+		customerIDs []string
+	)
+	for rows.Next() {
+		var c dbCustomer
+		if err := rows.Scan(
+			&c.CustomerID,
+			&c.Username,
+			&c.Email,
+			&c.Phone,
+			&c.Social.Facebook,
+			&c.Social.Instagram,
+			&c.Social.Line,
+			&c.CreateTime,
+			&c.UpdateTime,
+			&c.LastOrderTime,
+			&c.Picture,
+		); err != nil {
 			return nil, err
 		}
-		p.Addresses = []*dbAddress{}
-		customersMap[p.CustomerID] = &p
-		customerIDs = append(customerIDs, p.CustomerID)
+		customers = append(customers, &c)
+		customerIDs = append(customerIDs, c.CustomerID)
 	}
-	if err = customerRows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	if len(customerIDs) == 0 {
+	if len(customers) == 0 {
 		return []*dbCustomer{}, nil
 	}
 
-	query := `
+	addresses, err := s.listAddresses(ctx, customerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range customers {
+		c.Addresses = addresses[c.CustomerID]
+	}
+
+	return customers, nil
+}
+
+// listAddresses returns the addresses of the customers in one query, keyed by
+// customer ID.
+func (s *customerStorage) listAddresses(ctx context.Context, customerIDs []string) (map[string][]*dbAddress, error) {
+
+	rows, err := s.pool.Query(ctx, `
 		SELECT
 			customer_id,
 			address_id,
@@ -56,70 +147,65 @@ func (s *customerStorage) listCustomers(ctx context.Context) ([]*dbCustomer, err
 			sub_district,
 			district,
 			province,
-			postal_code
+			postal_code,
+			latitude,
+			longitude,
+			detail,
+			landmark,
+			delivery_instructions,
+			is_default,
+			location_approximate
 		FROM addresses
-		WHERE customer_id =  ANY($1)
-	`
-	addressRows, err := s.pool.Query(ctx, query, customerIDs)
+		WHERE customer_id = ANY($1)
+	`,
+		customerIDs,
+	)
 	if err != nil {
 		return nil, err
 	}
-	defer addressRows.Close()
+	defer rows.Close()
 
-	for addressRows.Next() {
+	addresses := make(map[string][]*dbAddress, len(customerIDs))
+	for rows.Next() {
 
 		var (
 			customerID string
 			addr       dbAddress
 		)
 
-		if err := addressRows.Scan(
-			&customerID,
-			&addr.AddressID,
-			&addr.AddressName,
-			&addr.SubDistrict,
-			&addr.District,
-			&addr.Province,
-			&addr.PostalCode,
-		); err != nil {
+		if err := rows.Scan(append([]any{&customerID}, addressFields(&addr)...)...); err != nil {
 			return nil, err
 		}
 
-		if customer, ok := customersMap[customerID]; ok {
-			customer.Addresses = append(customer.Addresses, &addr)
-		} else {
-			slog.Warn("Address found for unknown customer", "customerID", customerID)
-		}
+		addresses[customerID] = append(addresses[customerID], &addr)
 	}
-	if err = addressRows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	customers := make([]*dbCustomer, 0, len(customersMap))
-	for _, id := range customerIDs {
-		customers = append(customers, customersMap[id])
-	}
-
-	return customers, nil
+	return addresses, nil
 }
 
 func (s *customerStorage) getCustomer(ctx context.Context, customerID string) (*dbCustomer, error) {
 	var customer dbCustomer
 	if err := s.pool.QueryRow(ctx, `
 		SELECT 
-			customer_id,username,email,facebook,instagram,line,create_time,update_time
+			customer_id,username,email,COALESCE(phone, ''),facebook,instagram,line,create_time,update_time,last_order_time,picture
 		FROM customers 
-		WHERE customer_id = $1`,
+		WHERE customer_id = $1 AND delete_time IS NULL`,
 		customerID,
 	).Scan(
 		&customer.CustomerID,
 		&customer.Username,
 		&customer.Email,
+		&customer.Phone,
 		&customer.Social.Facebook,
 		&customer.Social.Instagram,
 		&customer.Social.Line,
 		&customer.CreateTime,
 		&customer.UpdateTime,
+		&customer.LastOrderTime,
+		&customer.Picture,
 	); err != nil {
 		return nil, err
 	}
@@ -131,7 +217,14 @@ func (s *customerStorage) getCustomer(ctx context.Context, customerID string) (*
 			sub_district,
 			district,
 			province,
-			postal_code
+			postal_code,
+			latitude,
+			longitude,
+			detail,
+			landmark,
+			delivery_instructions,
+			is_default,
+			location_approximate
 		FROM
 			addresses
 		WHERE customer_id = $1`,
@@ -144,14 +237,7 @@ func (s *customerStorage) getCustomer(ctx context.Context, customerID string) (*
 
 	for addressRows.Next() {
 		var addr dbAddress
-		if err := addressRows.Scan(
-			&addr.AddressID,
-			&addr.AddressName,
-			&addr.SubDistrict,
-			&addr.District,
-			&addr.Province,
-			&addr.PostalCode,
-		); err != nil {
+		if err := addressRows.Scan(addressFields(&addr)...); err != nil {
 			return nil, err
 		}
 		customer.Addresses = append(customer.Addresses, &addr)
@@ -173,19 +259,19 @@ func (s *customerStorage) getAddress(ctx context.Context, customerID, addressID
             sub_district,
             district,
             province,
-            postal_code
+            postal_code,
+            latitude,
+            longitude,
+            detail,
+            landmark,
+            delivery_instructions,
+            is_default,
+            location_approximate
         FROM addresses
         WHERE customer_id = $1 AND address_id = $2
     `, customerID, addressID)
 
-	if err := row.Scan(
-		&addr.AddressID,
-		&addr.AddressName,
-		&addr.SubDistrict,
-		&addr.District,
-		&addr.Province,
-		&addr.PostalCode,
-	); err != nil {
+	if err := row.Scan(addressFields(&addr)...); err != nil {
 		return nil, err
 	}
 
@@ -198,14 +284,16 @@ func (s *customerStorage) create(ctx context.Context, newCustomer *dbNewCustomer
 		INSERT INTO customers(
 			customer_id,
 			username,
-			email
+			email,
+			phone
 		)
-		VALUES($1,$2,$3)
+		VALUES($1,$2,$3,$4)
 		RETURNING customer_id
 	`,
 		newCustomer.CustomerID,
 		newCustomer.Username,
 		newCustomer.Email,
+		newCustomer.Phone,
 	)
 
 	var customerID string
@@ -216,18 +304,43 @@ func (s *customerStorage) create(ctx context.Context, newCustomer *dbNewCustomer
 	return customerID, nil
 }
 
+// createAddress adds an address. It becomes the default address when asked
+// to or when the customer has none yet.
 func (s *customerStorage) createAddress(ctx context.Context, customerID string, newAddress *dbAddress) (string, error) {
 
-	row := s.pool.QueryRow(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	if newAddress.IsDefault {
+		if err := clearDefaultAddressTx(ctx, tx, customerID); err != nil {
+			return "", err
+		}
+	}
+
+	row := tx.QueryRow(ctx, `
     INSERT INTO addresses (
         customer_id,
         address_name,
         sub_district,
         district,
         province,
-        postal_code
+        postal_code,
+        latitude,
+        longitude,
+        detail,
+        landmark,
+        delivery_instructions,
+        is_default,
+        location_approximate
+    )
+    VALUES (
+        $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+        $12 OR NOT EXISTS (SELECT 1 FROM addresses WHERE customer_id = $1 AND is_default),
+        $13
     )
-    VALUES ($1, $2, $3, $4, $5, $6)
     RETURNING address_id
 	`,
 		customerID,
@@ -236,6 +349,13 @@ func (s *customerStorage) createAddress(ctx context.Context, customerID string,
 		newAddress.District,
 		newAddress.Province,
 		newAddress.PostalCode,
+		newAddress.Latitude,
+		newAddress.Longitude,
+		newAddress.Detail,
+		newAddress.Landmark,
+		newAddress.DeliveryInstructions,
+		newAddress.IsDefault,
+		newAddress.LocationApproximate,
 	)
 
 	var addressID string
@@ -243,15 +363,20 @@ func (s *customerStorage) createAddress(ctx context.Context, customerID string,
 		return "", err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+
 	return addressID, nil
 }
 
-func (s *customerStorage) updateCustomerInfo(ctx context.Context, customerID, username, phone string) (string, error) {
+// updateCustomerInfo updates the profile fields. Email and phone are only
+// changed by auth sync events.
+func (s *customerStorage) updateCustomerInfo(ctx context.Context, customerID, username string) (string, error) {
 	row := s.pool.QueryRow(ctx, `
     UPDATE customers
     SET
       username = COALESCE(NULLIF($2, ''), username),
-	  phone    = COALESCE(NULLIF($3, ''), phone),
       update_time = NOW()
     WHERE customer_id = $1
     RETURNING customer_id;
@@ -292,16 +417,57 @@ func (s *customerStorage) updateCustomerSocial(ctx context.Context, customerID s
 	return id, nil
 }
 
+// updateCustomerAddress updates the non-empty fields of the address. Setting
+// IsDefault makes it the default address; the default is only moved, never
+// unset. LocationApproximate is only written with new coordinates.
+// setPicture replaces the profile picture of the customer and returns the
+// previous one, nil when there was none. A nil picture removes it.
+func (s *customerStorage) setPicture(ctx context.Context, customerID string, picture *dbPicture) (*dbPicture, error) {
+	var previous *dbPicture
+	err := s.pool.QueryRow(ctx, `
+    UPDATE customers AS c
+    SET picture = $2, update_time = NOW()
+    FROM (
+      SELECT customer_id, picture FROM customers WHERE customer_id = $1 FOR UPDATE
+    ) AS old
+    WHERE c.customer_id = old.customer_id
+    RETURNING old.picture
+  `,
+		customerID,
+		picture,
+	).Scan(&previous)
+	return previous, err
+}
+
 func (s *customerStorage) updateCustomerAddress(ctx context.Context, customerID, addressID string, addr *dbAddress) (string, error) {
 
-	row := s.pool.QueryRow(ctx, `
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	if addr.IsDefault {
+		if err := clearDefaultAddressTx(ctx, tx, customerID); err != nil {
+			return "", err
+		}
+	}
+
+	row := tx.QueryRow(ctx, `
     UPDATE addresses
     SET
       address_name = COALESCE(NULLIF($3,''), address_name),
       sub_district = COALESCE(NULLIF($4,''), sub_district),
       district     = COALESCE(NULLIF($5,''), district),
       province     = COALESCE(NULLIF($6,''), province),
-      postal_code  = COALESCE(NULLIF($7,''), postal_code)
+      postal_code  = COALESCE(NULLIF($7,''), postal_code),
+      latitude     = COALESCE($8, latitude),
+      longitude    = COALESCE($9, longitude),
+      detail       = COALESCE(NULLIF($10,''), detail),
+      landmark     = COALESCE(NULLIF($11,''), landmark),
+      delivery_instructions = COALESCE(NULLIF($12,''), delivery_instructions),
+      is_default   = is_default OR $13,
+      location_approximate = CASE WHEN $8::DOUBLE PRECISION IS NULL THEN location_approximate ELSE $14 END
     WHERE address_id = $2
       AND customer_id = $1
     RETURNING address_id;
@@ -313,15 +479,39 @@ func (s *customerStorage) updateCustomerAddress(ctx context.Context, customerID,
 		addr.District,
 		addr.Province,
 		addr.PostalCode,
+		addr.Latitude,
+		addr.Longitude,
+		addr.Detail,
+		addr.Landmark,
+		addr.DeliveryInstructions,
+		addr.IsDefault,
+		addr.LocationApproximate,
 	)
 
 	var addrId string
 	if err := row.Scan(&addrId); err != nil {
 		return "", err
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
 	return addrId, nil
 }
 
+func clearDefaultAddressTx(ctx context.Context, tx pgx.Tx, customerID string) error {
+	if _, err := tx.Exec(ctx, `
+    UPDATE addresses
+    SET is_default = FALSE
+    WHERE customer_id = $1 AND is_default
+  `,
+		customerID,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *customerStorage) delete(ctx context.Context, customerID string) error {
 	if _, err := s.pool.Exec(ctx, `DELETE FROM customers WHERE customer_id=$1`, customerID); err != nil {
 		return err
@@ -329,9 +519,1701 @@ func (s *customerStorage) delete(ctx context.Context, customerID string) error {
 	return nil
 }
 
-func (s *customerStorage) deleteAddress(ctx context.Context, customerID, addressID string) error {
-	if _, err := s.pool.Exec(ctx, `DELETE FROM addresses WHERE customer_id=$1 AND address_id=$2`, customerID, addressID); err != nil {
+// updateEmail applies an email change made in auth.
+func (s *customerStorage) updateEmail(ctx context.Context, customerID, email string) error {
+	if _, err := s.pool.Exec(ctx, `
+    UPDATE customers
+    SET
+      email       = $2,
+      update_time = NOW()
+    WHERE customer_id = $1 AND delete_time IS NULL
+  `,
+		customerID,
+		email,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// updatePhoneNumber applies a phone number change made in auth. An empty
+// number removes it.
+func (s *customerStorage) updatePhoneNumber(ctx context.Context, customerID, phoneNumber string) error {
+	if _, err := s.pool.Exec(ctx, `
+    UPDATE customers
+    SET
+      phone       = NULLIF($2, ''),
+      update_time = NOW()
+    WHERE customer_id = $1 AND delete_time IS NULL
+  `,
+		customerID,
+		phoneNumber,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// listContacts returns the email and phone of the customers with the IDs,
+// keyed by customer ID. Deleted customers are left out.
+func (s *customerStorage) listContacts(ctx context.Context, customerIDs []string) (map[string]*dbContact, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT customer_id, email, COALESCE(phone, '')
+		FROM customers
+		WHERE customer_id = ANY($1) AND delete_time IS NULL
+	`,
+		customerIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contacts := make(map[string]*dbContact)
+	for rows.Next() {
+		var c dbContact
+		if err := rows.Scan(&c.CustomerID, &c.Email, &c.Phone); err != nil {
+			return nil, err
+		}
+		contacts[c.CustomerID] = &c
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// countCustomers counts the customers that are not deleted.
+func (s *customerStorage) countCustomers(ctx context.Context) (int, error) {
+	var n int
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM customers WHERE delete_time IS NULL
+	`).Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// anonymize removes the personal data of a deleted account. The customer row
+// is kept, so orders still refer to an existing customer.
+func (s *customerStorage) anonymize(ctx context.Context, customerID string) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE customers
+    SET
+      username    = 'deleted-' || customer_id::text,
+      email       = customer_id::text || '@deleted.invalid',
+      phone       = NULL,
+      facebook    = NULL,
+      instagram   = NULL,
+      line        = NULL,
+      picture     = NULL,
+      delete_time = COALESCE(delete_time, NOW()),
+      update_time = NOW()
+    WHERE customer_id = $1
+  `,
+		customerID,
+	); err != nil {
+		return err
+	}
+
+	for _, table := range []string{"addresses", "favourite_merchants", "favourite_menu_items", "data_exports", "loyalty_transactions", "notifications", "notification_preferences"} {
+		if _, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE customer_id=$1`, customerID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// merge moves the addresses, favourites, loyalty points, wallet and inbox of
+// the source customer to the target and deletes the source. The target keeps
+// its own notification preferences. Merging a source that is gone already
+// does nothing.
+func (s *customerStorage) merge(ctx context.Context, sourceID, targetID string) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE addresses
+    SET
+      customer_id = $2,
+      is_default  = is_default AND NOT EXISTS (
+        SELECT 1 FROM addresses WHERE customer_id = $2 AND is_default
+      )
+    WHERE customer_id = $1
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE customers AS target
+    SET last_order_time = GREATEST(target.last_order_time, source.last_order_time)
+    FROM customers AS source
+    WHERE target.customer_id = $2 AND source.customer_id = $1
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	// Favourites the target has already are dropped with the source.
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO favourite_merchants (customer_id, merchant_id, merchant_name, image_url, create_time)
+    SELECT $2, merchant_id, merchant_name, image_url, create_time
+    FROM favourite_merchants
+    WHERE customer_id = $1
+    ON CONFLICT (customer_id, merchant_id) DO NOTHING
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO favourite_menu_items (customer_id, item_id, merchant_id, food_name, merchant_name, image_url, available, create_time)
+    SELECT $2, item_id, merchant_id, food_name, merchant_name, image_url, available, create_time
+    FROM favourite_menu_items
+    WHERE customer_id = $1
+    ON CONFLICT (customer_id, item_id) DO NOTHING
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE loyalty_transactions
+    SET customer_id = $2
+    WHERE customer_id = $1
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	if err := mergeWalletTx(ctx, tx, sourceID, targetID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO notifications (customer_id, category, title, body, reference, dedup_key, read_time, create_time)
+    SELECT $2, category, title, body, reference, dedup_key, read_time, create_time
+    FROM notifications
+    WHERE customer_id = $1
+    ON CONFLICT (customer_id, dedup_key) DO NOTHING
+  `,
+		sourceID,
+		targetID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM customers WHERE customer_id=$1`, sourceID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// markOrdered records an order of the customer. Older or redelivered orders
+// leave the time as is.
+func (s *customerStorage) markOrdered(ctx context.Context, customerID string, orderTime time.Time) error {
+	if _, err := s.pool.Exec(ctx, `
+    UPDATE customers
+    SET last_order_time = GREATEST(last_order_time, $2)
+    WHERE customer_id = $1
+  `,
+		customerID,
+		orderTime,
+	); err != nil {
 		return err
 	}
 	return nil
 }
+
+// deleteAddress deletes an address. When it was the default, another address
+// of the customer becomes the default.
+func (s *customerStorage) deleteAddress(ctx context.Context, customerID, addressID string) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var wasDefault bool
+	err = tx.QueryRow(ctx, `
+    DELETE FROM addresses
+    WHERE customer_id=$1 AND address_id=$2
+    RETURNING is_default
+  `,
+		customerID,
+		addressID,
+	).Scan(&wasDefault)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if wasDefault {
+		if _, err := tx.Exec(ctx, `
+    UPDATE addresses
+    SET is_default = TRUE
+    WHERE address_id = (
+      SELECT address_id FROM addresses
+      WHERE customer_id = $1
+      ORDER BY address_id
+      LIMIT 1
+    )
+  `,
+			customerID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// listFavouriteMerchants returns the favourite merchants of the customer,
+// newest first.
+func (s *customerStorage) listFavouriteMerchants(ctx context.Context, customerID string) ([]*dbFavouriteMerchant, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			merchant_id,
+			merchant_name,
+			image_url,
+			create_time
+		FROM favourite_merchants
+		WHERE customer_id = $1
+		ORDER BY create_time DESC, merchant_id
+	`,
+		customerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbFavouriteMerchant, error) {
+		var fav dbFavouriteMerchant
+		err := row.Scan(&fav.MerchantID, &fav.MerchantName, &fav.ImageURL, &fav.CreateTime)
+		return &fav, err
+	})
+}
+
+// listFavouriteMenuItems returns the favourite menu items of the customer,
+// newest first.
+func (s *customerStorage) listFavouriteMenuItems(ctx context.Context, customerID string) ([]*dbFavouriteMenuItem, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			merchant_id,
+			item_id,
+			food_name,
+			merchant_name,
+			image_url,
+			available,
+			create_time
+		FROM favourite_menu_items
+		WHERE customer_id = $1
+		ORDER BY create_time DESC, item_id
+	`,
+		customerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbFavouriteMenuItem, error) {
+		var fav dbFavouriteMenuItem
+		err := row.Scan(
+			&fav.MerchantID,
+			&fav.ItemID,
+			&fav.FoodName,
+			&fav.MerchantName,
+			&fav.ImageURL,
+			&fav.Available,
+			&fav.CreateTime,
+		)
+		return &fav, err
+	})
+}
+
+// countFavourites counts the favourite merchants and menu items of the
+// customer.
+func (s *customerStorage) countFavourites(ctx context.Context, customerID string) (merchants, menuItems int, err error) {
+	err = s.pool.QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM favourite_merchants WHERE customer_id = $1),
+			(SELECT COUNT(*) FROM favourite_menu_items WHERE customer_id = $1)
+	`,
+		customerID,
+	).Scan(&merchants, &menuItems)
+	return merchants, menuItems, err
+}
+
+// addFavouriteMerchant adds the merchant to the favourites of the customer.
+// Adding it again only refreshes the copied name and image.
+func (s *customerStorage) addFavouriteMerchant(ctx context.Context, customerID string, fav *dbFavouriteMerchant) (*dbFavouriteMerchant, error) {
+
+	row := s.pool.QueryRow(ctx, `
+    INSERT INTO favourite_merchants (
+        customer_id,
+        merchant_id,
+        merchant_name,
+        image_url
+    )
+    VALUES ($1, $2, $3, $4)
+    ON CONFLICT (customer_id, merchant_id) DO UPDATE
+    SET
+      merchant_name = EXCLUDED.merchant_name,
+      image_url     = EXCLUDED.image_url
+    RETURNING merchant_id, merchant_name, image_url, create_time
+  `,
+		customerID,
+		fav.MerchantID,
+		fav.MerchantName,
+		fav.ImageURL,
+	)
+
+	var added dbFavouriteMerchant
+	if err := row.Scan(&added.MerchantID, &added.MerchantName, &added.ImageURL, &added.CreateTime); err != nil {
+		return nil, err
+	}
+	return &added, nil
+}
+
+// addFavouriteMenuItem adds the menu item to the favourites of the customer.
+// Adding it again only refreshes the copied names and image.
+func (s *customerStorage) addFavouriteMenuItem(ctx context.Context, customerID string, fav *dbFavouriteMenuItem) (*dbFavouriteMenuItem, error) {
+
+	row := s.pool.QueryRow(ctx, `
+    INSERT INTO favourite_menu_items (
+        customer_id,
+        item_id,
+        merchant_id,
+        food_name,
+        merchant_name,
+        image_url
+    )
+    VALUES ($1, $2, $3, $4, $5, $6)
+    ON CONFLICT (customer_id, item_id) DO UPDATE
+    SET
+      food_name     = EXCLUDED.food_name,
+      merchant_name = EXCLUDED.merchant_name,
+      image_url     = EXCLUDED.image_url,
+      available     = TRUE
+    RETURNING merchant_id, item_id, food_name, merchant_name, image_url, available, create_time
+  `,
+		customerID,
+		fav.ItemID,
+		fav.MerchantID,
+		fav.FoodName,
+		fav.MerchantName,
+		fav.ImageURL,
+	)
+
+	var added dbFavouriteMenuItem
+	if err := row.Scan(
+		&added.MerchantID,
+		&added.ItemID,
+		&added.FoodName,
+		&added.MerchantName,
+		&added.ImageURL,
+		&added.Available,
+		&added.CreateTime,
+	); err != nil {
+		return nil, err
+	}
+	return &added, nil
+}
+
+func (s *customerStorage) removeFavouriteMerchant(ctx context.Context, customerID, merchantID string) error {
+	if _, err := s.pool.Exec(ctx, `
+    DELETE FROM favourite_merchants
+    WHERE customer_id = $1 AND merchant_id = $2
+  `,
+		customerID,
+		merchantID,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *customerStorage) removeFavouriteMenuItem(ctx context.Context, customerID, itemID string) error {
+	if _, err := s.pool.Exec(ctx, `
+    DELETE FROM favourite_menu_items
+    WHERE customer_id = $1 AND item_id = $2
+  `,
+		customerID,
+		itemID,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// refreshFavourites copies the merchant name, image and menu into the
+// favourites of every customer. Menu items no longer on the menu are marked
+// unavailable.
+func (s *customerStorage) refreshFavourites(ctx context.Context, merchant *dbMerchantCopy) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE favourite_merchants
+    SET
+      merchant_name = $2,
+      image_url     = $3
+    WHERE merchant_id = $1
+  `,
+		merchant.MerchantID,
+		merchant.MerchantName,
+		merchant.ImageURL,
+	); err != nil {
+		return err
+	}
+
+	var (
+		itemIDs   []string
+		foodNames []string
+		imageURLs []string
+	)
+	for _, item := range merchant.Menu {
+		itemIDs = append(itemIDs, item.ItemID)
+		foodNames = append(foodNames, item.FoodName)
+		imageURLs = append(imageURLs, item.ImageURL)
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE favourite_menu_items
+    SET
+      merchant_name = $2,
+      available     = FALSE
+    WHERE merchant_id = $1
+  `,
+		merchant.MerchantID,
+		merchant.MerchantName,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE favourite_menu_items AS f
+    SET
+      food_name = m.food_name,
+      image_url = m.image_url,
+      available = TRUE
+    FROM unnest($2::uuid[], $3::text[], $4::text[]) AS m(item_id, food_name, image_url)
+    WHERE f.merchant_id = $1 AND f.item_id = m.item_id
+  `,
+		merchant.MerchantID,
+		itemIDs,
+		foodNames,
+		imageURLs,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+const dataExportColumns = `
+	export_id,
+	customer_id,
+	state,
+	size_bytes,
+	create_time,
+	complete_time,
+	expire_time
+`
+
+// createDataExport queues an export of the customer. When an export is pending
+// or running already, it is returned instead.
+func (s *customerStorage) createDataExport(ctx context.Context, customerID string) (*dbDataExport, error) {
+
+	export, err := scanDataExport(s.pool.QueryRow(ctx, `
+    INSERT INTO data_exports (customer_id)
+    VALUES ($1)
+    ON CONFLICT (customer_id) WHERE state IN (1, 2) DO NOTHING
+    RETURNING `+dataExportColumns,
+		customerID,
+	))
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return export, err
+	}
+
+	// The active export can finish in between, the latest export is returned
+	// then.
+	return scanDataExport(s.pool.QueryRow(ctx, `
+    SELECT `+dataExportColumns+`
+    FROM data_exports
+    WHERE customer_id = $1
+    ORDER BY create_time DESC
+    LIMIT 1
+  `,
+		customerID,
+	))
+}
+
+func (s *customerStorage) getDataExport(ctx context.Context, customerID, exportID string) (*dbDataExport, error) {
+	return scanDataExport(s.pool.QueryRow(ctx, `
+    SELECT `+dataExportColumns+`
+    FROM data_exports
+    WHERE customer_id = $1 AND export_id = $2
+  `,
+		customerID,
+		exportID,
+	))
+}
+
+// getDataExportArchive returns the export with its archive. The archive is nil
+// unless the export succeeded and has not expired.
+func (s *customerStorage) getDataExportArchive(ctx context.Context, customerID, exportID string) (*dbDataExport, []byte, error) {
+
+	var (
+		export  dbDataExport
+		archive []byte
+	)
+	if err := s.pool.QueryRow(ctx, `
+    SELECT `+dataExportColumns+`, archive
+    FROM data_exports
+    WHERE customer_id = $1 AND export_id = $2
+  `,
+		customerID,
+		exportID,
+	).Scan(append(dataExportFields(&export), &archive)...); err != nil {
+		return nil, nil, err
+	}
+
+	return &export, archive, nil
+}
+
+// claimDataExport marks the oldest pending export as running and returns it.
+// Exports running for longer than staleAfter are claimed again, their worker
+// is assumed gone. It returns pgx.ErrNoRows when there is nothing to do.
+func (s *customerStorage) claimDataExport(ctx context.Context, staleAfter time.Duration) (*dbDataExport, error) {
+	return scanDataExport(s.pool.QueryRow(ctx, `
+    UPDATE data_exports
+    SET
+      state      = 2,
+      start_time = NOW()
+    WHERE export_id = (
+      SELECT export_id
+      FROM data_exports
+      WHERE state = 1 OR (state = 2 AND start_time < NOW() - $1::interval)
+      ORDER BY create_time
+      LIMIT 1
+      FOR UPDATE SKIP LOCKED
+    )
+    RETURNING `+dataExportColumns,
+		staleAfter,
+	))
+}
+
+// completeDataExport stores the archive of a running export.
+func (s *customerStorage) completeDataExport(ctx context.Context, exportID string, archive []byte, expireTime time.Time) error {
+	if _, err := s.pool.Exec(ctx, `
+    UPDATE data_exports
+    SET
+      state         = 3,
+      archive       = $2,
+      size_bytes    = $3,
+      complete_time = NOW(),
+      expire_time   = $4
+    WHERE export_id = $1 AND state = 2
+  `,
+		exportID,
+		archive,
+		len(archive),
+		expireTime,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *customerStorage) failDataExport(ctx context.Context, exportID, reason string) error {
+	if _, err := s.pool.Exec(ctx, `
+    UPDATE data_exports
+    SET
+      state         = 4,
+      error         = $2,
+      complete_time = NOW()
+    WHERE export_id = $1 AND state = 2
+  `,
+		exportID,
+		reason,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// expireDataExports deletes the archives past their expire time and returns
+// how many expired.
+func (s *customerStorage) expireDataExports(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `
+    UPDATE data_exports
+    SET
+      state   = 5,
+      archive = NULL
+    WHERE state = 3 AND expire_time <= NOW()
+  `)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func dataExportFields(export *dbDataExport) []any {
+	return []any{
+		&export.ExportID,
+		&export.CustomerID,
+		&export.State,
+		&export.SizeBytes,
+		&export.CreateTime,
+		&export.CompleteTime,
+		&export.ExpireTime,
+	}
+}
+
+func scanDataExport(row pgx.Row) (*dbDataExport, error) {
+	var export dbDataExport
+	if err := row.Scan(dataExportFields(&export)...); err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// loyaltyBalanceQuery sums the unexpired lots of the customer.
+const loyaltyBalanceQuery = `
+    WITH lots AS (
+      SELECT remaining, expire_time
+      FROM loyalty_transactions
+      WHERE customer_id = $1 AND remaining > 0 AND expire_time > NOW()
+    )
+    SELECT
+      COALESCE(SUM(remaining), 0)::int,
+      COALESCE(SUM(remaining) FILTER (WHERE expire_time = (SELECT MIN(expire_time) FROM lots)), 0)::int,
+      MIN(expire_time)
+    FROM lots
+`
+
+func (s *customerStorage) getLoyaltyBalance(ctx context.Context, customerID string) (*dbLoyaltyBalance, error) {
+	return scanLoyaltyBalance(s.pool.QueryRow(ctx, loyaltyBalanceQuery, customerID))
+}
+
+// listLoyaltyTransactions returns the ledger of the customer, newest first.
+func (s *customerStorage) listLoyaltyTransactions(ctx context.Context, filter *dbLoyaltyFilter) ([]*dbLoyaltyTransaction, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			transaction_id,
+			type,
+			points,
+			order_id,
+			expire_time,
+			create_time
+		FROM loyalty_transactions
+		WHERE
+			customer_id = $1 AND
+			($2::timestamp IS NULL OR (create_time, transaction_id) < ($2, NULLIF($3, '')::uuid))
+		ORDER BY create_time DESC, transaction_id DESC
+		LIMIT $4
+	`,
+		filter.CustomerID,
+		filter.BeforeTime,
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbLoyaltyTransaction, error) {
+		var t dbLoyaltyTransaction
+		err := row.Scan(&t.TransactionID, &t.Type, &t.Points, &t.OrderID, &t.ExpireTime, &t.CreateTime)
+		return &t, err
+	})
+}
+
+// earnLoyaltyPoints credits the points of a delivered order. It returns false
+// when the order has earned already.
+func (s *customerStorage) earnLoyaltyPoints(ctx context.Context, customerID, orderID string, points int32, expireTime time.Time) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+    INSERT INTO loyalty_transactions (customer_id, type, points, order_id, remaining, expire_time)
+    VALUES ($1, $2, $3, $4, $3, $5)
+    ON CONFLICT (customer_id, type, order_id) WHERE order_id <> '' DO NOTHING
+  `,
+		customerID,
+		loyaltyTransactionEarn,
+		points,
+		orderID,
+		expireTime,
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+var errInsufficientPoints = errors.New("insufficient loyalty points")
+
+// redeemLoyaltyPoints spends points of the customer for the order, from the
+// lots expiring first. Redeeming again for the order returns the points of
+// the first redemption. It returns errInsufficientPoints when the balance is
+// too low and pgx.ErrNoRows when the customer does not exist.
+func (s *customerStorage) redeemLoyaltyPoints(ctx context.Context, customerID, orderID string, points int32) (int32, *dbLoyaltyBalance, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the customer serialises its redemptions.
+	var active bool
+	if err := tx.QueryRow(ctx, `
+    SELECT delete_time IS NULL FROM customers WHERE customer_id = $1 FOR UPDATE
+  `,
+		customerID,
+	).Scan(&active); err != nil {
+		return 0, nil, err
+	}
+	if !active {
+		return 0, nil, pgx.ErrNoRows
+	}
+
+	var redeemed int32
+	err = tx.QueryRow(ctx, `
+    SELECT -points
+    FROM loyalty_transactions
+    WHERE customer_id = $1 AND type = $2 AND order_id = $3
+  `,
+		customerID,
+		loyaltyTransactionRedeem,
+		orderID,
+	).Scan(&redeemed)
+	switch {
+	case err == nil:
+		balance, err := scanLoyaltyBalance(tx.QueryRow(ctx, loyaltyBalanceQuery, customerID))
+		return redeemed, balance, err
+	case !errors.Is(err, pgx.ErrNoRows):
+		return 0, nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+    SELECT transaction_id, remaining
+    FROM loyalty_transactions
+    WHERE customer_id = $1 AND remaining > 0 AND expire_time > NOW()
+    ORDER BY expire_time, create_time
+  `,
+		customerID,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		lotIDs     []string
+		remainings []int32
+		left       = points
+	)
+	for rows.Next() && left > 0 {
+		var (
+			lotID     string
+			remaining int32
+		)
+		if err := rows.Scan(&lotID, &remaining); err != nil {
+			rows.Close()
+			return 0, nil, err
+		}
+		spent := min(remaining, left)
+		left -= spent
+		lotIDs = append(lotIDs, lotID)
+		remainings = append(remainings, remaining-spent)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, err
+	}
+	if left > 0 {
+		return 0, nil, errInsufficientPoints
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE loyalty_transactions AS t
+    SET remaining = l.remaining
+    FROM unnest($1::uuid[], $2::int[]) AS l(transaction_id, remaining)
+    WHERE t.transaction_id = l.transaction_id
+  `,
+		lotIDs,
+		remainings,
+	); err != nil {
+		return 0, nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO loyalty_transactions (customer_id, type, points, order_id)
+    VALUES ($1, $2, $3, $4)
+  `,
+		customerID,
+		loyaltyTransactionRedeem,
+		-points,
+		orderID,
+	); err != nil {
+		return 0, nil, err
+	}
+
+	balance, err := scanLoyaltyBalance(tx.QueryRow(ctx, loyaltyBalanceQuery, customerID))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return points, balance, tx.Commit(ctx)
+}
+
+// reverseLoyaltyRedemption gives back the points redeemed for the order as a
+// new lot expiring at expireTime. Orders without a redemption, or reversed
+// already, leave the ledger as is.
+func (s *customerStorage) reverseLoyaltyRedemption(ctx context.Context, customerID, orderID string, expireTime time.Time) (*dbLoyaltyBalance, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM customers WHERE customer_id = $1 FOR UPDATE`, customerID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO loyalty_transactions (customer_id, type, points, order_id, remaining, expire_time)
+    SELECT customer_id, $3, -points, order_id, -points, $4
+    FROM loyalty_transactions
+    WHERE customer_id = $1 AND type = $2 AND order_id = $5
+    ON CONFLICT (customer_id, type, order_id) WHERE order_id <> '' DO NOTHING
+  `,
+		customerID,
+		loyaltyTransactionRedeem,
+		loyaltyTransactionReverse,
+		expireTime,
+		orderID,
+	); err != nil {
+		return nil, err
+	}
+
+	balance, err := scanLoyaltyBalance(tx.QueryRow(ctx, loyaltyBalanceQuery, customerID))
+	if err != nil {
+		return nil, err
+	}
+
+	return balance, tx.Commit(ctx)
+}
+
+// expireLoyaltyPoints expires what is left of the lots past their expire
+// time, with one expire transaction per customer. It returns the number of
+// customers whose points expired.
+func (s *customerStorage) expireLoyaltyPoints(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `
+    WITH lots AS (
+      SELECT transaction_id, customer_id, remaining
+      FROM loyalty_transactions
+      WHERE remaining > 0 AND expire_time <= NOW()
+      FOR UPDATE SKIP LOCKED
+    ), cleared AS (
+      UPDATE loyalty_transactions AS t
+      SET remaining = 0
+      FROM lots
+      WHERE t.transaction_id = lots.transaction_id
+    )
+    INSERT INTO loyalty_transactions (customer_id, type, points)
+    SELECT customer_id, $1, -SUM(remaining)
+    FROM lots
+    GROUP BY customer_id
+  `,
+		loyaltyTransactionExpire,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanLoyaltyBalance(row pgx.Row) (*dbLoyaltyBalance, error) {
+	var balance dbLoyaltyBalance
+	if err := row.Scan(&balance.Points, &balance.NextExpiringPoints, &balance.NextExpireTime); err != nil {
+		return nil, err
+	}
+	return &balance, nil
+}
+
+// addressFields are the scan targets of the address columns, in the order
+// the queries select them.
+var (
+	errInsufficientBalance  = errors.New("insufficient wallet balance")
+	errIdempotencyKeyReused = errors.New("idempotency key was used for another request")
+	errGiftCardNotFound     = errors.New("gift card not found")
+	errGiftCardRedeemed     = errors.New("gift card has been redeemed")
+	errGiftCardExpired      = errors.New("gift card has expired")
+)
+
+// walletBalanceQuery sums the entries of the wallet of customer $1.
+const walletBalanceQuery = `
+    SELECT COALESCE(SUM(e.amount), 0)
+    FROM wallet_entries AS e
+    JOIN wallet_accounts AS a ON a.account_id = e.account_id
+    WHERE a.customer_id = $1
+  `
+
+func (s *customerStorage) getWalletBalance(ctx context.Context, customerID string) (int64, error) {
+	var balance int64
+	err := s.pool.QueryRow(ctx, walletBalanceQuery, customerID).Scan(&balance)
+	return balance, err
+}
+
+func (s *customerStorage) listWalletTransactions(ctx context.Context, filter *dbWalletFilter) ([]*dbWalletTransaction, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			t.transaction_id,
+			t.type,
+			e.amount,
+			t.reference,
+			e.create_time
+		FROM wallet_entries AS e
+		JOIN wallet_accounts AS a ON a.account_id = e.account_id
+		JOIN wallet_transactions AS t ON t.transaction_id = e.transaction_id
+		WHERE
+			a.customer_id = $1 AND
+			($2::timestamp IS NULL OR (e.create_time, e.transaction_id) < ($2, NULLIF($3, '')::uuid))
+		ORDER BY e.create_time DESC, e.transaction_id DESC
+		LIMIT $4
+	`,
+		filter.CustomerID,
+		filter.BeforeTime,
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbWalletTransaction, error) {
+		var t dbWalletTransaction
+		err := row.Scan(&t.TransactionID, &t.Type, &t.Amount, &t.Reference, &t.CreateTime)
+		return &t, err
+	})
+}
+
+// topUpWallet credits the wallet with money paid to the top_ups account.
+func (s *customerStorage) topUpWallet(ctx context.Context, customerID, idempotencyKey, paymentReference string, amount int64) (*dbWalletTransaction, error) {
+	return s.customerWalletTransfer(ctx, customerID, walletAccountTopUps, false, &dbWalletTransfer{
+		IdempotencyKey: "top-up:" + idempotencyKey,
+		Type:           walletTransactionTopUp,
+		Reference:      paymentReference,
+		Amount:         amount,
+	})
+}
+
+// payWithWallet debits the wallet for the order. It returns
+// errInsufficientBalance when the balance does not cover the amount.
+func (s *customerStorage) payWithWallet(ctx context.Context, customerID, orderID string, amount int64) (*dbWalletTransaction, error) {
+	return s.customerWalletTransfer(ctx, customerID, walletAccountOrderPayments, true, &dbWalletTransfer{
+		IdempotencyKey: "order:" + orderID + ":payment",
+		Type:           walletTransactionPayment,
+		Reference:      orderID,
+		Amount:         amount,
+	})
+}
+
+// refundToWallet credits the wallet with the refund of the order.
+func (s *customerStorage) refundToWallet(ctx context.Context, customerID, orderID string, amount int64) (*dbWalletTransaction, error) {
+	return s.customerWalletTransfer(ctx, customerID, walletAccountOrderPayments, false, &dbWalletTransfer{
+		IdempotencyKey: "order:" + orderID + ":refund",
+		Type:           walletTransactionRefund,
+		Reference:      orderID,
+		Amount:         amount,
+	})
+}
+
+// customerWalletTransfer moves money between the wallet of the customer and
+// a system account, out of the wallet when debit is set. A transfer whose
+// idempotency key exists already returns the first transaction.
+func (s *customerStorage) customerWalletTransfer(ctx context.Context, customerID, systemAccount string, debit bool, t *dbWalletTransfer) (*dbWalletTransaction, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	accountID, err := activeWalletAccountTx(ctx, tx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	systemID, err := systemWalletAccountTx(ctx, tx, systemAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	if debit {
+		t.FromAccountID, t.ToAccountID = accountID, systemID
+	} else {
+		t.FromAccountID, t.ToAccountID = systemID, accountID
+	}
+
+	existing, err := findWalletTransactionTx(ctx, tx, t, accountID)
+	switch {
+	case err == nil:
+		return existing, nil
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, err
+	}
+
+	if debit {
+		var balance int64
+		if err := tx.QueryRow(ctx, walletBalanceQuery, customerID).Scan(&balance); err != nil {
+			return nil, err
+		}
+		if balance < t.Amount {
+			return nil, errInsufficientBalance
+		}
+	}
+
+	transaction, err := insertWalletTransactionTx(ctx, tx, t, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, tx.Commit(ctx)
+}
+
+// redeemGiftCard credits the wallet with the amount of the gift card whose
+// code hashes to codeHash. Redeeming a gift card again returns the first
+// transaction.
+func (s *customerStorage) redeemGiftCard(ctx context.Context, customerID string, codeHash []byte) (*dbWalletTransaction, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	accountID, err := activeWalletAccountTx(ctx, tx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		card    dbGiftCard
+		expired bool
+	)
+	err = tx.QueryRow(ctx, `
+    SELECT gift_card_id, amount, redeemed_by, expire_time IS NOT NULL AND expire_time <= NOW()
+    FROM gift_cards
+    WHERE code_hash = $1
+    FOR UPDATE
+  `,
+		codeHash,
+	).Scan(&card.GiftCardID, &card.Amount, &card.RedeemedBy, &expired)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errGiftCardNotFound
+		}
+		return nil, err
+	}
+
+	giftCardsID, err := systemWalletAccountTx(ctx, tx, walletAccountGiftCards)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &dbWalletTransfer{
+		IdempotencyKey: "gift-card:" + card.GiftCardID + ":redeem",
+		Type:           walletTransactionGiftCard,
+		Reference:      card.GiftCardID,
+		FromAccountID:  giftCardsID,
+		ToAccountID:    accountID,
+		Amount:         card.Amount,
+	}
+
+	switch {
+	case card.RedeemedBy != nil && *card.RedeemedBy == customerID:
+		return findWalletTransactionTx(ctx, tx, t, accountID)
+	case card.RedeemedBy != nil:
+		return nil, errGiftCardRedeemed
+	case expired:
+		return nil, errGiftCardExpired
+	}
+
+	transaction, err := insertWalletTransactionTx(ctx, tx, t, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    UPDATE gift_cards
+    SET redeemed_by = $2, redeem_time = NOW()
+    WHERE gift_card_id = $1
+  `,
+		card.GiftCardID,
+		customerID,
+	); err != nil {
+		return nil, err
+	}
+
+	return transaction, tx.Commit(ctx)
+}
+
+// issueGiftCard creates the gift card and credits its amount to the
+// gift_cards account until it is redeemed. Issuing with an idempotency key
+// used before returns the first gift card.
+func (s *customerStorage) issueGiftCard(ctx context.Context, idempotencyKey string, card *dbGiftCard) (*dbGiftCard, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the gift_cards account serialises issues, so that a retried
+	// issue finds the gift card of the first.
+	var giftCardsID string
+	if err := tx.QueryRow(ctx, `
+    SELECT account_id FROM wallet_accounts WHERE name = $1 FOR NO KEY UPDATE
+  `,
+		walletAccountGiftCards,
+	).Scan(&giftCardsID); err != nil {
+		return nil, err
+	}
+	salesID, err := systemWalletAccountTx(ctx, tx, walletAccountGiftCardSales)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &dbWalletTransfer{
+		IdempotencyKey: "gift-card-issue:" + idempotencyKey,
+		Type:           walletTransactionGiftCardIssue,
+		FromAccountID:  salesID,
+		ToAccountID:    giftCardsID,
+		Amount:         card.Amount,
+	}
+
+	existing, err := findWalletTransactionTx(ctx, tx, t, giftCardsID)
+	switch {
+	case err == nil:
+		return scanGiftCard(tx.QueryRow(ctx, `SELECT `+giftCardColumns+` FROM gift_cards WHERE gift_card_id = $1`,
+			existing.Reference))
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, err
+	}
+
+	created, err := scanGiftCard(tx.QueryRow(ctx, `
+    INSERT INTO gift_cards (code_hash, amount, expire_time)
+    VALUES ($1, $2, $3)
+    RETURNING `+giftCardColumns,
+		card.CodeHash,
+		card.Amount,
+		card.ExpireTime,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	t.Reference = created.GiftCardID
+	if _, err := insertWalletTransactionTx(ctx, tx, t, giftCardsID); err != nil {
+		return nil, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// hashGiftCardCodes replaces the plain codes of the gift cards issued before
+// codes were hashed by their hash. It returns the number of gift cards
+// changed.
+func (s *customerStorage) hashGiftCardCodes(ctx context.Context, hash func(code string) []byte) (int64, error) {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT gift_card_id, code FROM gift_cards WHERE code IS NOT NULL FOR UPDATE`)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		ids    []string
+		hashes [][]byte
+	)
+	for rows.Next() {
+		var id, code string
+		if err := rows.Scan(&id, &code); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+		hashes = append(hashes, hash(code))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+    UPDATE gift_cards AS g
+    SET code = NULL, code_hash = c.code_hash
+    FROM unnest($1::uuid[], $2::bytea[]) AS c(gift_card_id, code_hash)
+    WHERE g.gift_card_id = c.gift_card_id
+  `,
+		ids,
+		hashes,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), tx.Commit(ctx)
+}
+
+// activeWalletAccountTx returns the wallet account of the customer like
+// walletAccountTx. It returns pgx.ErrNoRows for missing or deleted
+// customers.
+func activeWalletAccountTx(ctx context.Context, tx pgx.Tx, customerID string) (string, error) {
+
+	var active bool
+	if err := tx.QueryRow(ctx, `
+    SELECT delete_time IS NULL FROM customers WHERE customer_id = $1
+  `,
+		customerID,
+	).Scan(&active); err != nil {
+		return "", err
+	}
+	if !active {
+		return "", pgx.ErrNoRows
+	}
+
+	return walletAccountTx(ctx, tx, customerID)
+}
+
+// walletAccountTx returns the wallet account of the customer, created on
+// first use. The account stays locked until tx ends, which serialises the
+// transactions of a wallet.
+func walletAccountTx(ctx context.Context, tx pgx.Tx, customerID string) (string, error) {
+	var accountID string
+	err := tx.QueryRow(ctx, `
+    INSERT INTO wallet_accounts (customer_id)
+    VALUES ($1)
+    ON CONFLICT (customer_id) DO UPDATE SET customer_id = EXCLUDED.customer_id
+    RETURNING account_id
+  `,
+		customerID,
+	).Scan(&accountID)
+	return accountID, err
+}
+
+func systemWalletAccountTx(ctx context.Context, tx pgx.Tx, name string) (string, error) {
+	var accountID string
+	err := tx.QueryRow(ctx, `SELECT account_id FROM wallet_accounts WHERE name = $1`, name).Scan(&accountID)
+	return accountID, err
+}
+
+// findWalletTransactionTx returns the transaction with the idempotency key
+// of t as seen by the account. It returns errIdempotencyKeyReused when that
+// transaction differs from t in type or amount, and pgx.ErrNoRows when there
+// is none.
+func findWalletTransactionTx(ctx context.Context, tx pgx.Tx, t *dbWalletTransfer, accountID string) (*dbWalletTransaction, error) {
+
+	var existing dbWalletTransaction
+	if err := tx.QueryRow(ctx, `
+    SELECT t.transaction_id, t.type, COALESCE(e.amount, 0), t.reference, t.create_time
+    FROM wallet_transactions AS t
+    LEFT JOIN wallet_entries AS e ON e.transaction_id = t.transaction_id AND e.account_id = $2
+    WHERE t.idempotency_key = $1
+  `,
+		t.IdempotencyKey,
+		accountID,
+	).Scan(
+		&existing.TransactionID,
+		&existing.Type,
+		&existing.Amount,
+		&existing.Reference,
+		&existing.CreateTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if existing.Type != t.Type || existing.Amount != walletEntryAmount(t, accountID) {
+		return nil, errIdempotencyKeyReused
+	}
+	return &existing, nil
+}
+
+// insertWalletTransactionTx records t as a transaction of two entries and
+// returns it as seen by the account. When a concurrent request committed the
+// idempotency key first, that transaction is returned instead.
+func insertWalletTransactionTx(ctx context.Context, tx pgx.Tx, t *dbWalletTransfer, accountID string) (*dbWalletTransaction, error) {
+
+	created := dbWalletTransaction{
+		Type:      t.Type,
+		Amount:    walletEntryAmount(t, accountID),
+		Reference: t.Reference,
+	}
+	err := tx.QueryRow(ctx, `
+    INSERT INTO wallet_transactions (idempotency_key, type, reference)
+    VALUES ($1, $2, $3)
+    ON CONFLICT (idempotency_key) DO NOTHING
+    RETURNING transaction_id, create_time
+  `,
+		t.IdempotencyKey,
+		t.Type,
+		t.Reference,
+	).Scan(&created.TransactionID, &created.CreateTime)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return findWalletTransactionTx(ctx, tx, t, accountID)
+		}
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+    INSERT INTO wallet_entries (transaction_id, account_id, amount)
+    VALUES ($1, $2, $3), ($1, $4, $5)
+  `,
+		created.TransactionID,
+		t.FromAccountID,
+		-t.Amount,
+		t.ToAccountID,
+		t.Amount,
+	); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// walletEntryAmount returns the entry of the account in t.
+func walletEntryAmount(t *dbWalletTransfer, accountID string) int64 {
+	switch accountID {
+	case t.ToAccountID:
+		return t.Amount
+	case t.FromAccountID:
+		return -t.Amount
+	}
+	return 0
+}
+
+// mergeWalletTx moves the wallet balance of the source customer to the
+// target.
+func mergeWalletTx(ctx context.Context, tx pgx.Tx, sourceID, targetID string) error {
+
+	var sourceAccountID string
+	err := tx.QueryRow(ctx, `
+    SELECT account_id FROM wallet_accounts WHERE customer_id = $1 FOR NO KEY UPDATE
+  `,
+		sourceID,
+	).Scan(&sourceAccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	var balance int64
+	if err := tx.QueryRow(ctx, walletBalanceQuery, sourceID).Scan(&balance); err != nil {
+		return err
+	}
+	if balance == 0 {
+		return nil
+	}
+
+	targetAccountID, err := walletAccountTx(ctx, tx, targetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = insertWalletTransactionTx(ctx, tx, &dbWalletTransfer{
+		IdempotencyKey: "merge:" + sourceID,
+		Type:           walletTransactionTransfer,
+		Reference:      sourceID,
+		FromAccountID:  sourceAccountID,
+		ToAccountID:    targetAccountID,
+		Amount:         balance,
+	}, targetAccountID)
+	return err
+}
+
+const giftCardColumns = `
+	gift_card_id,
+	code_hash,
+	amount,
+	expire_time,
+	redeemed_by,
+	redeem_time,
+	create_time
+`
+
+func scanGiftCard(row pgx.Row) (*dbGiftCard, error) {
+	var card dbGiftCard
+	if err := row.Scan(
+		&card.GiftCardID,
+		&card.CodeHash,
+		&card.Amount,
+		&card.ExpireTime,
+		&card.RedeemedBy,
+		&card.RedeemTime,
+		&card.CreateTime,
+	); err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// listNotificationPreferences returns the preferences the customer has set,
+// pairs without one use their default.
+func (s *customerStorage) listNotificationPreferences(ctx context.Context, customerID string) ([]*dbNotificationPreference, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT category, channel, enabled
+		FROM notification_preferences
+		WHERE customer_id = $1
+	`,
+		customerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbNotificationPreference, error) {
+		var p dbNotificationPreference
+		err := row.Scan(&p.Category, &p.Channel, &p.Enabled)
+		return &p, err
+	})
+}
+
+// updateNotificationPreferences sets the preferences of the customer. It
+// returns pgx.ErrNoRows for missing or deleted customers.
+func (s *customerStorage) updateNotificationPreferences(ctx context.Context, customerID string, prefs []*dbNotificationPreference) error {
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var active bool
+	if err := tx.QueryRow(ctx, `
+    SELECT delete_time IS NULL FROM customers WHERE customer_id = $1
+  `,
+		customerID,
+	).Scan(&active); err != nil {
+		return err
+	}
+	if !active {
+		return pgx.ErrNoRows
+	}
+
+	for _, p := range prefs {
+		if _, err := tx.Exec(ctx, `
+      INSERT INTO notification_preferences (customer_id, category, channel, enabled)
+      VALUES ($1, $2, $3, $4)
+      ON CONFLICT (customer_id, category, channel) DO UPDATE
+      SET enabled = EXCLUDED.enabled, update_time = NOW()
+    `,
+			customerID,
+			p.Category,
+			p.Channel,
+			p.Enabled,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// createNotification adds a notification to the inbox of the customer when
+// the customer has in-app notifications of its category enabled,
+// enabledByDefault when not set. It returns false when the notification was
+// not added, because of the preference or because its dedup key was added
+// already.
+func (s *customerStorage) createNotification(ctx context.Context, customerID string, n *dbNotification, enabledByDefault bool) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+    INSERT INTO notifications (customer_id, category, title, body, reference, dedup_key)
+    SELECT c.customer_id, $2, $3, $4, $5, $6
+    FROM customers AS c
+    LEFT JOIN notification_preferences AS p ON
+      p.customer_id = c.customer_id AND
+      p.category = $2 AND
+      p.channel = $7
+    WHERE
+      c.customer_id = $1 AND
+      c.delete_time IS NULL AND
+      COALESCE(p.enabled, $8)
+    ON CONFLICT (customer_id, dedup_key) DO NOTHING
+  `,
+		customerID,
+		n.Category,
+		n.Title,
+		n.Body,
+		n.Reference,
+		n.DedupKey,
+		notificationChannelInApp,
+		enabledByDefault,
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// broadcastNotification is createNotification for every customer, it
+// returns the number of notifications added.
+func (s *customerStorage) broadcastNotification(ctx context.Context, n *dbNotification, enabledByDefault bool) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `
+    INSERT INTO notifications (customer_id, category, title, body, reference, dedup_key)
+    SELECT c.customer_id, $1, $2, $3, $4, $5
+    FROM customers AS c
+    LEFT JOIN notification_preferences AS p ON
+      p.customer_id = c.customer_id AND
+      p.category = $1 AND
+      p.channel = $6
+    WHERE
+      c.delete_time IS NULL AND
+      COALESCE(p.enabled, $7)
+    ON CONFLICT (customer_id, dedup_key) DO NOTHING
+  `,
+		n.Category,
+		n.Title,
+		n.Body,
+		n.Reference,
+		n.DedupKey,
+		notificationChannelInApp,
+		enabledByDefault,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// listNotifications returns the inbox of the customer, newest first.
+func (s *customerStorage) listNotifications(ctx context.Context, filter *dbNotificationFilter) ([]*dbNotification, error) {
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT
+			notification_id,
+			category,
+			title,
+			body,
+			reference,
+			read_time,
+			create_time
+		FROM notifications
+		WHERE
+			customer_id = $1 AND
+			(NOT $2 OR read_time IS NULL) AND
+			($3::timestamp IS NULL OR (create_time, notification_id) < ($3, NULLIF($4, '')::uuid))
+		ORDER BY create_time DESC, notification_id DESC
+		LIMIT $5
+	`,
+		filter.CustomerID,
+		filter.UnreadOnly,
+		filter.BeforeTime,
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*dbNotification, error) {
+		var n dbNotification
+		err := row.Scan(&n.NotificationID, &n.Category, &n.Title, &n.Body, &n.Reference, &n.ReadTime, &n.CreateTime)
+		return &n, err
+	})
+}
+
+func (s *customerStorage) countUnreadNotifications(ctx context.Context, customerID string) (int32, error) {
+	var count int32
+	err := s.pool.QueryRow(ctx, `
+    SELECT COUNT(*) FROM notifications WHERE customer_id = $1 AND read_time IS NULL
+  `,
+		customerID,
+	).Scan(&count)
+	return count, err
+}
+
+// markNotificationsRead marks the unread notifications of the customer as
+// read, all of them when notificationIDs is nil.
+func (s *customerStorage) markNotificationsRead(ctx context.Context, customerID string, notificationIDs []string) error {
+	_, err := s.pool.Exec(ctx, `
+    UPDATE notifications
+    SET read_time = NOW()
+    WHERE
+      customer_id = $1 AND
+      read_time IS NULL AND
+      ($2::uuid[] IS NULL OR notification_id = ANY($2))
+  `,
+		customerID,
+		notificationIDs,
+	)
+	return err
+}
+
+func addressFields(addr *dbAddress) []any {
+	return []any{
+		&addr.AddressID,
+		&addr.AddressName,
+		&addr.SubDistrict,
+		&addr.District,
+		&addr.Province,
+		&addr.PostalCode,
+		&addr.Latitude,
+		&addr.Longitude,
+		&addr.Detail,
+		&addr.Landmark,
+		&addr.DeliveryInstructions,
+		&addr.IsDefault,
+		&addr.LocationApproximate,
+	}
+}
+
+// escapeLike escapes the LIKE wildcards of a user supplied prefix.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
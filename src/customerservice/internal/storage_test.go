@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pongsathonn/ihavefood/pkg/migrate"
+	"github.com/pongsathonn/ihavefood/src/customerservice/supabase/migrations"
+)
+
+// testStorage returns the storage of the database at CUSTOMER_TEST_DB_URL,
+// migrated to the latest version. The tests using it are skipped when the
+// variable is not set.
+func testStorage(t *testing.T) *customerStorage {
+	t.Helper()
+
+	url := os.Getenv("CUSTOMER_TEST_DB_URL")
+	if url == "" {
+		t.Skip("CUSTOMER_TEST_DB_URL is not set")
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	m, err := migrate.New(pool, migrations.FS, "customerservice.schema_versions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewCustomerStorage(pool)
+}
+
+// testCustomer creates a customer with a new id.
+func testCustomer(t *testing.T, s *customerStorage) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	if _, err := s.create(context.Background(), &dbNewCustomer{
+		CustomerID: id,
+		Username:   "test-" + id,
+		Email:      id + "@example.com",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestRedeemLoyaltyPoints(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	customerID := testCustomer(t, s)
+
+	// The lot expiring first is spent first.
+	if _, err := s.earnLoyaltyPoints(ctx, customerID, "order-1", 30, time.Now().Add(48*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.earnLoyaltyPoints(ctx, customerID, "order-2", 50, time.Now().Add(24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := s.redeemLoyaltyPoints(ctx, customerID, "order-3", 81); !errors.Is(err, errInsufficientPoints) {
+		t.Fatalf("redeem over the balance: got %v, want errInsufficientPoints", err)
+	}
+
+	redeemed, balance, err := s.redeemLoyaltyPoints(ctx, customerID, "order-3", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redeemed != 60 || balance.Points != 20 || balance.NextExpiringPoints != 20 {
+		t.Errorf("redeem: got %d points, balance %+v, want 60 points and 20 left", redeemed, balance)
+	}
+
+	// Redeeming again for the order returns the first redemption, whatever
+	// the points asked for.
+	redeemed, balance, err = s.redeemLoyaltyPoints(ctx, customerID, "order-3", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redeemed != 60 || balance.Points != 20 {
+		t.Errorf("redeem again: got %d points, balance %d, want 60 and 20", redeemed, balance.Points)
+	}
+
+	if _, _, err := s.redeemLoyaltyPoints(ctx, uuid.NewString(), "order-3", 10); err == nil {
+		t.Error("redeem for an unknown customer: expected an error")
+	}
+}
+
+func TestReverseLoyaltyRedemption(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	customerID := testCustomer(t, s)
+
+	if _, err := s.earnLoyaltyPoints(ctx, customerID, "order-1", 50, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.redeemLoyaltyPoints(ctx, customerID, "order-2", 40); err != nil {
+		t.Fatal(err)
+	}
+
+	expireTime := time.Now().Add(24 * time.Hour)
+	for i := range 2 {
+		balance, err := s.reverseLoyaltyRedemption(ctx, customerID, "order-2", expireTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.Points != 50 {
+			t.Errorf("reverse #%d: balance %d, want 50", i+1, balance.Points)
+		}
+	}
+
+	transactions, err := s.listLoyaltyTransactions(ctx, &dbLoyaltyFilter{CustomerID: customerID, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reversed int
+	for _, tr := range transactions {
+		if tr.Type == loyaltyTransactionReverse {
+			reversed++
+			if tr.Points != 40 {
+				t.Errorf("reverse transaction of %d points, want 40", tr.Points)
+			}
+		}
+	}
+	if reversed != 1 {
+		t.Errorf("got %d reverse transactions, want 1", reversed)
+	}
+
+	// An order without a redemption leaves the ledger as is.
+	balance, err := s.reverseLoyaltyRedemption(ctx, customerID, "order-3", expireTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Points != 50 {
+		t.Errorf("reverse without redemption: balance %d, want 50", balance.Points)
+	}
+}
+
+func TestExpireLoyaltyPoints(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	customerID := testCustomer(t, s)
+
+	if _, err := s.earnLoyaltyPoints(ctx, customerID, "order-1", 30, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.earnLoyaltyPoints(ctx, customerID, "order-2", 20, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired lots are not spent, even before the expirer ran.
+	if _, _, err := s.redeemLoyaltyPoints(ctx, customerID, "order-3", 21); !errors.Is(err, errInsufficientPoints) {
+		t.Fatalf("redeem expired points: got %v, want errInsufficientPoints", err)
+	}
+
+	n, err := s.expireLoyaltyPoints(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n < 1 {
+		t.Errorf("expired the points of %d customers, want at least 1", n)
+	}
+
+	balance, err := s.getLoyaltyBalance(ctx, customerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Points != 20 {
+		t.Errorf("balance %d after expiry, want 20", balance.Points)
+	}
+
+	// Running again finds nothing left to expire for the customer.
+	if _, err := s.expireLoyaltyPoints(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	transactions, err := s.listLoyaltyTransactions(ctx, &dbLoyaltyFilter{CustomerID: customerID, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expired []int32
+	for _, tr := range transactions {
+		if tr.Type == loyaltyTransactionExpire {
+			expired = append(expired, tr.Points)
+		}
+	}
+	if len(expired) != 1 || expired[0] != -30 {
+		t.Errorf("expire transactions %v, want [-30]", expired)
+	}
+}
+
+func TestCustomerWalletTransfer(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	customerID := testCustomer(t, s)
+	key := uuid.NewString()
+
+	if _, err := s.topUpWallet(ctx, customerID, key, "payment-1", 500); err != nil {
+		t.Fatal(err)
+	}
+
+	// A replayed key returns the first top-up, with another amount it fails.
+	if _, err := s.topUpWallet(ctx, customerID, key, "payment-1", 500); err != nil {
+		t.Fatalf("replay top-up: %v", err)
+	}
+	if _, err := s.topUpWallet(ctx, customerID, key, "payment-1", 900); !errors.Is(err, errIdempotencyKeyReused) {
+		t.Fatalf("replay top-up with another amount: got %v, want errIdempotencyKeyReused", err)
+	}
+
+	orderID := uuid.NewString()
+	if _, err := s.payWithWallet(ctx, customerID, orderID, 501); !errors.Is(err, errInsufficientBalance) {
+		t.Fatalf("pay over the balance: got %v, want errInsufficientBalance", err)
+	}
+
+	payment, err := s.payWithWallet(ctx, customerID, orderID, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Amount != -300 {
+		t.Errorf("payment of %d, want -300", payment.Amount)
+	}
+	if balance, err := s.getWalletBalance(ctx, customerID); err != nil || balance != 200 {
+		t.Fatalf("balance after payment: %d, %v, want 200", balance, err)
+	}
+
+	// The cancelled order is refunded once.
+	for i := range 2 {
+		refund, err := s.refundToWallet(ctx, customerID, orderID, 300)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if refund.Amount != 300 {
+			t.Errorf("refund #%d of %d, want 300", i+1, refund.Amount)
+		}
+	}
+	if balance, err := s.getWalletBalance(ctx, customerID); err != nil || balance != 500 {
+		t.Fatalf("balance after refund: %d, %v, want 500", balance, err)
+	}
+}
+
+func TestRedeemGiftCard(t *testing.T) {
+
+	s := testStorage(t)
+	ctx := context.Background()
+	customerID := testCustomer(t, s)
+	otherID := testCustomer(t, s)
+
+	key := bytes.Repeat([]byte("k"), minGiftCardKeyLen)
+	code, err := newGiftCardCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := s.issueGiftCard(ctx, uuid.NewString(), &dbGiftCard{
+		CodeHash: hashGiftCardCode(key, code),
+		Amount:   250,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.redeemGiftCard(ctx, customerID, hashGiftCardCode(key, code+"A")); !errors.Is(err, errGiftCardNotFound) {
+		t.Fatalf("redeem an unknown code: got %v, want errGiftCardNotFound", err)
+	}
+
+	first, err := s.redeemGiftCard(ctx, customerID, hashGiftCardCode(key, code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Amount != 250 || first.Reference != card.GiftCardID {
+		t.Errorf("redeem: got %+v, want 250 of gift card %s", first, card.GiftCardID)
+	}
+
+	// Redeeming again returns the first transaction, another customer cannot.
+	again, err := s.redeemGiftCard(ctx, customerID, hashGiftCardCode(key, code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.TransactionID != first.TransactionID {
+		t.Errorf("redeem again: got transaction %s, want %s", again.TransactionID, first.TransactionID)
+	}
+	if _, err := s.redeemGiftCard(ctx, otherID, hashGiftCardCode(key, code)); !errors.Is(err, errGiftCardRedeemed) {
+		t.Fatalf("redeem by another customer: got %v, want errGiftCardRedeemed", err)
+	}
+
+	if balance, err := s.getWalletBalance(ctx, customerID); err != nil || balance != 250 {
+		t.Fatalf("balance after redemption: %d, %v, want 250", balance, err)
+	}
+}
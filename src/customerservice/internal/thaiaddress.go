@@ -0,0 +1,256 @@
+package internal
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Addresses are checked against a dataset of Thai sub-districts embedded in
+// the binary, and written with its English names. data/thai_addresses.csv is
+// generated by "go generate" from a published copy of the national province,
+// district and sub-district list, see scripts/thaiaddresses. latitude and
+// longitude are the centroid of the sub-district, used by centroidGeocoder.
+// The list has none, so the generator keeps those already in the file and
+// leaves the others empty.
+
+//go:generate go run ../scripts/thaiaddresses -o data/thai_addresses.csv
+//go:embed data/thai_addresses.csv
+var thaiAddressCSV []byte
+
+var thaiAddressColumns = []string{
+	"province_th", "province_en",
+	"district_th", "district_en",
+	"sub_district_th", "sub_district_en",
+	"postal_code",
+	"latitude", "longitude",
+}
+
+var (
+	thaiAddresses = mustLoadThaiAddresses()
+
+	errUnknownAddress = errors.New("unknown address")
+
+	postalCodePattern = regexp.MustCompile(`^\d{5}$`)
+)
+
+type thaiSubDistrict struct {
+	ProvinceTH    string
+	ProvinceEN    string
+	DistrictTH    string
+	DistrictEN    string
+	SubDistrictTH string
+	SubDistrictEN string
+	PostalCode    string
+	// Latitude and Longitude are only set when Located.
+	Latitude  float64
+	Longitude float64
+	Located   bool
+
+	// keys are the names of each level in both languages, normalised by
+	// addressKey.
+	provinceKeys    []string
+	districtKeys    []string
+	subDistrictKeys []string
+}
+
+type thaiAddressBook struct {
+	subDistricts []*thaiSubDistrict
+}
+
+func mustLoadThaiAddresses() *thaiAddressBook {
+	book, err := loadThaiAddresses(bytes.NewReader(thaiAddressCSV))
+	if err != nil {
+		log.Fatalf("failed to load thai addresses: %v", err)
+	}
+	return book
+}
+
+func loadThaiAddresses(r io.Reader) (*thaiAddressBook, error) {
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(thaiAddressColumns)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Equal(header, thaiAddressColumns) {
+		return nil, fmt.Errorf("columns must be %s", strings.Join(thaiAddressColumns, ","))
+	}
+
+	book := &thaiAddressBook{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sd := &thaiSubDistrict{
+			ProvinceTH:    record[0],
+			ProvinceEN:    record[1],
+			DistrictTH:    record[2],
+			DistrictEN:    record[3],
+			SubDistrictTH: record[4],
+			SubDistrictEN: record[5],
+			PostalCode:    record[6],
+		}
+		if !postalCodePattern.MatchString(sd.PostalCode) {
+			return nil, fmt.Errorf("sub-district %s has invalid postal code %q", sd.SubDistrictEN, sd.PostalCode)
+		}
+		if record[7] != "" || record[8] != "" {
+			if sd.Latitude, err = strconv.ParseFloat(record[7], 64); err != nil || sd.Latitude < -90 || sd.Latitude > 90 {
+				return nil, fmt.Errorf("sub-district %s has invalid latitude %q", sd.SubDistrictEN, record[7])
+			}
+			if sd.Longitude, err = strconv.ParseFloat(record[8], 64); err != nil || sd.Longitude < -180 || sd.Longitude > 180 {
+				return nil, fmt.Errorf("sub-district %s has invalid longitude %q", sd.SubDistrictEN, record[8])
+			}
+			sd.Located = true
+		}
+
+		sd.provinceKeys = []string{addressKey(sd.ProvinceTH), addressKey(sd.ProvinceEN)}
+		sd.districtKeys = []string{addressKey(sd.DistrictTH), addressKey(sd.DistrictEN)}
+		sd.subDistrictKeys = []string{addressKey(sd.SubDistrictTH), addressKey(sd.SubDistrictEN)}
+
+		book.subDistricts = append(book.subDistricts, sd)
+	}
+
+	return book, nil
+}
+
+// normalize finds the sub-district of the names, given in Thai or English
+// with or without their administrative prefix. An empty postal code is filled
+// in; a given one must match.
+func (b *thaiAddressBook) normalize(subDistrict, district, province, postalCode string) (*thaiSubDistrict, error) {
+
+	subKey, districtKey, provinceKey := addressKey(subDistrict), addressKey(district), addressKey(province)
+	if subKey == "" || districtKey == "" || provinceKey == "" {
+		return nil, fmt.Errorf("%w: sub-district, district and province are required", errUnknownAddress)
+	}
+	postalCode = strings.TrimSpace(postalCode)
+
+	var match *thaiSubDistrict
+	for _, sd := range b.subDistricts {
+		if !slices.Contains(sd.subDistrictKeys, subKey) ||
+			!slices.Contains(sd.districtKeys, districtKey) ||
+			!slices.Contains(sd.provinceKeys, provinceKey) {
+			continue
+		}
+		match = sd
+		if postalCode == "" || sd.PostalCode == postalCode {
+			return sd, nil
+		}
+	}
+
+	if match != nil {
+		return nil, fmt.Errorf("%w: postal code of %s, %s is %s", errUnknownAddress,
+			match.SubDistrictEN, match.DistrictEN, match.PostalCode)
+	}
+	return nil, fmt.Errorf("%w: no sub-district %q in district %q, province %q", errUnknownAddress,
+		subDistrict, district, province)
+}
+
+// suggest returns up to limit sub-districts in the province and district
+// whose names or postal code start with query. Sub-district matches come
+// first, then district, province and postal code matches.
+func (b *thaiAddressBook) suggest(query, district, province string, limit int) []*thaiSubDistrict {
+
+	queryKey, districtKey, provinceKey := addressKey(query), addressKey(district), addressKey(province)
+	query = strings.TrimSpace(query)
+
+	type ranked struct {
+		sd   *thaiSubDistrict
+		rank int
+	}
+
+	var matches []ranked
+	for _, sd := range b.subDistricts {
+		if provinceKey != "" && !slices.Contains(sd.provinceKeys, provinceKey) {
+			continue
+		}
+		if districtKey != "" && !slices.Contains(sd.districtKeys, districtKey) {
+			continue
+		}
+
+		rank := 0
+		if queryKey != "" {
+			switch {
+			case hasKeyPrefix(sd.subDistrictKeys, queryKey):
+				rank = 0
+			case hasKeyPrefix(sd.districtKeys, queryKey):
+				rank = 1
+			case hasKeyPrefix(sd.provinceKeys, queryKey):
+				rank = 2
+			case strings.HasPrefix(sd.PostalCode, query):
+				rank = 3
+			default:
+				continue
+			}
+		}
+		matches = append(matches, ranked{sd, rank})
+	}
+
+	slices.SortStableFunc(matches, func(x, y ranked) int { return x.rank - y.rank })
+
+	var suggestions []*thaiSubDistrict
+	for _, m := range matches {
+		if len(suggestions) == limit {
+			break
+		}
+		suggestions = append(suggestions, m.sd)
+	}
+
+	return suggestions
+}
+
+func hasKeyPrefix(keys []string, prefix string) bool {
+	return slices.ContainsFunc(keys, func(key string) bool { return strings.HasPrefix(key, prefix) })
+}
+
+// thaiAddressPrefixes are the administrative words written before names,
+// e.g. "ตำบลสุเทพ", "Amphoe Mueang Chiang Mai" or "Khet Bang Rak".
+var thaiAddressPrefixes = []string{
+	"จังหวัด", "จ.", "อำเภอ", "อ.", "ตำบล", "ต.", "เขต", "แขวง",
+	"changwat", "amphoe", "tambon", "khet", "khwaeng",
+}
+
+// thaiAddressAliases are other spellings of names, by their key.
+var thaiAddressAliases = map[string]string{
+	"กรุงเทพ":       "bangkok",
+	"กรุงเทพฯ":      "bangkok",
+	"กรุงเทพมหานคร": "bangkok",
+	"กทม":                 "bangkok",
+	"krungthep":           "bangkok",
+	"krungthepmahanakhon": "bangkok",
+}
+
+// addressKey normalises a name for comparison. It drops case, spaces,
+// hyphens, dots and administrative prefixes, spells "muang" as "mueang" and
+// resolves aliases.
+func addressKey(name string) string {
+
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, prefix := range thaiAddressPrefixes {
+		if rest, ok := strings.CutPrefix(key, prefix); ok {
+			key = rest
+			break
+		}
+	}
+
+	key = strings.NewReplacer(" ", "", "-", "", ".", "", "muang", "mueang").Replace(key)
+	if alias, ok := thaiAddressAliases[key]; ok {
+		return alias
+	}
+	return key
+}
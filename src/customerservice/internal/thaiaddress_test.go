@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeRealAddresses(t *testing.T) {
+
+	tests := []struct {
+		name                            string
+		subDistrict, district, province string
+		postalCode                      string
+		wantSubDistrict                 string
+		wantPostalCode                  string
+	}{
+		{
+			name:        "Bangkok in Thai with prefixes",
+			subDistrict: "แขวงสีลม", district: "เขตบางรัก", province: "กรุงเทพฯ",
+			postalCode:      "10500",
+			wantSubDistrict: "Si Lom", wantPostalCode: "10500",
+		},
+		{
+			name:        "Chiang Mai in Thai with short prefixes",
+			subDistrict: "ต.สุเทพ", district: "อ.เมืองเชียงใหม่", province: "จ.เชียงใหม่",
+			wantSubDistrict: "Suthep", wantPostalCode: "50200",
+		},
+		{
+			name:        "Phuket in English",
+			subDistrict: "Tambon Talat Yai", district: "Amphoe Muang Phuket", province: "Phuket",
+			postalCode:      "83000",
+			wantSubDistrict: "Talat Yai", wantPostalCode: "83000",
+		},
+		{
+			name:        "Nonthaburi mixed languages",
+			subDistrict: "Bang Kraso", district: "เมืองนนทบุรี", province: "Nonthaburi",
+			wantSubDistrict: "Bang Kraso", wantPostalCode: "11000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sd, err := thaiAddresses.normalize(tt.subDistrict, tt.district, tt.province, tt.postalCode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sd.SubDistrictEN != tt.wantSubDistrict || sd.PostalCode != tt.wantPostalCode {
+				t.Errorf("normalize() = %s %s, want %s %s", sd.SubDistrictEN, sd.PostalCode, tt.wantSubDistrict, tt.wantPostalCode)
+			}
+		})
+	}
+
+	if _, err := thaiAddresses.normalize("สีลม", "บางรัก", "กรุงเทพมหานคร", "10200"); !errors.Is(err, errUnknownAddress) {
+		t.Errorf("wrong postal code: got %v, want errUnknownAddress", err)
+	}
+	if _, err := thaiAddresses.normalize("สุเทพ", "บางรัก", "กรุงเทพมหานคร", ""); !errors.Is(err, errUnknownAddress) {
+		t.Errorf("sub-district of another district: got %v, want errUnknownAddress", err)
+	}
+}
+
+func TestLoadThaiAddressesWithoutCentroid(t *testing.T) {
+
+	csv := strings.Join(thaiAddressColumns, ",") + "\n" +
+		"เลย,Loei,เมืองเลย,Mueang Loei,กุดป่อง,Kut Pong,42000,,\n" +
+		"เลย,Loei,เมืองเลย,Mueang Loei,นาอาน,Na An,42000,17.5,101.7\n"
+
+	book, err := loadThaiAddresses(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := &centroidGeocoder{book: book}
+
+	if _, err := g.Geocode(context.Background(), &GeoAddress{
+		SubDistrict: "Kut Pong", District: "Mueang Loei", Province: "Loei",
+	}); !errors.Is(err, ErrNoLocation) {
+		t.Errorf("sub-district without centroid: got %v, want ErrNoLocation", err)
+	}
+
+	point, err := g.Geocode(context.Background(), &GeoAddress{
+		SubDistrict: "Na An", District: "Mueang Loei", Province: "Loei",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if point.Latitude != 17.5 || point.Longitude != 101.7 || !point.Approximate {
+		t.Errorf("Geocode() = %+v", point)
+	}
+
+	if _, err := loadThaiAddresses(strings.NewReader(strings.Join(thaiAddressColumns, ",") + "\n" +
+		"เลย,Loei,เมืองเลย,Mueang Loei,กุดป่อง,Kut Pong,42000,17.5,\n")); err == nil {
+		t.Error("half a centroid: expected an error")
+	}
+}
@@ -0,0 +1,336 @@
+package internal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+// maxIdempotencyKeyLen leaves room in the stored key for its prefix.
+const maxIdempotencyKeyLen = 64
+
+// minGiftCardKeyLen is the shortest GIFT_CARD_CODE_KEY, the size of the hash.
+const minGiftCardKeyLen = sha256.Size
+
+// GiftCardKeyFromEnv reads GIFT_CARD_CODE_KEY, the key of the hash gift card
+// codes are stored as. Changing it makes the unredeemed gift cards unusable.
+func GiftCardKeyFromEnv() ([]byte, error) {
+	key := os.Getenv("GIFT_CARD_CODE_KEY")
+	if len(key) < minGiftCardKeyLen {
+		return nil, fmt.Errorf("GIFT_CARD_CODE_KEY must be at least %d bytes", minGiftCardKeyLen)
+	}
+	return []byte(key), nil
+}
+
+// HashGiftCardCodes hashes the codes of the gift cards issued while they were
+// stored in plain text. It runs on start, before any gift card is redeemed.
+func HashGiftCardCodes(ctx context.Context, store *customerStorage, key []byte) error {
+
+	n, err := store.hashGiftCardCodes(ctx, func(code string) []byte {
+		return hashGiftCardCode(key, code)
+	})
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		slog.Info("hashed gift card codes", "count", n)
+	}
+	return nil
+}
+
+func (x *CustomerService) GetWallet(ctx context.Context, in *pb.GetWalletRequest) (*pb.Wallet, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	balance, err := x.store.getWalletBalance(ctx, in.CustomerId)
+	if err != nil {
+		slog.Error("store get wallet balance", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &pb.Wallet{CustomerId: in.CustomerId, Balance: balance}, nil
+}
+
+func (x *CustomerService) ListWalletTransactions(ctx context.Context, in *pb.ListWalletTransactionsRequest) (*pb.ListWalletTransactionsResponse, error) {
+
+	if err := authorizeCustomer(ctx, in.CustomerId, permCustomersRead); err != nil {
+		return nil, err
+	}
+
+	filter := &dbWalletFilter{
+		CustomerID: in.CustomerId,
+		Limit:      int(in.PageSize),
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = 20
+	case filter.Limit > 100:
+		filter.Limit = 100
+	}
+
+	if in.PageToken != "" {
+		before, id, err := decodeTransactionCursor(in.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.BeforeTime = &before
+		filter.BeforeID = id
+	}
+
+	transactions, err := x.store.listWalletTransactions(ctx, filter)
+	if err != nil {
+		slog.Error("store list wallet transactions", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &pb.ListWalletTransactionsResponse{}
+	for _, t := range transactions {
+		resp.Transactions = append(resp.Transactions, toPbWalletTransaction(t))
+	}
+
+	if len(transactions) == filter.Limit {
+		last := transactions[len(transactions)-1]
+		resp.NextPageToken = encodeTransactionCursor(last.CreateTime, last.TransactionID)
+	}
+
+	return resp, nil
+}
+
+// RedeemGiftCard adds the amount of a gift card to the wallet of the caller.
+// Codes are matched regardless of case, dashes and spaces.
+func (x *CustomerService) RedeemGiftCard(ctx context.Context, in *pb.RedeemGiftCardRequest) (*pb.WalletTransaction, error) {
+
+	if err := authorizeSelf(ctx, in.CustomerId); err != nil {
+		return nil, err
+	}
+
+	code := normalizeGiftCardCode(in.Code)
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "gift card code is required")
+	}
+
+	transaction, err := x.store.redeemGiftCard(ctx, in.CustomerId, hashGiftCardCode(x.giftCardKey, code))
+	if err != nil {
+		return nil, walletError("redeem gift card", err)
+	}
+
+	slog.Info("redeemed gift card", "customerID", in.CustomerId, "amount", transaction.Amount)
+	return toPbWalletTransaction(transaction), nil
+}
+
+// TopUpWallet adds a payment confirmed by the payment provider to the
+// wallet. Only the payment service can call it.
+func (x *CustomerService) TopUpWallet(ctx context.Context, in *pb.TopUpWalletRequest) (*pb.WalletTransaction, error) {
+
+	if err := x.services.authorize(ctx, servicePayment); err != nil {
+		return nil, err
+	}
+
+	if in.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if err := validateIdempotencyKey(in.IdempotencyKey); err != nil {
+		return nil, err
+	}
+
+	transaction, err := x.store.topUpWallet(ctx, in.CustomerId, in.IdempotencyKey, in.PaymentReference, in.Amount)
+	if err != nil {
+		return nil, walletError("top up wallet", err)
+	}
+
+	return toPbWalletTransaction(transaction), nil
+}
+
+// IssueGiftCard creates a gift card sold through the payment service, the
+// only caller allowed.
+func (x *CustomerService) IssueGiftCard(ctx context.Context, in *pb.IssueGiftCardRequest) (*pb.GiftCard, error) {
+
+	if err := x.services.authorize(ctx, servicePayment); err != nil {
+		return nil, err
+	}
+
+	if in.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if err := validateIdempotencyKey(in.IdempotencyKey); err != nil {
+		return nil, err
+	}
+
+	code, err := newGiftCardCode()
+	if err != nil {
+		slog.Error("generate gift card code", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	card := &dbGiftCard{CodeHash: hashGiftCardCode(x.giftCardKey, code), Amount: in.Amount}
+	if in.ExpireTime != nil {
+		expireTime := in.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expire time must be in the future")
+		}
+		card.ExpireTime = &expireTime
+	}
+
+	issued, err := x.store.issueGiftCard(ctx, in.IdempotencyKey, card)
+	if err != nil {
+		return nil, walletError("issue gift card", err)
+	}
+
+	// A retry returns the gift card of the first issue, whose code is gone.
+	if hmac.Equal(issued.CodeHash, card.CodeHash) {
+		issued.Code = code
+	}
+
+	slog.Info("issued gift card", "giftCardID", issued.GiftCardID, "amount", issued.Amount)
+	return toPbGiftCard(issued), nil
+}
+
+// PayWithWallet pays an order from the wallet. The payment of an order is
+// made once, paying again returns it as long as the amount is the same. Only
+// the order service can call it.
+func (x *CustomerService) PayWithWallet(ctx context.Context, in *pb.PayWithWalletRequest) (*pb.WalletTransaction, error) {
+
+	if err := x.services.authorize(ctx, serviceOrder); err != nil {
+		return nil, err
+	}
+
+	if in.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+	if in.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	transaction, err := x.store.payWithWallet(ctx, in.CustomerId, in.OrderId, in.Amount)
+	if err != nil {
+		return nil, walletError("pay with wallet", err)
+	}
+
+	return toPbWalletTransaction(transaction), nil
+}
+
+// RefundToWallet refunds a cancelled order to the wallet. The refund of an
+// order is made once. Only the order service can call it.
+func (x *CustomerService) RefundToWallet(ctx context.Context, in *pb.RefundToWalletRequest) (*pb.WalletTransaction, error) {
+
+	if err := x.services.authorize(ctx, serviceOrder); err != nil {
+		return nil, err
+	}
+
+	if in.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+	if in.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	transaction, err := x.store.refundToWallet(ctx, in.CustomerId, in.OrderId, in.Amount)
+	if err != nil {
+		return nil, walletError("refund to wallet", err)
+	}
+
+	return toPbWalletTransaction(transaction), nil
+}
+
+// walletError maps a store error of a wallet transaction to its status.
+func walletError(op string, err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Error(codes.NotFound, "customer not found")
+	case errors.Is(err, errGiftCardNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errInsufficientBalance),
+		errors.Is(err, errGiftCardRedeemed),
+		errors.Is(err, errGiftCardExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errIdempotencyKeyReused):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	slog.Error("store "+op, "err", err)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func validateIdempotencyKey(key string) error {
+	switch {
+	case key == "":
+		return status.Error(codes.InvalidArgument, "idempotency key is required")
+	case len(key) > maxIdempotencyKeyLen:
+		return status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLen)
+	}
+	return nil
+}
+
+// newGiftCardCode returns a random code of 16 base32 characters, 80 bits.
+func newGiftCardCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+// hashGiftCardCode returns the hash a normalized code is stored as. It is
+// keyed, so that the codes cannot be found from the hashes without the key.
+func hashGiftCardCode(key []byte, code string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(code))
+	return mac.Sum(nil)
+}
+
+// normalizeGiftCardCode returns the code as it is hashed, in upper case
+// without dashes and spaces.
+func normalizeGiftCardCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToUpper(code))
+}
+
+// formatGiftCardCode groups the code by four, XXXX-XXXX-XXXX-XXXX.
+func formatGiftCardCode(code string) string {
+	var groups []string
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), "-")
+}
+
+func toPbWalletTransaction(t *dbWalletTransaction) *pb.WalletTransaction {
+	return &pb.WalletTransaction{
+		TransactionId: t.TransactionID,
+		Type:          pb.WalletTransactionType(t.Type),
+		Amount:        t.Amount,
+		Reference:     t.Reference,
+		CreateTime:    timestamppb.New(t.CreateTime),
+	}
+}
+
+func toPbGiftCard(card *dbGiftCard) *pb.GiftCard {
+	pbCard := &pb.GiftCard{
+		GiftCardId: card.GiftCardID,
+		Code:       formatGiftCardCode(card.Code),
+		Amount:     card.Amount,
+		CreateTime: timestamppb.New(card.CreateTime),
+	}
+	if card.ExpireTime != nil {
+		pbCard.ExpireTime = timestamppb.New(*card.ExpireTime)
+	}
+	return pbCard
+}
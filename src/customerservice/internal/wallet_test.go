@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
+)
+
+func TestWalletOfAnotherCustomer(t *testing.T) {
+
+	// The store is never reached, the caller is refused first.
+	x := &CustomerService{}
+
+	calls := map[string]func(context.Context) error{
+		"get": func(ctx context.Context) error {
+			_, err := x.GetWallet(ctx, &pb.GetWalletRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"list transactions": func(ctx context.Context) error {
+			_, err := x.ListWalletTransactions(ctx, &pb.ListWalletTransactionsRequest{CustomerId: testCustomerID})
+			return err
+		},
+		"redeem gift card": func(ctx context.Context) error {
+			_, err := x.RedeemGiftCard(ctx, &pb.RedeemGiftCardRequest{CustomerId: testCustomerID, Code: "ABCD-EFGH"})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		if got := status.Code(call(callerContext(testOtherID, "ROLES_CUSTOMER"))); got != codes.PermissionDenied {
+			t.Errorf("%s: got %v, want PermissionDenied", name, got)
+		}
+		if got := status.Code(call(context.Background())); got != codes.Unauthenticated {
+			t.Errorf("%s without identity: got %v, want Unauthenticated", name, got)
+		}
+	}
+
+	// Gift cards are redeemed into the wallet of the caller only.
+	_, err := x.RedeemGiftCard(callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "customers:write"),
+		&pb.RedeemGiftCardRequest{CustomerId: testCustomerID, Code: "ABCD-EFGH"})
+	if got := status.Code(err); got != codes.PermissionDenied {
+		t.Errorf("redeem gift card as admin: got %v, want PermissionDenied", got)
+	}
+}
+
+// Money is only moved into wallets by the services. The store is never
+// reached, the caller is refused first.
+func TestWalletServiceCalls(t *testing.T) {
+
+	x := &CustomerService{services: testServices()}
+
+	calls := []struct {
+		name    string
+		service string
+		call    func(context.Context) error
+	}{
+		{"top up", "payment", func(ctx context.Context) error {
+			_, err := x.TopUpWallet(ctx, &pb.TopUpWalletRequest{CustomerId: testCustomerID, Amount: 100, IdempotencyKey: "key-1"})
+			return err
+		}},
+		{"issue gift card", "payment", func(ctx context.Context) error {
+			_, err := x.IssueGiftCard(ctx, &pb.IssueGiftCardRequest{Amount: 100, IdempotencyKey: "key-1"})
+			return err
+		}},
+		{"pay", "order", func(ctx context.Context) error {
+			_, err := x.PayWithWallet(ctx, &pb.PayWithWalletRequest{CustomerId: testCustomerID, OrderId: "order-1", Amount: 100})
+			return err
+		}},
+		{"refund", "order", func(ctx context.Context) error {
+			_, err := x.RefundToWallet(ctx, &pb.RefundToWalletRequest{CustomerId: testCustomerID, OrderId: "order-1", Amount: 100})
+			return err
+		}},
+	}
+
+	for _, c := range calls {
+		other := "order"
+		if c.service == "order" {
+			other = "payment"
+		}
+		for name, ctx := range map[string]context.Context{
+			"no identity":   context.Background(),
+			"customer":      callerContext(testCustomerID, "ROLES_CUSTOMER"),
+			"admin":         callerContext(testOtherID, "ROLES_ADMIN", "auth-permissions", "customers:write"),
+			"forged token":  serviceContext("forged"),
+			"other service": serviceContext(other),
+		} {
+			if got := status.Code(c.call(ctx)); got != codes.PermissionDenied {
+				t.Errorf("%s by %s: got %v, want PermissionDenied", c.name, name, got)
+			}
+		}
+	}
+}
+
+func TestHashGiftCardCode(t *testing.T) {
+
+	key := bytes.Repeat([]byte("k"), minGiftCardKeyLen)
+	code := "ABCDEFGHIJKLMNOP"
+
+	if got := hashGiftCardCode(key, normalizeGiftCardCode("abcd-efgh ijkl-mnop")); !bytes.Equal(got, hashGiftCardCode(key, code)) {
+		t.Error("a formatted code does not hash as its normalized code")
+	}
+	if bytes.Contains(hashGiftCardCode(key, code), []byte(code)) {
+		t.Error("the hash contains the code")
+	}
+	if bytes.Equal(hashGiftCardCode(key, code), hashGiftCardCode(bytes.Repeat([]byte("o"), minGiftCardKeyLen), code)) {
+		t.Error("the hash does not depend on the key")
+	}
+}
@@ -2,26 +2,34 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"log/slog"
 	"net"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"strings"
 
 	"os"
 	"time"
 
 	_ "github.com/lib/pq"
+	"google.golang.org/api/idtoken"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/oauth"
 
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"google.golang.org/grpc/health"
 	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/pongsathonn/ihavefood/pkg/migrate"
 	pb "github.com/pongsathonn/ihavefood/src/customerservice/genproto"
 	"github.com/pongsathonn/ihavefood/src/customerservice/internal"
+	"github.com/pongsathonn/ihavefood/src/customerservice/supabase/migrations"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
@@ -72,15 +80,120 @@ func main() {
 		log.Fatal(err)
 	}
 
+	migrator, err := migrate.New(pool, migrations.FS, "customerservice.schema_versions")
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(migrator, "customerservice", os.Args[2:]); err != nil {
+			log.Fatalf("Failed to migrate: %v", err)
+		}
+		return
+	}
+
+	if err := migrate.OnStart(migrator); err != nil {
+		log.Fatalf("Failed to migrate: %v", err)
+	}
+
+	loyalty, err := internal.LoyaltyRulesFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	giftCardKey, err := internal.GiftCardKeyFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	blobs, err := newBlobStore()
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+
+	store := internal.NewCustomerStorage(pool)
+	if err := internal.HashGiftCardCodes(context.Background(), store, giftCardKey); err != nil {
+		log.Fatalf("Failed to hash gift card codes: %v", err)
+	}
+
 	rabbitmq := internal.NewRabbitMQ(initAMQPCon())
-	s := internal.NewCustomerService(rabbitmq,
-		internal.NewCustomerStorage(pool),
+	s := internal.NewCustomerService(
+		rabbitmq,
+		store,
+		internal.NewCentroidGeocoder(),
+		pb.NewMerchantServiceClient(newGRPCConn("MERCHANT_URI")),
+		loyalty,
+		blobs,
+		giftCardKey,
+		internal.ServiceAuthenticatorFromEnv(),
 	)
 
 	go rabbitmq.Start([]*internal.EventHandler{
 		{Key: "sync.customer.created", Handler: s.HandleCustomerCreation},
+		{Key: "sync.customer.deleted", Handler: s.HandleCustomerDeletion},
+		{Key: "sync.customer.email.updated", Handler: s.HandleCustomerEmailUpdated},
+		{Key: "sync.customer.phone_number.updated", Handler: s.HandleCustomerPhoneNumberUpdated},
+		{Key: "sync.customer.merged", Handler: s.HandleCustomerMerged},
+		{Key: "order.placed.event", Handler: s.HandleOrderPlaced},
+		{Key: "merchant.updated.event", Handler: s.HandleMerchantUpdated},
+		{Key: "order.delivered.event", Handler: s.HandleOrderDelivered},
+		{Key: "order.placed.event", Handler: s.HandleOrderPlacedNotification},
+		{Key: "order.delivered.event", Handler: s.HandleOrderDeliveredNotification},
+		{Key: "order.cancelled.event", Handler: s.HandleOrderCancelledNotification},
+		{Key: "coupon.added.event", Handler: s.HandleCouponAdded},
 	})
 
+	expireInterval := time.Hour
+	if v := os.Getenv("LOYALTY_EXPIRE_INTERVAL"); v != "" {
+		if expireInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("invalid LOYALTY_EXPIRE_INTERVAL: %v", err)
+		}
+	}
+	go internal.NewLoyaltyExpirer(store).Run(context.Background(), expireInterval)
+
+	// Email and phone are copies of auth. Drift is only reported, a fix
+	// goes through auth so that every service gets it.
+	if os.Getenv("AUTH_URI") != "" {
+		interval := 24 * time.Hour
+		if v := os.Getenv("CONTACT_RECONCILE_INTERVAL"); v != "" {
+			if interval, err = time.ParseDuration(v); err != nil {
+				log.Fatalf("invalid CONTACT_RECONCILE_INTERVAL: %v", err)
+			}
+		}
+		reconciler := internal.NewContactReconciler(
+			pb.NewAuthServiceClient(newGRPCConn("AUTH_URI")),
+			store,
+		)
+		go reconciler.Run(context.Background(), interval)
+	} else {
+		slog.Warn("AUTH_URI is not set, contact reconciliation is disabled")
+	}
+
+	// Exports need every service holding personal data of the customer.
+	if os.Getenv("AUTH_URI") != "" && os.Getenv("ORDER_URI") != "" {
+		interval := time.Minute
+		if v := os.Getenv("DATA_EXPORT_INTERVAL"); v != "" {
+			if interval, err = time.ParseDuration(v); err != nil {
+				log.Fatalf("invalid DATA_EXPORT_INTERVAL: %v", err)
+			}
+		}
+		ttl := 7 * 24 * time.Hour
+		if v := os.Getenv("DATA_EXPORT_TTL"); v != "" {
+			if ttl, err = time.ParseDuration(v); err != nil {
+				log.Fatalf("invalid DATA_EXPORT_TTL: %v", err)
+			}
+		}
+		exporter := internal.NewDataExporter(
+			store,
+			pb.NewAuthServiceClient(newGRPCConn("AUTH_URI")),
+			pb.NewOrderServiceClient(newGRPCConn("ORDER_URI")),
+			ttl,
+		)
+		go exporter.Run(context.Background(), interval)
+	} else {
+		slog.Warn("AUTH_URI or ORDER_URI is not set, data exports are disabled")
+	}
+
 	if s == nil {
 		log.Fatal("customer service instance is nil")
 	}
@@ -91,17 +204,92 @@ func main() {
 		log.Fatal("Failed to listen:", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(internal.MaxRecvMsgSize))
 	healthcheck := health.NewServer()
 	healthgrpc.RegisterHealthServer(grpcServer, healthcheck)
 	pb.RegisterCustomerServiceServer(grpcServer, s)
 
-	if err := grpcServer.Serve(lis); err != nil {
+	// Cloud Run routes a single port, the blobs are served next to gRPC.
+	mux := http.NewServeMux()
+	mux.Handle("GET /blobs/", http.StripPrefix("/blobs", blobs.Handler()))
+
+	var protocols http.Protocols
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	server := &http.Server{
+		Handler:   grpcOrHTTP(grpcServer, mux),
+		Protocols: &protocols,
+	}
+
+	if err := server.Serve(lis); err != nil {
 		log.Fatal("Failed to serve:", err)
 	}
 
 }
 
+// newBlobStore keeps blobs under BLOB_DIR, "blobs" by default, served on
+// PORT at BLOB_BASE_URL. The local store is for development: on Cloud Run the
+// filesystem of an instance is lost on restart and not shared with the other
+// instances, deployments need a BlobStore backed by a bucket. BLOB_BASE_URL
+// is required there, the blobs are reached through the api-gateway.
+func newBlobStore() (*internal.LocalBlobStore, error) {
+
+	dir := os.Getenv("BLOB_DIR")
+	if dir == "" {
+		dir = "blobs"
+	}
+	baseURL := os.Getenv("BLOB_BASE_URL")
+	if baseURL == "" {
+		// Cloud Run sets K_SERVICE.
+		if os.Getenv("K_SERVICE") != "" {
+			return nil, fmt.Errorf("BLOB_BASE_URL not set")
+		}
+		baseURL = fmt.Sprintf("http://localhost:%s/blobs", os.Getenv("PORT"))
+	}
+	if os.Getenv("K_SERVICE") != "" {
+		slog.Warn("blobs are kept on the instance filesystem, they are lost on restart", "dir", dir)
+	}
+
+	return internal.NewLocalBlobStore(dir, baseURL)
+}
+
+// grpcOrHTTP serves gRPC requests with grpcServer and the others with h.
+func grpcOrHTTP(grpcServer *grpc.Server, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func newGRPCConn(env string) *grpc.ClientConn {
+	ctx := context.Background()
+
+	uri := os.Getenv(env)
+	tokenSource, err := idtoken.NewTokenSource(ctx, uri)
+	if err != nil {
+		log.Fatalf("Failed to create token source: %v", err)
+	}
+
+	parsedURL, err := url.Parse(uri)
+	if err != nil {
+		log.Fatalf("Failed to parse URI: %v", err)
+	}
+
+	cc, err := grpc.NewClient(
+		parsedURL.Host+":443",
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+		grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokenSource}),
+	)
+	if err != nil {
+		log.Fatalf("failed to create grpc client for %s: %v", env, err)
+	}
+
+	return cc
+}
+
 func initAMQPCon() *amqp.Connection {
 
 	uri := fmt.Sprintf("amqp://%s:%s@%s/%s",
@@ -0,0 +1,233 @@
+// Command thaiaddresses generates internal/data/thai_addresses.csv, the
+// address dataset of customerservice, from the province, district and
+// sub-district list published by the kongvut/thai-province-data project,
+// which follows the Department of Provincial Administration codes.
+//
+// Run it through "go generate ./internal" from src/customerservice. The list
+// has no coordinates, so the centroids already in the output file are kept
+// and the other sub-districts are written without one.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const defaultSource = "https://raw.githubusercontent.com/kongvut/thai-province-data/master/api_province_with_amphure_tambon.json"
+
+var columns = []string{
+	"province_th", "province_en",
+	"district_th", "district_en",
+	"sub_district_th", "sub_district_en",
+	"postal_code",
+	"latitude", "longitude",
+}
+
+// prefixes are the administrative words some names of the list start with.
+var prefixes = []string{"เขต", "อำเภอ", "แขวง", "ตำบล", "Khet ", "Amphoe ", "Khwaeng ", "Tambon "}
+
+var postalCodePattern = regexp.MustCompile(`^\d{5}$`)
+
+type province struct {
+	NameTH    string     `json:"name_th"`
+	NameEN    string     `json:"name_en"`
+	DeletedAt *string    `json:"deleted_at"`
+	Districts []district `json:"amphure"`
+}
+
+type district struct {
+	NameTH       string        `json:"name_th"`
+	NameEN       string        `json:"name_en"`
+	DeletedAt    *string       `json:"deleted_at"`
+	SubDistricts []subDistrict `json:"tambon"`
+}
+
+type subDistrict struct {
+	NameTH    string  `json:"name_th"`
+	NameEN    string  `json:"name_en"`
+	ZipCode   zipCode `json:"zip_code"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+// zipCode is a number in some releases of the list and a string in others.
+type zipCode string
+
+func (z *zipCode) UnmarshalJSON(data []byte) error {
+	*z = zipCode(strings.Trim(string(data), `"`))
+	return nil
+}
+
+func main() {
+
+	source := flag.String("source", defaultSource, "URL or file of the province list")
+	output := flag.String("o", "data/thai_addresses.csv", "CSV file to write")
+	minimum := flag.Int("min", 7000, "fewest sub-districts the list must have")
+	flag.Parse()
+
+	provinces, err := readProvinces(*source)
+	if err != nil {
+		log.Fatalf("read %s: %v", *source, err)
+	}
+
+	centroids, err := readCentroids(*output)
+	if err != nil {
+		log.Fatalf("read centroids of %s: %v", *output, err)
+	}
+
+	records, located := toRecords(provinces, centroids)
+	if len(records) < *minimum {
+		log.Fatalf("%s has %d sub-districts, want at least %d", *source, len(records), *minimum)
+	}
+
+	if err := writeRecords(*output, records); err != nil {
+		log.Fatalf("write %s: %v", *output, err)
+	}
+
+	log.Printf("wrote %d sub-districts, %d with a centroid, to %s", len(records), located, *output)
+}
+
+func readProvinces(source string) ([]province, error) {
+
+	var r io.Reader
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		client := &http.Client{Timeout: time.Minute}
+		resp, err := client.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var provinces []province
+	if err := json.NewDecoder(r).Decode(&provinces); err != nil {
+		return nil, err
+	}
+	return provinces, nil
+}
+
+// readCentroids returns the latitude and longitude of the sub-districts of
+// the current file, by their Thai names.
+func readCentroids(path string) (map[string][2]string, error) {
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	centroids := make(map[string][2]string)
+	for _, record := range records[1:] {
+		if record[7] != "" {
+			centroids[nameKey(record[0], record[2], record[4])] = [2]string{record[7], record[8]}
+		}
+	}
+	return centroids, nil
+}
+
+func toRecords(provinces []province, centroids map[string][2]string) (records [][]string, located int) {
+
+	seen := make(map[string]bool)
+	for _, p := range provinces {
+		if p.DeletedAt != nil {
+			continue
+		}
+		for _, d := range p.Districts {
+			// Names starting with "*" are branch offices, not districts.
+			if d.DeletedAt != nil || strings.HasPrefix(d.NameTH, "*") {
+				continue
+			}
+			for _, sd := range d.SubDistricts {
+				if sd.DeletedAt != nil || strings.HasPrefix(sd.NameTH, "*") {
+					continue
+				}
+
+				record := []string{
+					clean(p.NameTH), clean(p.NameEN),
+					clean(d.NameTH), clean(d.NameEN),
+					clean(sd.NameTH), clean(sd.NameEN),
+					string(sd.ZipCode),
+					"", "",
+				}
+				if !postalCodePattern.MatchString(record[6]) {
+					log.Printf("skip %s, %s, %s: invalid postal code %q", record[5], record[3], record[1], record[6])
+					continue
+				}
+
+				key := nameKey(record[0], record[2], record[4])
+				if seen[key+record[6]] {
+					continue
+				}
+				seen[key+record[6]] = true
+
+				if c, ok := centroids[key]; ok {
+					record[7], record[8] = c[0], c[1]
+					located++
+				}
+				records = append(records, record)
+			}
+		}
+	}
+
+	return records, located
+}
+
+func writeRecords(path string, records [][]string) error {
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	w.Write(columns)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func clean(name string) string {
+	name = strings.TrimSpace(name)
+	for _, prefix := range prefixes {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			return strings.TrimSpace(rest)
+		}
+	}
+	return name
+}
+
+func nameKey(province, district, subDistrict string) string {
+	return province + "|" + district + "|" + subDistrict
+}
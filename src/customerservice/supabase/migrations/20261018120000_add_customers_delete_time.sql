@@ -0,0 +1 @@
+ALTER TABLE customers ADD COLUMN delete_time TIMESTAMP;
@@ -0,0 +1,3 @@
+-- phone is a copy of the auth credential, which already keeps it unique.
+-- Enforcing it here again fails sync events that arrive out of order.
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_phone_key;
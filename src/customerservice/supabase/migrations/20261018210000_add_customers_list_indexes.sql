@@ -0,0 +1,12 @@
+-- last_order_time is set from "order.placed.event", for the has-orders
+-- filter of ListCustomers. Orders placed before it was added are not counted.
+ALTER TABLE customers ADD COLUMN last_order_time TIMESTAMP;
+
+-- Keyset pagination of ListCustomers for each sort, and its prefix filters.
+CREATE INDEX customers_create_time_idx ON customers (create_time, customer_id);
+CREATE INDEX customers_username_idx ON customers (username, customer_id);
+-- Customers without orders sort last, as if they ordered at the epoch.
+CREATE INDEX customers_last_order_time_idx ON customers ((COALESCE(last_order_time, 'epoch')), customer_id);
+CREATE INDEX customers_email_prefix_idx ON customers (lower(email) text_pattern_ops);
+CREATE INDEX customers_phone_prefix_idx ON customers (phone text_pattern_ops);
+CREATE INDEX addresses_customer_id_idx ON addresses (customer_id);
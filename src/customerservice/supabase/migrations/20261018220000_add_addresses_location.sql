@@ -0,0 +1,25 @@
+-- The pin and delivery details of an address. Both coordinates are NULL for
+-- addresses without a pin.
+ALTER TABLE addresses
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD COLUMN detail VARCHAR(255),
+    ADD COLUMN landmark VARCHAR(255),
+    ADD COLUMN delivery_instructions VARCHAR(500),
+    ADD COLUMN is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT addresses_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL) OR
+        (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+-- A customer has at most one default address.
+CREATE UNIQUE INDEX addresses_default_idx ON addresses (customer_id) WHERE is_default;
+
+-- Existing customers get one of their addresses as default, so orders
+-- without an address keep working.
+UPDATE addresses SET is_default = TRUE
+WHERE address_id IN (
+    SELECT DISTINCT ON (customer_id) address_id
+    FROM addresses
+    ORDER BY customer_id, address_id
+);
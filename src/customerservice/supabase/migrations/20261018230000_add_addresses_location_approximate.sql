@@ -0,0 +1,5 @@
+-- Addresses without a pin get the coordinates of their sub-district, marked
+-- as approximate. Existing addresses without a pin are geocoded when they
+-- are next updated.
+ALTER TABLE addresses
+    ADD COLUMN location_approximate BOOLEAN NOT NULL DEFAULT FALSE;
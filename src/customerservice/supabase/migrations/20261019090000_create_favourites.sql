@@ -0,0 +1,35 @@
+-- Favourite merchants and menu items of a customer. Names and images are
+-- copies from the merchant service, refreshed by merchant.updated.event.
+CREATE TABLE favourite_merchants (
+    customer_id UUID,
+    merchant_id UUID,
+    merchant_name VARCHAR(255) NOT NULL,
+    image_url TEXT NOT NULL DEFAULT '',
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (customer_id, merchant_id),
+    CONSTRAINT fk_favourite_merchants_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX favourite_merchants_merchant_idx ON favourite_merchants (merchant_id);
+
+CREATE TABLE favourite_menu_items (
+    customer_id UUID,
+    item_id UUID,
+    merchant_id UUID NOT NULL,
+    food_name VARCHAR(255) NOT NULL,
+    merchant_name VARCHAR(255) NOT NULL,
+    image_url TEXT NOT NULL DEFAULT '',
+    -- available is cleared when the item is no longer on the menu.
+    available BOOLEAN NOT NULL DEFAULT TRUE,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (customer_id, item_id),
+    CONSTRAINT fk_favourite_menu_items_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX favourite_menu_items_merchant_idx ON favourite_menu_items (merchant_id);
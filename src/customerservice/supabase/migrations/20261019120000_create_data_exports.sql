@@ -0,0 +1,25 @@
+-- Personal data exports of a customer. state mirrors pb.DataExportState and
+-- archive is cleared once the export expires.
+CREATE TABLE data_exports (
+    export_id UUID DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL,
+    state SMALLINT NOT NULL DEFAULT 1,
+    archive BYTEA,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    start_time TIMESTAMP,
+    complete_time TIMESTAMP,
+    expire_time TIMESTAMP,
+    PRIMARY KEY (export_id),
+    CONSTRAINT fk_data_exports_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+-- At most one pending or running export per customer.
+CREATE UNIQUE INDEX data_exports_active_idx ON data_exports (customer_id)
+    WHERE state IN (1, 2);
+
+CREATE INDEX data_exports_customer_idx ON data_exports (customer_id, create_time DESC);
@@ -0,0 +1,28 @@
+-- Points ledger of a customer. type mirrors pb.LoyaltyTransactionType and
+-- points is negative for redemptions and expiry. Credits (earn and reverse)
+-- are lots spent oldest-expiring first: remaining is what is left of the lot
+-- and is expired after expire_time.
+CREATE TABLE loyalty_transactions (
+    transaction_id UUID DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL,
+    type SMALLINT NOT NULL,
+    points INTEGER NOT NULL,
+    order_id VARCHAR(64) NOT NULL DEFAULT '',
+    remaining INTEGER NOT NULL DEFAULT 0,
+    expire_time TIMESTAMP,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (transaction_id),
+    CONSTRAINT fk_loyalty_transactions_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+-- An order earns, redeems and is reversed at most once.
+CREATE UNIQUE INDEX loyalty_transactions_order_idx ON loyalty_transactions (customer_id, type, order_id)
+    WHERE order_id <> '';
+
+CREATE INDEX loyalty_transactions_customer_idx ON loyalty_transactions (customer_id, create_time DESC, transaction_id DESC);
+
+CREATE INDEX loyalty_transactions_lots_idx ON loyalty_transactions (customer_id, expire_time)
+    WHERE remaining > 0;
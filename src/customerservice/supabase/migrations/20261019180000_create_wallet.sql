@@ -0,0 +1,86 @@
+-- Wallet ledger. Money only moves by transactions of balanced entries between
+-- accounts, and the balance of an account is the sum of its entries. An
+-- account belongs to a customer or is a system account counting where money
+-- came from or went to. Accounts are not tied to customers so that the ledger
+-- outlives deleted customers.
+CREATE TABLE wallet_accounts (
+    account_id UUID DEFAULT gen_random_uuid(),
+    customer_id UUID UNIQUE,
+    name VARCHAR(64) UNIQUE,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (account_id),
+    CONSTRAINT wallet_accounts_owner CHECK ((customer_id IS NULL) <> (name IS NULL))
+);
+
+INSERT INTO wallet_accounts (name) VALUES
+    ('top_ups'),
+    ('gift_card_sales'),
+    ('gift_cards'),
+    ('order_payments');
+
+-- type mirrors pb.WalletTransactionType. idempotency_key makes retries return
+-- the first transaction.
+CREATE TABLE wallet_transactions (
+    transaction_id UUID DEFAULT gen_random_uuid(),
+    idempotency_key VARCHAR(128) NOT NULL UNIQUE,
+    type SMALLINT NOT NULL,
+    reference VARCHAR(128) NOT NULL DEFAULT '',
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (transaction_id)
+);
+
+-- amount credits the account when positive and debits it when negative.
+CREATE TABLE wallet_entries (
+    entry_id UUID DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES wallet_transactions(transaction_id),
+    account_id UUID NOT NULL REFERENCES wallet_accounts(account_id),
+    amount BIGINT NOT NULL CHECK (amount <> 0),
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (entry_id)
+);
+
+CREATE INDEX wallet_entries_account_idx ON wallet_entries (account_id, create_time DESC, transaction_id DESC);
+
+CREATE INDEX wallet_entries_transaction_idx ON wallet_entries (transaction_id);
+
+CREATE FUNCTION wallet_ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER wallet_transactions_append_only
+    BEFORE UPDATE OR DELETE ON wallet_transactions
+    FOR EACH ROW EXECUTE FUNCTION wallet_ledger_append_only();
+
+CREATE TRIGGER wallet_entries_append_only
+    BEFORE UPDATE OR DELETE ON wallet_entries
+    FOR EACH ROW EXECUTE FUNCTION wallet_ledger_append_only();
+
+-- The entries of a transaction must sum to zero once it commits.
+CREATE FUNCTION wallet_entries_balanced() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM wallet_entries WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'wallet transaction % is not balanced', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER wallet_entries_balanced
+    AFTER INSERT ON wallet_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION wallet_entries_balanced();
+
+-- code is stored without dashes. A gift card is redeemed once, into the
+-- wallet of redeemed_by.
+CREATE TABLE gift_cards (
+    gift_card_id UUID DEFAULT gen_random_uuid(),
+    code VARCHAR(32) NOT NULL UNIQUE,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    expire_time TIMESTAMP,
+    redeemed_by UUID,
+    redeem_time TIMESTAMP,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (gift_card_id)
+);
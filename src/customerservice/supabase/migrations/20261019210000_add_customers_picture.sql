@@ -0,0 +1,3 @@
+-- The profile picture as JSON: the blob key of its variants and their URLs.
+-- NULL for customers without a picture.
+ALTER TABLE customers ADD COLUMN picture JSONB;
@@ -0,0 +1,40 @@
+-- Notification preferences of a customer. category and channel mirror
+-- pb.NotificationCategory and pb.NotificationChannel, a missing row means
+-- the default of the pair.
+CREATE TABLE notification_preferences (
+    customer_id UUID NOT NULL,
+    category SMALLINT NOT NULL,
+    channel SMALLINT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    update_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (customer_id, category, channel),
+    CONSTRAINT fk_notification_preferences_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+-- In-app inbox. dedup_key makes redelivered events notify once.
+CREATE TABLE notifications (
+    notification_id UUID DEFAULT gen_random_uuid(),
+    customer_id UUID NOT NULL,
+    category SMALLINT NOT NULL,
+    title VARCHAR(128) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    reference VARCHAR(128) NOT NULL DEFAULT '',
+    dedup_key VARCHAR(128) NOT NULL,
+    read_time TIMESTAMP,
+    create_time TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (notification_id),
+    CONSTRAINT fk_notifications_customer
+        FOREIGN KEY(customer_id)
+        REFERENCES customers(customer_id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX notifications_dedup_idx ON notifications (customer_id, dedup_key);
+
+CREATE INDEX notifications_customer_idx ON notifications (customer_id, create_time DESC, notification_id DESC);
+
+CREATE INDEX notifications_unread_idx ON notifications (customer_id)
+    WHERE read_time IS NULL;
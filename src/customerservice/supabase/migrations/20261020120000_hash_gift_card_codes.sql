@@ -0,0 +1,8 @@
+-- Gift card codes are stored as their HMAC-SHA256 keyed with
+-- GIFT_CARD_CODE_KEY, so that reading the table gives no spendable code. The
+-- service hashes the codes of gift cards issued before on start, code is
+-- NULL once hashed.
+ALTER TABLE gift_cards
+    ADD COLUMN code_hash BYTEA UNIQUE,
+    ALTER COLUMN code DROP NOT NULL,
+    ADD CONSTRAINT gift_cards_code_check CHECK ((code IS NULL) <> (code_hash IS NULL));
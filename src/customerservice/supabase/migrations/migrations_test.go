@@ -0,0 +1,14 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/pongsathonn/ihavefood/pkg/migrate"
+)
+
+// The embedded migrations must load, or the service cannot start.
+func TestEmbeddedMigrations(t *testing.T) {
+	if _, err := migrate.New(nil, FS, "test"); err != nil {
+		t.Fatal(err)
+	}
+}
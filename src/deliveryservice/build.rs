@@ -24,6 +24,7 @@ fn main() -> Result<(), Box<dyn Error>> {
                     "../../protos/deliveryservice.proto",
                     "../../protos/merchantservice.proto",
                     "../../protos/customerservice.proto",
+                    "../../protos/authservice.proto",
                     "../../protos/events.proto",
                 ],
                 &["../../protos"],
@@ -717,6 +717,23 @@ pub struct NewAddress {
     pub province: ::prost::alloc::string::String,
     #[prost(string, tag = "6")]
     pub postal_code: ::prost::alloc::string::String,
+    /// latitude and longitude of the pin dropped by the customer, in degrees.
+    /// Both are 0 when the address has no pin.
+    #[prost(double, tag = "7")]
+    pub latitude: f64,
+    #[prost(double, tag = "8")]
+    pub longitude: f64,
+    /// detail is the house number, building, floor or room.
+    #[prost(string, tag = "9")]
+    pub detail: ::prost::alloc::string::String,
+    #[prost(string, tag = "10")]
+    pub landmark: ::prost::alloc::string::String,
+    #[prost(string, tag = "11")]
+    pub delivery_instructions: ::prost::alloc::string::String,
+    /// is_default marks the address used when an order names none. A customer
+    /// has at most one default address.
+    #[prost(bool, tag = "12")]
+    pub is_default: bool,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -733,6 +750,28 @@ pub struct Address {
     pub province: ::prost::alloc::string::String,
     #[prost(string, tag = "6")]
     pub postal_code: ::prost::alloc::string::String,
+    /// latitude and longitude of the pin dropped by the customer, in degrees.
+    /// Without a pin they are geocoded from the sub-district, see
+    /// location_approximate. Both are 0 when the address has no location.
+    #[prost(double, tag = "7")]
+    pub latitude: f64,
+    #[prost(double, tag = "8")]
+    pub longitude: f64,
+    /// detail is the house number, building, floor or room.
+    #[prost(string, tag = "9")]
+    pub detail: ::prost::alloc::string::String,
+    #[prost(string, tag = "10")]
+    pub landmark: ::prost::alloc::string::String,
+    #[prost(string, tag = "11")]
+    pub delivery_instructions: ::prost::alloc::string::String,
+    /// is_default marks the address used when an order names none. A customer
+    /// has at most one default address.
+    #[prost(bool, tag = "12")]
+    pub is_default: bool,
+    /// location_approximate is set when latitude and longitude are geocoded
+    /// from the address rather than pinned by the customer.
+    #[prost(bool, tag = "13")]
+    pub location_approximate: bool,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -1749,18 +1788,65 @@ pub struct Customer {
     pub addresses: ::prost::alloc::vec::Vec<Address>,
     #[prost(message, optional, tag = "8")]
     pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
-    /// bytes picture = 10;
     #[prost(message, optional, tag = "9")]
     pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    /// Unset for customers without a profile picture.
+    #[prost(message, optional, tag = "10")]
+    pub picture: ::core::option::Option<CustomerPicture>,
+    /// last_order_time is when the customer last placed an order, unset for
+    /// customers without orders.
+    #[prost(message, optional, tag = "11")]
+    pub last_order_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
 }
+/// CustomerPicture holds the URLs of the profile picture, square JPEGs at
+/// most the given size.
 #[derive(serde::Deserialize, serde::Serialize)]
-#[derive(Clone, Copy, PartialEq, ::prost::Message)]
-pub struct ListCustomersRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CustomerPicture {
+    /// 64 pixels.
+    #[prost(string, tag = "1")]
+    pub small_url: ::prost::alloc::string::String,
+    /// 256 pixels.
+    #[prost(string, tag = "2")]
+    pub medium_url: ::prost::alloc::string::String,
+    /// 1024 pixels.
+    #[prost(string, tag = "3")]
+    pub large_url: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "4")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListCustomersRequest {
+    /// Defaults to 50, at most 200.
+    #[prost(int32, tag = "1")]
+    pub page_size: i32,
+    /// page_token is only valid with the filters and sort it was returned for.
+    #[prost(string, tag = "2")]
+    pub page_token: ::prost::alloc::string::String,
+    /// Case-insensitive prefix of the email.
+    #[prost(string, tag = "3")]
+    pub email_prefix: ::prost::alloc::string::String,
+    /// Prefix of the phone number, e.g. "+6681".
+    #[prost(string, tag = "4")]
+    pub phone_prefix: ::prost::alloc::string::String,
+    /// Customers created at or after create_time_from and before create_time_to.
+    #[prost(message, optional, tag = "5")]
+    pub create_time_from: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(message, optional, tag = "6")]
+    pub create_time_to: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(enumeration = "CustomerOrdersFilter", tag = "7")]
+    pub orders: i32,
+    #[prost(enumeration = "CustomerSort", tag = "8")]
+    pub sort: i32,
+}
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ListCustomersResponse {
     #[prost(message, repeated, tag = "1")]
     pub customers: ::prost::alloc::vec::Vec<Customer>,
+    #[prost(string, tag = "2")]
+    pub next_page_token: ::prost::alloc::string::String,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -1789,6 +1875,20 @@ pub struct UpdateCustomerInfoRequest {
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UploadCustomerPictureRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "2")]
+    pub content: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteCustomerPictureRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UpdateCustomerSocialRequest {
     #[prost(string, tag = "1")]
     pub customer_id: ::prost::alloc::string::String,
@@ -1819,440 +1919,3193 @@ pub struct DeleteCustomerAddressRequest {
     #[prost(string, tag = "2")]
     pub address_id: ::prost::alloc::string::String,
 }
-/// Generated client implementations.
-pub mod customer_service_client {
-    #![allow(
-        unused_variables,
-        dead_code,
-        missing_docs,
-        clippy::wildcard_imports,
-        clippy::let_unit_value,
-    )]
-    use tonic::codegen::*;
-    use tonic::codegen::http::Uri;
-    /// ---------------------CUSTOMER SERVICE------------------------------
-    #[derive(Debug, Clone)]
-    pub struct CustomerServiceClient<T> {
-        inner: tonic::client::Grpc<T>,
-    }
-    impl CustomerServiceClient<tonic::transport::Channel> {
-        /// Attempt to create a new client by connecting to a given endpoint.
-        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
-        where
-            D: TryInto<tonic::transport::Endpoint>,
-            D::Error: Into<StdError>,
-        {
-            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
-            Ok(Self::new(conn))
-        }
-    }
-    impl<T> CustomerServiceClient<T>
-    where
-        T: tonic::client::GrpcService<tonic::body::BoxBody>,
-        T::Error: Into<StdError>,
-        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
-        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
-    {
-        pub fn new(inner: T) -> Self {
-            let inner = tonic::client::Grpc::new(inner);
-            Self { inner }
-        }
-        pub fn with_origin(inner: T, origin: Uri) -> Self {
-            let inner = tonic::client::Grpc::with_origin(inner, origin);
-            Self { inner }
-        }
-        pub fn with_interceptor<F>(
-            inner: T,
-            interceptor: F,
-        ) -> CustomerServiceClient<InterceptedService<T, F>>
-        where
-            F: tonic::service::Interceptor,
-            T::ResponseBody: Default,
-            T: tonic::codegen::Service<
-                http::Request<tonic::body::BoxBody>,
-                Response = http::Response<
-                    <T as tonic::client::GrpcService<tonic::body::BoxBody>>::ResponseBody,
-                >,
-            >,
-            <T as tonic::codegen::Service<
-                http::Request<tonic::body::BoxBody>,
-            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
-        {
-            CustomerServiceClient::new(InterceptedService::new(inner, interceptor))
-        }
-        /// Compress requests with the given encoding.
-        ///
-        /// This requires the server to support it otherwise it might respond with an
-        /// error.
-        #[must_use]
-        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.inner = self.inner.send_compressed(encoding);
-            self
-        }
-        /// Enable decompressing responses.
-        #[must_use]
-        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.inner = self.inner.accept_compressed(encoding);
-            self
-        }
-        /// Limits the maximum size of a decoded message.
-        ///
-        /// Default: `4MB`
-        #[must_use]
-        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
-            self.inner = self.inner.max_decoding_message_size(limit);
-            self
-        }
-        /// Limits the maximum size of an encoded message.
-        ///
-        /// Default: `usize::MAX`
-        #[must_use]
-        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
-            self.inner = self.inner.max_encoding_message_size(limit);
-            self
-        }
-        /// ListCustomers shows all customers profile.
-        pub async fn list_customers(
-            &mut self,
-            request: impl tonic::IntoRequest<super::ListCustomersRequest>,
-        ) -> std::result::Result<
-            tonic::Response<super::ListCustomersResponse>,
-            tonic::Status,
-        > {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/ListCustomers",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListCustomers"));
-            self.inner.unary(req, path, codec).await
-        }
-        /// GetCustomer shows a customer profile.
-        pub async fn get_customer(
-            &mut self,
-            request: impl tonic::IntoRequest<super::GetCustomerRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/GetCustomer",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetCustomer"));
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn create_address(
-            &mut self,
-            request: impl tonic::IntoRequest<super::CreateAddressRequest>,
-        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status> {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/CreateAddress",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(GrpcMethod::new("ihavefood.CustomerService", "CreateAddress"));
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn update_customer_info(
-            &mut self,
-            request: impl tonic::IntoRequest<super::UpdateCustomerInfoRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/UpdateCustomerInfo",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(
-                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerInfo"),
-                );
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn update_customer_social(
-            &mut self,
-            request: impl tonic::IntoRequest<super::UpdateCustomerSocialRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/UpdateCustomerSocial",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(
-                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerSocial"),
-                );
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn update_customer_address(
-            &mut self,
-            request: impl tonic::IntoRequest<super::UpdateCustomerAddressRequest>,
-        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status> {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/UpdateCustomerAddress",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(
-                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerAddress"),
-                );
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn delete_customer(
-            &mut self,
-            request: impl tonic::IntoRequest<super::DeleteCustomerRequest>,
-        ) -> std::result::Result<
-            tonic::Response<::prost_wkt_types::Empty>,
-            tonic::Status,
-        > {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/DeleteCustomer",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(GrpcMethod::new("ihavefood.CustomerService", "DeleteCustomer"));
-            self.inner.unary(req, path, codec).await
-        }
-        pub async fn delete_customer_address(
-            &mut self,
-            request: impl tonic::IntoRequest<super::DeleteCustomerAddressRequest>,
-        ) -> std::result::Result<
-            tonic::Response<::prost_wkt_types::Empty>,
-            tonic::Status,
-        > {
-            self.inner
-                .ready()
-                .await
-                .map_err(|e| {
-                    tonic::Status::unknown(
-                        format!("Service was not ready: {}", e.into()),
-                    )
-                })?;
-            let codec = tonic::codec::ProstCodec::default();
-            let path = http::uri::PathAndQuery::from_static(
-                "/ihavefood.CustomerService/DeleteCustomerAddress",
-            );
-            let mut req = request.into_request();
-            req.extensions_mut()
-                .insert(
-                    GrpcMethod::new("ihavefood.CustomerService", "DeleteCustomerAddress"),
-                );
-            self.inner.unary(req, path, codec).await
-        }
-    }
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SuggestAddressesRequest {
+    /// Prefix of a sub-district, district or province name in Thai or
+    /// English, or of a postal code.
+    #[prost(string, tag = "1")]
+    pub query: ::prost::alloc::string::String,
+    /// Only suggest addresses in the province or district, by name.
+    #[prost(string, tag = "2")]
+    pub province: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub district: ::prost::alloc::string::String,
+    /// Defaults to 10, at most 50.
+    #[prost(int32, tag = "4")]
+    pub page_size: i32,
 }
-/// Generated server implementations.
-pub mod customer_service_server {
-    #![allow(
-        unused_variables,
-        dead_code,
-        missing_docs,
-        clippy::wildcard_imports,
-        clippy::let_unit_value,
-    )]
-    use tonic::codegen::*;
-    /// Generated trait containing gRPC methods that should be implemented for use with CustomerServiceServer.
-    #[async_trait]
-    pub trait CustomerService: std::marker::Send + std::marker::Sync + 'static {
-        /// ListCustomers shows all customers profile.
-        async fn list_customers(
-            &self,
-            request: tonic::Request<super::ListCustomersRequest>,
-        ) -> std::result::Result<
-            tonic::Response<super::ListCustomersResponse>,
-            tonic::Status,
-        >;
-        /// GetCustomer shows a customer profile.
-        async fn get_customer(
-            &self,
-            request: tonic::Request<super::GetCustomerRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
-        async fn create_address(
-            &self,
-            request: tonic::Request<super::CreateAddressRequest>,
-        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status>;
-        async fn update_customer_info(
-            &self,
-            request: tonic::Request<super::UpdateCustomerInfoRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
-        async fn update_customer_social(
-            &self,
-            request: tonic::Request<super::UpdateCustomerSocialRequest>,
-        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
-        async fn update_customer_address(
-            &self,
-            request: tonic::Request<super::UpdateCustomerAddressRequest>,
-        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status>;
-        async fn delete_customer(
-            &self,
-            request: tonic::Request<super::DeleteCustomerRequest>,
-        ) -> std::result::Result<
-            tonic::Response<::prost_wkt_types::Empty>,
-            tonic::Status,
-        >;
-        async fn delete_customer_address(
-            &self,
-            request: tonic::Request<super::DeleteCustomerAddressRequest>,
-        ) -> std::result::Result<
-            tonic::Response<::prost_wkt_types::Empty>,
-            tonic::Status,
-        >;
-    }
-    /// ---------------------CUSTOMER SERVICE------------------------------
-    #[derive(Debug)]
-    pub struct CustomerServiceServer<T> {
-        inner: Arc<T>,
-        accept_compression_encodings: EnabledCompressionEncodings,
-        send_compression_encodings: EnabledCompressionEncodings,
-        max_decoding_message_size: Option<usize>,
-        max_encoding_message_size: Option<usize>,
-    }
-    impl<T> CustomerServiceServer<T> {
-        pub fn new(inner: T) -> Self {
-            Self::from_arc(Arc::new(inner))
-        }
-        pub fn from_arc(inner: Arc<T>) -> Self {
-            Self {
-                inner,
-                accept_compression_encodings: Default::default(),
-                send_compression_encodings: Default::default(),
-                max_decoding_message_size: None,
-                max_encoding_message_size: None,
-            }
-        }
-        pub fn with_interceptor<F>(
-            inner: T,
-            interceptor: F,
-        ) -> InterceptedService<Self, F>
-        where
-            F: tonic::service::Interceptor,
-        {
-            InterceptedService::new(Self::new(inner), interceptor)
-        }
-        /// Enable decompressing requests with the given encoding.
-        #[must_use]
-        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.accept_compression_encodings.enable(encoding);
-            self
-        }
-        /// Compress responses with the given encoding, if the client supports it.
-        #[must_use]
-        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.send_compression_encodings.enable(encoding);
-            self
-        }
-        /// Limits the maximum size of a decoded message.
-        ///
-        /// Default: `4MB`
-        #[must_use]
-        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
-            self.max_decoding_message_size = Some(limit);
-            self
-        }
-        /// Limits the maximum size of an encoded message.
-        ///
-        /// Default: `usize::MAX`
-        #[must_use]
-        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
-            self.max_encoding_message_size = Some(limit);
-            self
-        }
-    }
-    impl<T, B> tonic::codegen::Service<http::Request<B>> for CustomerServiceServer<T>
-    where
-        T: CustomerService,
-        B: Body + std::marker::Send + 'static,
-        B::Error: Into<StdError> + std::marker::Send + 'static,
-    {
-        type Response = http::Response<tonic::body::BoxBody>;
-        type Error = std::convert::Infallible;
-        type Future = BoxFuture<Self::Response, Self::Error>;
-        fn poll_ready(
-            &mut self,
-            _cx: &mut Context<'_>,
-        ) -> Poll<std::result::Result<(), Self::Error>> {
-            Poll::Ready(Ok(()))
-        }
-        fn call(&mut self, req: http::Request<B>) -> Self::Future {
-            match req.uri().path() {
-                "/ihavefood.CustomerService/ListCustomers" => {
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AddressSuggestion {
+    #[prost(string, tag = "1")]
+    pub sub_district: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub district: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub province: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub postal_code: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub sub_district_th: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub district_th: ::prost::alloc::string::String,
+    #[prost(string, tag = "7")]
+    pub province_th: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SuggestAddressesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub suggestions: ::prost::alloc::vec::Vec<AddressSuggestion>,
+}
+/// FavouriteMerchant keeps a copy of the merchant name and image, refreshed
+/// from merchant.updated.event.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FavouriteMerchant {
+    #[prost(string, tag = "1")]
+    pub merchant_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub merchant_name: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub image_url: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "4")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FavouriteMenuItem {
+    #[prost(string, tag = "1")]
+    pub merchant_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub item_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub food_name: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub merchant_name: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub image_url: ::prost::alloc::string::String,
+    /// available is false once the item was removed from the menu.
+    #[prost(bool, tag = "6")]
+    pub available: bool,
+    #[prost(message, optional, tag = "7")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListFavouritesRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+/// The merchant_id of merchants matches Merchant.merchant_id, so clients can
+/// mark favourites in ListMerchants results.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListFavouritesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub merchants: ::prost::alloc::vec::Vec<FavouriteMerchant>,
+    #[prost(message, repeated, tag = "2")]
+    pub menu_items: ::prost::alloc::vec::Vec<FavouriteMenuItem>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AddFavouriteMerchantRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub merchant_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RemoveFavouriteMerchantRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub merchant_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AddFavouriteMenuItemRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub merchant_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub item_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RemoveFavouriteMenuItemRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub item_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DataExport {
+    #[prost(string, tag = "1")]
+    pub export_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "DataExportState", tag = "3")]
+    pub state: i32,
+    #[prost(message, optional, tag = "4")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(message, optional, tag = "5")]
+    pub complete_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    /// Set once the export succeeded.
+    #[prost(message, optional, tag = "6")]
+    pub expire_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(int64, tag = "7")]
+    pub size_bytes: i64,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RequestDataExportRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetDataExportRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub export_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DownloadDataExportRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub export_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DataExportArchive {
+    #[prost(string, tag = "1")]
+    pub filename: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub content_type: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "3")]
+    pub content: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct LoyaltyBalance {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(int32, tag = "2")]
+    pub points: i32,
+    /// The points expiring next and when, unset without points.
+    #[prost(int32, tag = "3")]
+    pub next_expiring_points: i32,
+    #[prost(message, optional, tag = "4")]
+    pub next_expire_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct LoyaltyTransaction {
+    #[prost(string, tag = "1")]
+    pub transaction_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "LoyaltyTransactionType", tag = "2")]
+    pub r#type: i32,
+    /// points is negative for redemptions and expiry.
+    #[prost(int32, tag = "3")]
+    pub points: i32,
+    #[prost(string, tag = "4")]
+    pub order_id: ::prost::alloc::string::String,
+    /// Set for earned and given back points.
+    #[prost(message, optional, tag = "5")]
+    pub expire_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(message, optional, tag = "6")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetLoyaltyBalanceRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListLoyaltyTransactionsRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    /// Defaults to 20, at most 100.
+    #[prost(int32, tag = "2")]
+    pub page_size: i32,
+    #[prost(string, tag = "3")]
+    pub page_token: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListLoyaltyTransactionsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub transactions: ::prost::alloc::vec::Vec<LoyaltyTransaction>,
+    #[prost(string, tag = "2")]
+    pub next_page_token: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RedeemLoyaltyPointsRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub order_id: ::prost::alloc::string::String,
+    #[prost(int32, tag = "3")]
+    pub points: i32,
+    /// order_amount caps the discount, customers can only pay part of an
+    /// order with points.
+    #[prost(int32, tag = "4")]
+    pub order_amount: i32,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RedeemLoyaltyPointsResponse {
+    #[prost(int32, tag = "1")]
+    pub discount: i32,
+    /// balance is the points left after the redemption.
+    #[prost(int32, tag = "2")]
+    pub balance: i32,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ReverseLoyaltyRedemptionRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub order_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Wallet {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(int64, tag = "2")]
+    pub balance: i64,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WalletTransaction {
+    #[prost(string, tag = "1")]
+    pub transaction_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "WalletTransactionType", tag = "2")]
+    pub r#type: i32,
+    /// amount is positive when it credits the wallet.
+    #[prost(int64, tag = "3")]
+    pub amount: i64,
+    /// reference is the order of payments and refunds, the gift card of
+    /// redemptions and the payment of top-ups.
+    #[prost(string, tag = "4")]
+    pub reference: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "5")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GiftCard {
+    #[prost(string, tag = "1")]
+    pub gift_card_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub code: ::prost::alloc::string::String,
+    #[prost(int64, tag = "3")]
+    pub amount: i64,
+    /// Unset for gift cards that do not expire.
+    #[prost(message, optional, tag = "4")]
+    pub expire_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(message, optional, tag = "5")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetWalletRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListWalletTransactionsRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    /// Defaults to 20, at most 100.
+    #[prost(int32, tag = "2")]
+    pub page_size: i32,
+    #[prost(string, tag = "3")]
+    pub page_token: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListWalletTransactionsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub transactions: ::prost::alloc::vec::Vec<WalletTransaction>,
+    #[prost(string, tag = "2")]
+    pub next_page_token: ::prost::alloc::string::String,
+}
+/// Redeeming a gift card again returns the first transaction.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RedeemGiftCardRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub code: ::prost::alloc::string::String,
+}
+/// The idempotency_key of a request is chosen by the client. Retrying with the
+/// same key returns the first transaction, reusing it for another request
+/// fails.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TopUpWalletRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(int64, tag = "2")]
+    pub amount: i64,
+    #[prost(string, tag = "3")]
+    pub payment_reference: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub idempotency_key: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IssueGiftCardRequest {
+    #[prost(int64, tag = "1")]
+    pub amount: i64,
+    #[prost(message, optional, tag = "2")]
+    pub expire_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(string, tag = "3")]
+    pub idempotency_key: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PayWithWalletRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub order_id: ::prost::alloc::string::String,
+    #[prost(int64, tag = "3")]
+    pub amount: i64,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RefundToWalletRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub order_id: ::prost::alloc::string::String,
+    #[prost(int64, tag = "3")]
+    pub amount: i64,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NotificationPreference {
+    #[prost(enumeration = "NotificationCategory", tag = "1")]
+    pub category: i32,
+    #[prost(enumeration = "NotificationChannel", tag = "2")]
+    pub channel: i32,
+    #[prost(bool, tag = "3")]
+    pub enabled: bool,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NotificationPreferences {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    /// One preference for every category and channel.
+    #[prost(message, repeated, tag = "2")]
+    pub preferences: ::prost::alloc::vec::Vec<NotificationPreference>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetNotificationPreferencesRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateNotificationPreferencesRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "2")]
+    pub preferences: ::prost::alloc::vec::Vec<NotificationPreference>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Notification {
+    #[prost(string, tag = "1")]
+    pub notification_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "NotificationCategory", tag = "2")]
+    pub category: i32,
+    #[prost(string, tag = "3")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub body: ::prost::alloc::string::String,
+    /// reference is the order of order updates and the coupon code of
+    /// promotions.
+    #[prost(string, tag = "5")]
+    pub reference: ::prost::alloc::string::String,
+    /// Unset for unread notifications.
+    #[prost(message, optional, tag = "6")]
+    pub read_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    #[prost(message, optional, tag = "7")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListNotificationsRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    /// Defaults to 20, at most 100.
+    #[prost(int32, tag = "2")]
+    pub page_size: i32,
+    #[prost(string, tag = "3")]
+    pub page_token: ::prost::alloc::string::String,
+    #[prost(bool, tag = "4")]
+    pub unread_only: bool,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListNotificationsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub notifications: ::prost::alloc::vec::Vec<Notification>,
+    #[prost(string, tag = "2")]
+    pub next_page_token: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MarkNotificationsReadRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+    #[prost(string, repeated, tag = "2")]
+    pub notification_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetUnreadNotificationCountRequest {
+    #[prost(string, tag = "1")]
+    pub customer_id: ::prost::alloc::string::String,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UnreadNotificationCount {
+    #[prost(int32, tag = "1")]
+    pub unread_count: i32,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum CustomerSort {
+    CreateTimeDesc = 0,
+    CreateTimeAsc = 1,
+    UsernameAsc = 2,
+    UsernameDesc = 3,
+    LastOrderTimeDesc = 4,
+}
+impl CustomerSort {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::CreateTimeDesc => "CUSTOMER_SORT_CREATE_TIME_DESC",
+            Self::CreateTimeAsc => "CUSTOMER_SORT_CREATE_TIME_ASC",
+            Self::UsernameAsc => "CUSTOMER_SORT_USERNAME_ASC",
+            Self::UsernameDesc => "CUSTOMER_SORT_USERNAME_DESC",
+            Self::LastOrderTimeDesc => "CUSTOMER_SORT_LAST_ORDER_TIME_DESC",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "CUSTOMER_SORT_CREATE_TIME_DESC" => Some(Self::CreateTimeDesc),
+            "CUSTOMER_SORT_CREATE_TIME_ASC" => Some(Self::CreateTimeAsc),
+            "CUSTOMER_SORT_USERNAME_ASC" => Some(Self::UsernameAsc),
+            "CUSTOMER_SORT_USERNAME_DESC" => Some(Self::UsernameDesc),
+            "CUSTOMER_SORT_LAST_ORDER_TIME_DESC" => Some(Self::LastOrderTimeDesc),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum CustomerOrdersFilter {
+    Any = 0,
+    WithOrders = 1,
+    WithoutOrders = 2,
+}
+impl CustomerOrdersFilter {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Any => "CUSTOMER_ORDERS_FILTER_ANY",
+            Self::WithOrders => "CUSTOMER_ORDERS_FILTER_WITH_ORDERS",
+            Self::WithoutOrders => "CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "CUSTOMER_ORDERS_FILTER_ANY" => Some(Self::Any),
+            "CUSTOMER_ORDERS_FILTER_WITH_ORDERS" => Some(Self::WithOrders),
+            "CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS" => Some(Self::WithoutOrders),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum DataExportState {
+    Unspecified = 0,
+    Pending = 1,
+    Running = 2,
+    Succeeded = 3,
+    Failed = 4,
+    /// The archive was deleted after expire_time.
+    Expired = 5,
+}
+impl DataExportState {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "DATA_EXPORT_STATE_UNSPECIFIED",
+            Self::Pending => "DATA_EXPORT_STATE_PENDING",
+            Self::Running => "DATA_EXPORT_STATE_RUNNING",
+            Self::Succeeded => "DATA_EXPORT_STATE_SUCCEEDED",
+            Self::Failed => "DATA_EXPORT_STATE_FAILED",
+            Self::Expired => "DATA_EXPORT_STATE_EXPIRED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "DATA_EXPORT_STATE_UNSPECIFIED" => Some(Self::Unspecified),
+            "DATA_EXPORT_STATE_PENDING" => Some(Self::Pending),
+            "DATA_EXPORT_STATE_RUNNING" => Some(Self::Running),
+            "DATA_EXPORT_STATE_SUCCEEDED" => Some(Self::Succeeded),
+            "DATA_EXPORT_STATE_FAILED" => Some(Self::Failed),
+            "DATA_EXPORT_STATE_EXPIRED" => Some(Self::Expired),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum LoyaltyTransactionType {
+    Unspecified = 0,
+    Earn = 1,
+    Redeem = 2,
+    /// Points given back when the order of a redemption was cancelled.
+    Reverse = 3,
+    Expire = 4,
+}
+impl LoyaltyTransactionType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "LOYALTY_TRANSACTION_TYPE_UNSPECIFIED",
+            Self::Earn => "LOYALTY_TRANSACTION_TYPE_EARN",
+            Self::Redeem => "LOYALTY_TRANSACTION_TYPE_REDEEM",
+            Self::Reverse => "LOYALTY_TRANSACTION_TYPE_REVERSE",
+            Self::Expire => "LOYALTY_TRANSACTION_TYPE_EXPIRE",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "LOYALTY_TRANSACTION_TYPE_UNSPECIFIED" => Some(Self::Unspecified),
+            "LOYALTY_TRANSACTION_TYPE_EARN" => Some(Self::Earn),
+            "LOYALTY_TRANSACTION_TYPE_REDEEM" => Some(Self::Redeem),
+            "LOYALTY_TRANSACTION_TYPE_REVERSE" => Some(Self::Reverse),
+            "LOYALTY_TRANSACTION_TYPE_EXPIRE" => Some(Self::Expire),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum WalletTransactionType {
+    Unspecified = 0,
+    TopUp = 1,
+    GiftCard = 2,
+    Payment = 3,
+    Refund = 4,
+    /// The balance of a guest moved to the customer it merged into.
+    Transfer = 5,
+    GiftCardIssue = 6,
+}
+impl WalletTransactionType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "WALLET_TRANSACTION_TYPE_UNSPECIFIED",
+            Self::TopUp => "WALLET_TRANSACTION_TYPE_TOP_UP",
+            Self::GiftCard => "WALLET_TRANSACTION_TYPE_GIFT_CARD",
+            Self::Payment => "WALLET_TRANSACTION_TYPE_PAYMENT",
+            Self::Refund => "WALLET_TRANSACTION_TYPE_REFUND",
+            Self::Transfer => "WALLET_TRANSACTION_TYPE_TRANSFER",
+            Self::GiftCardIssue => "WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "WALLET_TRANSACTION_TYPE_UNSPECIFIED" => Some(Self::Unspecified),
+            "WALLET_TRANSACTION_TYPE_TOP_UP" => Some(Self::TopUp),
+            "WALLET_TRANSACTION_TYPE_GIFT_CARD" => Some(Self::GiftCard),
+            "WALLET_TRANSACTION_TYPE_PAYMENT" => Some(Self::Payment),
+            "WALLET_TRANSACTION_TYPE_REFUND" => Some(Self::Refund),
+            "WALLET_TRANSACTION_TYPE_TRANSFER" => Some(Self::Transfer),
+            "WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE" => Some(Self::GiftCardIssue),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum NotificationChannel {
+    Unspecified = 0,
+    Push = 1,
+    Email = 2,
+    Sms = 3,
+    Line = 4,
+    /// The inbox of ListNotifications.
+    InApp = 5,
+}
+impl NotificationChannel {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "NOTIFICATION_CHANNEL_UNSPECIFIED",
+            Self::Push => "NOTIFICATION_CHANNEL_PUSH",
+            Self::Email => "NOTIFICATION_CHANNEL_EMAIL",
+            Self::Sms => "NOTIFICATION_CHANNEL_SMS",
+            Self::Line => "NOTIFICATION_CHANNEL_LINE",
+            Self::InApp => "NOTIFICATION_CHANNEL_IN_APP",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "NOTIFICATION_CHANNEL_UNSPECIFIED" => Some(Self::Unspecified),
+            "NOTIFICATION_CHANNEL_PUSH" => Some(Self::Push),
+            "NOTIFICATION_CHANNEL_EMAIL" => Some(Self::Email),
+            "NOTIFICATION_CHANNEL_SMS" => Some(Self::Sms),
+            "NOTIFICATION_CHANNEL_LINE" => Some(Self::Line),
+            "NOTIFICATION_CHANNEL_IN_APP" => Some(Self::InApp),
+            _ => None,
+        }
+    }
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum NotificationCategory {
+    Unspecified = 0,
+    /// Orders placed, delivered and cancelled.
+    OrderUpdates = 1,
+    /// New coupons.
+    Promotions = 2,
+}
+impl NotificationCategory {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "NOTIFICATION_CATEGORY_UNSPECIFIED",
+            Self::OrderUpdates => "NOTIFICATION_CATEGORY_ORDER_UPDATES",
+            Self::Promotions => "NOTIFICATION_CATEGORY_PROMOTIONS",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "NOTIFICATION_CATEGORY_UNSPECIFIED" => Some(Self::Unspecified),
+            "NOTIFICATION_CATEGORY_ORDER_UPDATES" => Some(Self::OrderUpdates),
+            "NOTIFICATION_CATEGORY_PROMOTIONS" => Some(Self::Promotions),
+            _ => None,
+        }
+    }
+}
+/// Generated client implementations.
+pub mod customer_service_client {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    use tonic::codegen::http::Uri;
+    /// ---------------------CUSTOMER SERVICE------------------------------
+    #[derive(Debug, Clone)]
+    pub struct CustomerServiceClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    impl CustomerServiceClient<tonic::transport::Channel> {
+        /// Attempt to create a new client by connecting to a given endpoint.
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> CustomerServiceClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::BoxBody>,
+        T::Error: Into<StdError>,
+        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
+        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_origin(inner: T, origin: Uri) -> Self {
+            let inner = tonic::client::Grpc::with_origin(inner, origin);
+            Self { inner }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> CustomerServiceClient<InterceptedService<T, F>>
+        where
+            F: tonic::service::Interceptor,
+            T::ResponseBody: Default,
+            T: tonic::codegen::Service<
+                http::Request<tonic::body::BoxBody>,
+                Response = http::Response<
+                    <T as tonic::client::GrpcService<tonic::body::BoxBody>>::ResponseBody,
+                >,
+            >,
+            <T as tonic::codegen::Service<
+                http::Request<tonic::body::BoxBody>,
+            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
+        {
+            CustomerServiceClient::new(InterceptedService::new(inner, interceptor))
+        }
+        /// Compress requests with the given encoding.
+        ///
+        /// This requires the server to support it otherwise it might respond with an
+        /// error.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.send_compressed(encoding);
+            self
+        }
+        /// Enable decompressing responses.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.accept_compressed(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_decoding_message_size(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_encoding_message_size(limit);
+            self
+        }
+        /// ListCustomers shows all customers profile.
+        pub async fn list_customers(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListCustomersRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCustomersResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ListCustomers",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListCustomers"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// GetCustomer shows a customer profile.
+        pub async fn get_customer(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetCustomerRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetCustomer",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetCustomer"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn create_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CreateAddressRequest>,
+        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/CreateAddress",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "CreateAddress"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn update_customer_info(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateCustomerInfoRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/UpdateCustomerInfo",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerInfo"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn update_customer_social(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateCustomerSocialRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/UpdateCustomerSocial",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerSocial"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn update_customer_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateCustomerAddressRequest>,
+        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/UpdateCustomerAddress",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("ihavefood.CustomerService", "UpdateCustomerAddress"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn delete_customer(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteCustomerRequest>,
+        ) -> std::result::Result<
+            tonic::Response<::prost_wkt_types::Empty>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/DeleteCustomer",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "DeleteCustomer"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn delete_customer_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteCustomerAddressRequest>,
+        ) -> std::result::Result<
+            tonic::Response<::prost_wkt_types::Empty>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/DeleteCustomerAddress",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("ihavefood.CustomerService", "DeleteCustomerAddress"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// SuggestAddresses completes a partial Thai address for address forms.
+        /// Addresses are written with the English names of the suggestions.
+        pub async fn suggest_addresses(
+            &mut self,
+            request: impl tonic::IntoRequest<super::SuggestAddressesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::SuggestAddressesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/SuggestAddresses",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("ihavefood.CustomerService", "SuggestAddresses"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// ListFavourites lists the favourite merchants and menu items of the
+        /// customer, newest first.
+        pub async fn list_favourites(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListFavouritesRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListFavouritesResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ListFavourites",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListFavourites"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn add_favourite_merchant(
+            &mut self,
+            request: impl tonic::IntoRequest<super::AddFavouriteMerchantRequest>,
+        ) -> std::result::Result<tonic::Response<super::FavouriteMerchant>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/AddFavouriteMerchant",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "AddFavouriteMerchant"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn remove_favourite_merchant(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RemoveFavouriteMerchantRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RemoveFavouriteMerchant",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RemoveFavouriteMerchant"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn add_favourite_menu_item(
+            &mut self,
+            request: impl tonic::IntoRequest<super::AddFavouriteMenuItemRequest>,
+        ) -> std::result::Result<tonic::Response<super::FavouriteMenuItem>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/AddFavouriteMenuItem",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "AddFavouriteMenuItem"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn remove_favourite_menu_item(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RemoveFavouriteMenuItemRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RemoveFavouriteMenuItem",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RemoveFavouriteMenuItem"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// RequestDataExport starts collecting the personal data of the customer
+        /// into a ZIP archive of JSON files. It returns the export in progress
+        /// when there is one.
+        pub async fn request_data_export(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RequestDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExport>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RequestDataExport",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RequestDataExport"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn get_data_export(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExport>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetDataExport",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetDataExport"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// DownloadDataExport returns the archive of a succeeded export until it
+        /// expires.
+        pub async fn download_data_export(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DownloadDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExportArchive>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/DownloadDataExport",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "DownloadDataExport"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// GetLoyaltyBalance returns the points the customer can redeem. Points are
+        /// earned on delivered orders and expire a while after they were earned.
+        pub async fn get_loyalty_balance(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetLoyaltyBalanceRequest>,
+        ) -> std::result::Result<tonic::Response<super::LoyaltyBalance>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetLoyaltyBalance",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetLoyaltyBalance"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// ListLoyaltyTransactions lists the points ledger of the customer, newest
+        /// first.
+        pub async fn list_loyalty_transactions(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListLoyaltyTransactionsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListLoyaltyTransactionsResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ListLoyaltyTransactions",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListLoyaltyTransactions"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// RedeemLoyaltyPoints spends points for a discount on an order, points
+        /// expiring first are spent first. It is called by orders at checkout;
+        /// redeeming again for the same order returns the first redemption.
+        pub async fn redeem_loyalty_points(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RedeemLoyaltyPointsRequest>,
+        ) -> std::result::Result<tonic::Response<super::RedeemLoyaltyPointsResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RedeemLoyaltyPoints",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RedeemLoyaltyPoints"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// ReverseLoyaltyRedemption gives back the points redeemed for an order
+        /// that was cancelled. Orders without a redemption are ignored.
+        pub async fn reverse_loyalty_redemption(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ReverseLoyaltyRedemptionRequest>,
+        ) -> std::result::Result<tonic::Response<super::LoyaltyBalance>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ReverseLoyaltyRedemption",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ReverseLoyaltyRedemption"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// GetWallet returns the wallet balance of the customer, the sum of its
+        /// ledger entries.
+        pub async fn get_wallet(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::Wallet>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetWallet",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetWallet"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// ListWalletTransactions lists the transactions of the wallet, newest
+        /// first.
+        pub async fn list_wallet_transactions(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListWalletTransactionsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListWalletTransactionsResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ListWalletTransactions",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListWalletTransactions"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// RedeemGiftCard adds the amount of a gift card to the wallet. A gift card
+        /// is redeemed once.
+        pub async fn redeem_gift_card(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RedeemGiftCardRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RedeemGiftCard",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RedeemGiftCard"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// TopUpWallet adds money to the wallet. It is called once the payment
+        /// provider confirmed the charge.
+        pub async fn top_up_wallet(
+            &mut self,
+            request: impl tonic::IntoRequest<super::TopUpWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/TopUpWallet",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "TopUpWallet"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// IssueGiftCard creates a gift card for sale. The code is returned in
+        /// full and grants the amount to whoever redeems it first.
+        pub async fn issue_gift_card(
+            &mut self,
+            request: impl tonic::IntoRequest<super::IssueGiftCardRequest>,
+        ) -> std::result::Result<tonic::Response<super::GiftCard>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/IssueGiftCard",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "IssueGiftCard"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// PayWithWallet pays an order from the wallet. It is called by orders at
+        /// checkout, paying again for the same order returns the first payment.
+        pub async fn pay_with_wallet(
+            &mut self,
+            request: impl tonic::IntoRequest<super::PayWithWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/PayWithWallet",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "PayWithWallet"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// RefundToWallet refunds a cancelled order to the wallet, whatever the
+        /// order was paid with. An order is refunded once.
+        pub async fn refund_to_wallet(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RefundToWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/RefundToWallet",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "RefundToWallet"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// UploadCustomerPicture sets the profile picture of the customer. The
+        /// content is a JPEG or PNG image of at most 5 MiB. It is cropped square
+        /// and resized to the sizes of CustomerPicture, without its metadata.
+        pub async fn upload_customer_picture(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UploadCustomerPictureRequest>,
+        ) -> std::result::Result<tonic::Response<super::CustomerPicture>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/UploadCustomerPicture",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "UploadCustomerPicture"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn delete_customer_picture(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteCustomerPictureRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/DeleteCustomerPicture",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "DeleteCustomerPicture"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// GetNotificationPreferences returns whether the customer receives each
+        /// category of notifications on each channel, defaults included.
+        pub async fn get_notification_preferences(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetNotificationPreferencesRequest>,
+        ) -> std::result::Result<tonic::Response<super::NotificationPreferences>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetNotificationPreferences",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetNotificationPreferences"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// UpdateNotificationPreferences changes the listed preferences, the
+        /// others are left as they are.
+        pub async fn update_notification_preferences(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateNotificationPreferencesRequest>,
+        ) -> std::result::Result<tonic::Response<super::NotificationPreferences>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/UpdateNotificationPreferences",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "UpdateNotificationPreferences"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// ListNotifications lists the in-app inbox of the customer, newest first.
+        pub async fn list_notifications(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListNotificationsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListNotificationsResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/ListNotifications",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "ListNotifications"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// MarkNotificationsRead marks notifications of the inbox as read, all of
+        /// them when no IDs are given.
+        pub async fn mark_notifications_read(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MarkNotificationsReadRequest>,
+        ) -> std::result::Result<tonic::Response<super::UnreadNotificationCount>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/MarkNotificationsRead",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "MarkNotificationsRead"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn get_unread_notification_count(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetUnreadNotificationCountRequest>,
+        ) -> std::result::Result<tonic::Response<super::UnreadNotificationCount>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.CustomerService/GetUnreadNotificationCount",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.CustomerService", "GetUnreadNotificationCount"));
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod customer_service_server {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with CustomerServiceServer.
+    #[async_trait]
+    pub trait CustomerService: std::marker::Send + std::marker::Sync + 'static {
+        /// ListCustomers shows all customers profile.
+        async fn list_customers(
+            &self,
+            request: tonic::Request<super::ListCustomersRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCustomersResponse>,
+            tonic::Status,
+        >;
+        /// GetCustomer shows a customer profile.
+        async fn get_customer(
+            &self,
+            request: tonic::Request<super::GetCustomerRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
+        async fn create_address(
+            &self,
+            request: tonic::Request<super::CreateAddressRequest>,
+        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status>;
+        async fn update_customer_info(
+            &self,
+            request: tonic::Request<super::UpdateCustomerInfoRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
+        async fn update_customer_social(
+            &self,
+            request: tonic::Request<super::UpdateCustomerSocialRequest>,
+        ) -> std::result::Result<tonic::Response<super::Customer>, tonic::Status>;
+        async fn update_customer_address(
+            &self,
+            request: tonic::Request<super::UpdateCustomerAddressRequest>,
+        ) -> std::result::Result<tonic::Response<super::Address>, tonic::Status>;
+        async fn delete_customer(
+            &self,
+            request: tonic::Request<super::DeleteCustomerRequest>,
+        ) -> std::result::Result<
+            tonic::Response<::prost_wkt_types::Empty>,
+            tonic::Status,
+        >;
+        async fn delete_customer_address(
+            &self,
+            request: tonic::Request<super::DeleteCustomerAddressRequest>,
+        ) -> std::result::Result<
+            tonic::Response<::prost_wkt_types::Empty>,
+            tonic::Status,
+        >;
+        /// SuggestAddresses completes a partial Thai address for address forms.
+        /// Addresses are written with the English names of the suggestions.
+        async fn suggest_addresses(
+            &self,
+            request: tonic::Request<super::SuggestAddressesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::SuggestAddressesResponse>,
+            tonic::Status,
+        >;
+        /// ListFavourites lists the favourite merchants and menu items of the
+        /// customer, newest first.
+        async fn list_favourites(
+            &self,
+            request: tonic::Request<super::ListFavouritesRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListFavouritesResponse>, tonic::Status>;
+        async fn add_favourite_merchant(
+            &self,
+            request: tonic::Request<super::AddFavouriteMerchantRequest>,
+        ) -> std::result::Result<tonic::Response<super::FavouriteMerchant>, tonic::Status>;
+        async fn remove_favourite_merchant(
+            &self,
+            request: tonic::Request<super::RemoveFavouriteMerchantRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status>;
+        async fn add_favourite_menu_item(
+            &self,
+            request: tonic::Request<super::AddFavouriteMenuItemRequest>,
+        ) -> std::result::Result<tonic::Response<super::FavouriteMenuItem>, tonic::Status>;
+        async fn remove_favourite_menu_item(
+            &self,
+            request: tonic::Request<super::RemoveFavouriteMenuItemRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status>;
+        /// RequestDataExport starts collecting the personal data of the customer
+        /// into a ZIP archive of JSON files. It returns the export in progress
+        /// when there is one.
+        async fn request_data_export(
+            &self,
+            request: tonic::Request<super::RequestDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExport>, tonic::Status>;
+        async fn get_data_export(
+            &self,
+            request: tonic::Request<super::GetDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExport>, tonic::Status>;
+        /// DownloadDataExport returns the archive of a succeeded export until it
+        /// expires.
+        async fn download_data_export(
+            &self,
+            request: tonic::Request<super::DownloadDataExportRequest>,
+        ) -> std::result::Result<tonic::Response<super::DataExportArchive>, tonic::Status>;
+        /// GetLoyaltyBalance returns the points the customer can redeem. Points are
+        /// earned on delivered orders and expire a while after they were earned.
+        async fn get_loyalty_balance(
+            &self,
+            request: tonic::Request<super::GetLoyaltyBalanceRequest>,
+        ) -> std::result::Result<tonic::Response<super::LoyaltyBalance>, tonic::Status>;
+        /// ListLoyaltyTransactions lists the points ledger of the customer, newest
+        /// first.
+        async fn list_loyalty_transactions(
+            &self,
+            request: tonic::Request<super::ListLoyaltyTransactionsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListLoyaltyTransactionsResponse>, tonic::Status>;
+        /// RedeemLoyaltyPoints spends points for a discount on an order, points
+        /// expiring first are spent first. It is called by orders at checkout;
+        /// redeeming again for the same order returns the first redemption.
+        async fn redeem_loyalty_points(
+            &self,
+            request: tonic::Request<super::RedeemLoyaltyPointsRequest>,
+        ) -> std::result::Result<tonic::Response<super::RedeemLoyaltyPointsResponse>, tonic::Status>;
+        /// ReverseLoyaltyRedemption gives back the points redeemed for an order
+        /// that was cancelled. Orders without a redemption are ignored.
+        async fn reverse_loyalty_redemption(
+            &self,
+            request: tonic::Request<super::ReverseLoyaltyRedemptionRequest>,
+        ) -> std::result::Result<tonic::Response<super::LoyaltyBalance>, tonic::Status>;
+        /// GetWallet returns the wallet balance of the customer, the sum of its
+        /// ledger entries.
+        async fn get_wallet(
+            &self,
+            request: tonic::Request<super::GetWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::Wallet>, tonic::Status>;
+        /// ListWalletTransactions lists the transactions of the wallet, newest
+        /// first.
+        async fn list_wallet_transactions(
+            &self,
+            request: tonic::Request<super::ListWalletTransactionsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListWalletTransactionsResponse>, tonic::Status>;
+        /// RedeemGiftCard adds the amount of a gift card to the wallet. A gift card
+        /// is redeemed once.
+        async fn redeem_gift_card(
+            &self,
+            request: tonic::Request<super::RedeemGiftCardRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status>;
+        /// TopUpWallet adds money to the wallet. It is called once the payment
+        /// provider confirmed the charge.
+        async fn top_up_wallet(
+            &self,
+            request: tonic::Request<super::TopUpWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status>;
+        /// IssueGiftCard creates a gift card for sale. The code is returned in
+        /// full and grants the amount to whoever redeems it first.
+        async fn issue_gift_card(
+            &self,
+            request: tonic::Request<super::IssueGiftCardRequest>,
+        ) -> std::result::Result<tonic::Response<super::GiftCard>, tonic::Status>;
+        /// PayWithWallet pays an order from the wallet. It is called by orders at
+        /// checkout, paying again for the same order returns the first payment.
+        async fn pay_with_wallet(
+            &self,
+            request: tonic::Request<super::PayWithWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status>;
+        /// RefundToWallet refunds a cancelled order to the wallet, whatever the
+        /// order was paid with. An order is refunded once.
+        async fn refund_to_wallet(
+            &self,
+            request: tonic::Request<super::RefundToWalletRequest>,
+        ) -> std::result::Result<tonic::Response<super::WalletTransaction>, tonic::Status>;
+        /// UploadCustomerPicture sets the profile picture of the customer. The
+        /// content is a JPEG or PNG image of at most 5 MiB. It is cropped square
+        /// and resized to the sizes of CustomerPicture, without its metadata.
+        async fn upload_customer_picture(
+            &self,
+            request: tonic::Request<super::UploadCustomerPictureRequest>,
+        ) -> std::result::Result<tonic::Response<super::CustomerPicture>, tonic::Status>;
+        async fn delete_customer_picture(
+            &self,
+            request: tonic::Request<super::DeleteCustomerPictureRequest>,
+        ) -> std::result::Result<tonic::Response<::prost_wkt_types::Empty>, tonic::Status>;
+        /// GetNotificationPreferences returns whether the customer receives each
+        /// category of notifications on each channel, defaults included.
+        async fn get_notification_preferences(
+            &self,
+            request: tonic::Request<super::GetNotificationPreferencesRequest>,
+        ) -> std::result::Result<tonic::Response<super::NotificationPreferences>, tonic::Status>;
+        /// UpdateNotificationPreferences changes the listed preferences, the
+        /// others are left as they are.
+        async fn update_notification_preferences(
+            &self,
+            request: tonic::Request<super::UpdateNotificationPreferencesRequest>,
+        ) -> std::result::Result<tonic::Response<super::NotificationPreferences>, tonic::Status>;
+        /// ListNotifications lists the in-app inbox of the customer, newest first.
+        async fn list_notifications(
+            &self,
+            request: tonic::Request<super::ListNotificationsRequest>,
+        ) -> std::result::Result<tonic::Response<super::ListNotificationsResponse>, tonic::Status>;
+        /// MarkNotificationsRead marks notifications of the inbox as read, all of
+        /// them when no IDs are given.
+        async fn mark_notifications_read(
+            &self,
+            request: tonic::Request<super::MarkNotificationsReadRequest>,
+        ) -> std::result::Result<tonic::Response<super::UnreadNotificationCount>, tonic::Status>;
+        async fn get_unread_notification_count(
+            &self,
+            request: tonic::Request<super::GetUnreadNotificationCountRequest>,
+        ) -> std::result::Result<tonic::Response<super::UnreadNotificationCount>, tonic::Status>;
+    }
+    /// ---------------------CUSTOMER SERVICE------------------------------
+    #[derive(Debug)]
+    pub struct CustomerServiceServer<T> {
+        inner: Arc<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    impl<T> CustomerServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.max_decoding_message_size = Some(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.max_encoding_message_size = Some(limit);
+            self
+        }
+    }
+    impl<T, B> tonic::codegen::Service<http::Request<B>> for CustomerServiceServer<T>
+    where
+        T: CustomerService,
+        B: Body + std::marker::Send + 'static,
+        B::Error: Into<StdError> + std::marker::Send + 'static,
+    {
+        type Response = http::Response<tonic::body::BoxBody>;
+        type Error = std::convert::Infallible;
+        type Future = BoxFuture<Self::Response, Self::Error>;
+        fn poll_ready(
+            &mut self,
+            _cx: &mut Context<'_>,
+        ) -> Poll<std::result::Result<(), Self::Error>> {
+            Poll::Ready(Ok(()))
+        }
+        fn call(&mut self, req: http::Request<B>) -> Self::Future {
+            match req.uri().path() {
+                "/ihavefood.CustomerService/ListCustomers" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListCustomersSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::ListCustomersRequest>
+                    for ListCustomersSvc<T> {
+                        type Response = super::ListCustomersResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListCustomersRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::list_customers(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListCustomersSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/GetCustomer" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetCustomerSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::GetCustomerRequest>
+                    for GetCustomerSvc<T> {
+                        type Response = super::Customer;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetCustomerRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::get_customer(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetCustomerSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/CreateAddress" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateAddressSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::CreateAddressRequest>
+                    for CreateAddressSvc<T> {
+                        type Response = super::Address;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CreateAddressRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::create_address(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = CreateAddressSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/UpdateCustomerInfo" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateCustomerInfoSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::UpdateCustomerInfoRequest>
+                    for UpdateCustomerInfoSvc<T> {
+                        type Response = super::Customer;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateCustomerInfoRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::update_customer_info(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateCustomerInfoSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/UpdateCustomerSocial" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateCustomerSocialSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::UpdateCustomerSocialRequest>
+                    for UpdateCustomerSocialSvc<T> {
+                        type Response = super::Customer;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateCustomerSocialRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::update_customer_social(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateCustomerSocialSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/UpdateCustomerAddress" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateCustomerAddressSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::UpdateCustomerAddressRequest>
+                    for UpdateCustomerAddressSvc<T> {
+                        type Response = super::Address;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateCustomerAddressRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::update_customer_address(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateCustomerAddressSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/DeleteCustomer" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteCustomerSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::DeleteCustomerRequest>
+                    for DeleteCustomerSvc<T> {
+                        type Response = ::prost_wkt_types::Empty;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteCustomerRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::delete_customer(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = DeleteCustomerSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/DeleteCustomerAddress" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteCustomerAddressSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::DeleteCustomerAddressRequest>
+                    for DeleteCustomerAddressSvc<T> {
+                        type Response = ::prost_wkt_types::Empty;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteCustomerAddressRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::delete_customer_address(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = DeleteCustomerAddressSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/SuggestAddresses" => {
+                    #[allow(non_camel_case_types)]
+                    struct SuggestAddressesSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::SuggestAddressesRequest>
+                    for SuggestAddressesSvc<T> {
+                        type Response = super::SuggestAddressesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::SuggestAddressesRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::suggest_addresses(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = SuggestAddressesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/ListFavourites" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListFavouritesSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::ListFavouritesRequest>
+                    for ListFavouritesSvc<T> {
+                        type Response = super::ListFavouritesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListFavouritesRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::list_favourites(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListFavouritesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/AddFavouriteMerchant" => {
+                    #[allow(non_camel_case_types)]
+                    struct AddFavouriteMerchantSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::AddFavouriteMerchantRequest>
+                    for AddFavouriteMerchantSvc<T> {
+                        type Response = super::FavouriteMerchant;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::AddFavouriteMerchantRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::add_favourite_merchant(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = AddFavouriteMerchantSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RemoveFavouriteMerchant" => {
+                    #[allow(non_camel_case_types)]
+                    struct RemoveFavouriteMerchantSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::RemoveFavouriteMerchantRequest>
+                    for RemoveFavouriteMerchantSvc<T> {
+                        type Response = ::prost_wkt_types::Empty;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RemoveFavouriteMerchantRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::remove_favourite_merchant(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RemoveFavouriteMerchantSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/AddFavouriteMenuItem" => {
+                    #[allow(non_camel_case_types)]
+                    struct AddFavouriteMenuItemSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::AddFavouriteMenuItemRequest>
+                    for AddFavouriteMenuItemSvc<T> {
+                        type Response = super::FavouriteMenuItem;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::AddFavouriteMenuItemRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::add_favourite_menu_item(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = AddFavouriteMenuItemSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RemoveFavouriteMenuItem" => {
+                    #[allow(non_camel_case_types)]
+                    struct RemoveFavouriteMenuItemSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::RemoveFavouriteMenuItemRequest>
+                    for RemoveFavouriteMenuItemSvc<T> {
+                        type Response = ::prost_wkt_types::Empty;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RemoveFavouriteMenuItemRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::remove_favourite_menu_item(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RemoveFavouriteMenuItemSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RequestDataExport" => {
+                    #[allow(non_camel_case_types)]
+                    struct RequestDataExportSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::RequestDataExportRequest>
+                    for RequestDataExportSvc<T> {
+                        type Response = super::DataExport;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RequestDataExportRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::request_data_export(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RequestDataExportSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/GetDataExport" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetDataExportSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::GetDataExportRequest>
+                    for GetDataExportSvc<T> {
+                        type Response = super::DataExport;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetDataExportRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::get_data_export(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetDataExportSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/DownloadDataExport" => {
+                    #[allow(non_camel_case_types)]
+                    struct DownloadDataExportSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::DownloadDataExportRequest>
+                    for DownloadDataExportSvc<T> {
+                        type Response = super::DataExportArchive;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DownloadDataExportRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::download_data_export(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = DownloadDataExportSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/GetLoyaltyBalance" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetLoyaltyBalanceSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::GetLoyaltyBalanceRequest>
+                    for GetLoyaltyBalanceSvc<T> {
+                        type Response = super::LoyaltyBalance;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetLoyaltyBalanceRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::get_loyalty_balance(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetLoyaltyBalanceSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/ListLoyaltyTransactions" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListLoyaltyTransactionsSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::ListLoyaltyTransactionsRequest>
+                    for ListLoyaltyTransactionsSvc<T> {
+                        type Response = super::ListLoyaltyTransactionsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListLoyaltyTransactionsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::list_loyalty_transactions(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListLoyaltyTransactionsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RedeemLoyaltyPoints" => {
+                    #[allow(non_camel_case_types)]
+                    struct RedeemLoyaltyPointsSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::RedeemLoyaltyPointsRequest>
+                    for RedeemLoyaltyPointsSvc<T> {
+                        type Response = super::RedeemLoyaltyPointsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RedeemLoyaltyPointsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::redeem_loyalty_points(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RedeemLoyaltyPointsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/ReverseLoyaltyRedemption" => {
+                    #[allow(non_camel_case_types)]
+                    struct ReverseLoyaltyRedemptionSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::ReverseLoyaltyRedemptionRequest>
+                    for ReverseLoyaltyRedemptionSvc<T> {
+                        type Response = super::LoyaltyBalance;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ReverseLoyaltyRedemptionRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::reverse_loyalty_redemption(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ReverseLoyaltyRedemptionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/GetWallet" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetWalletSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::GetWalletRequest>
+                    for GetWalletSvc<T> {
+                        type Response = super::Wallet;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetWalletRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::get_wallet(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetWalletSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/ListWalletTransactions" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListWalletTransactionsSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::ListWalletTransactionsRequest>
+                    for ListWalletTransactionsSvc<T> {
+                        type Response = super::ListWalletTransactionsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListWalletTransactionsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::list_wallet_transactions(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListWalletTransactionsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RedeemGiftCard" => {
+                    #[allow(non_camel_case_types)]
+                    struct RedeemGiftCardSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::RedeemGiftCardRequest>
+                    for RedeemGiftCardSvc<T> {
+                        type Response = super::WalletTransaction;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RedeemGiftCardRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::redeem_gift_card(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RedeemGiftCardSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/TopUpWallet" => {
+                    #[allow(non_camel_case_types)]
+                    struct TopUpWalletSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::TopUpWalletRequest>
+                    for TopUpWalletSvc<T> {
+                        type Response = super::WalletTransaction;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::TopUpWalletRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::top_up_wallet(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = TopUpWalletSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/IssueGiftCard" => {
+                    #[allow(non_camel_case_types)]
+                    struct IssueGiftCardSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::IssueGiftCardRequest>
+                    for IssueGiftCardSvc<T> {
+                        type Response = super::GiftCard;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::IssueGiftCardRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::issue_gift_card(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = IssueGiftCardSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/PayWithWallet" => {
+                    #[allow(non_camel_case_types)]
+                    struct PayWithWalletSvc<T: CustomerService>(pub Arc<T>);
+                    impl<
+                        T: CustomerService,
+                    > tonic::server::UnaryService<super::PayWithWalletRequest>
+                    for PayWithWalletSvc<T> {
+                        type Response = super::WalletTransaction;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::PayWithWalletRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CustomerService>::pay_with_wallet(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = PayWithWalletSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/ihavefood.CustomerService/RefundToWallet" => {
                     #[allow(non_camel_case_types)]
-                    struct ListCustomersSvc<T: CustomerService>(pub Arc<T>);
+                    struct RefundToWalletSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::ListCustomersRequest>
-                    for ListCustomersSvc<T> {
-                        type Response = super::ListCustomersResponse;
+                    > tonic::server::UnaryService<super::RefundToWalletRequest>
+                    for RefundToWalletSvc<T> {
+                        type Response = super::WalletTransaction;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::ListCustomersRequest>,
+                            request: tonic::Request<super::RefundToWalletRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::list_customers(&inner, request)
+                                <T as CustomerService>::refund_to_wallet(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2264,7 +5117,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = ListCustomersSvc(inner);
+                        let method = RefundToWalletSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2280,25 +5133,26 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/GetCustomer" => {
+                "/ihavefood.CustomerService/UploadCustomerPicture" => {
                     #[allow(non_camel_case_types)]
-                    struct GetCustomerSvc<T: CustomerService>(pub Arc<T>);
+                    struct UploadCustomerPictureSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::GetCustomerRequest>
-                    for GetCustomerSvc<T> {
-                        type Response = super::Customer;
+                    > tonic::server::UnaryService<super::UploadCustomerPictureRequest>
+                    for UploadCustomerPictureSvc<T> {
+                        type Response = super::CustomerPicture;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetCustomerRequest>,
+                            request: tonic::Request<super::UploadCustomerPictureRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::get_customer(&inner, request).await
+                                <T as CustomerService>::upload_customer_picture(&inner, request)
+                                    .await
                             };
                             Box::pin(fut)
                         }
@@ -2309,7 +5163,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = GetCustomerSvc(inner);
+                        let method = UploadCustomerPictureSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2325,25 +5179,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/CreateAddress" => {
+                "/ihavefood.CustomerService/DeleteCustomerPicture" => {
                     #[allow(non_camel_case_types)]
-                    struct CreateAddressSvc<T: CustomerService>(pub Arc<T>);
+                    struct DeleteCustomerPictureSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::CreateAddressRequest>
-                    for CreateAddressSvc<T> {
-                        type Response = super::Address;
+                    > tonic::server::UnaryService<super::DeleteCustomerPictureRequest>
+                    for DeleteCustomerPictureSvc<T> {
+                        type Response = ::prost_wkt_types::Empty;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::CreateAddressRequest>,
+                            request: tonic::Request<super::DeleteCustomerPictureRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::create_address(&inner, request)
+                                <T as CustomerService>::delete_customer_picture(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2355,7 +5209,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = CreateAddressSvc(inner);
+                        let method = DeleteCustomerPictureSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2371,28 +5225,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/UpdateCustomerInfo" => {
+                "/ihavefood.CustomerService/GetNotificationPreferences" => {
                     #[allow(non_camel_case_types)]
-                    struct UpdateCustomerInfoSvc<T: CustomerService>(pub Arc<T>);
+                    struct GetNotificationPreferencesSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::UpdateCustomerInfoRequest>
-                    for UpdateCustomerInfoSvc<T> {
-                        type Response = super::Customer;
+                    > tonic::server::UnaryService<super::GetNotificationPreferencesRequest>
+                    for GetNotificationPreferencesSvc<T> {
+                        type Response = super::NotificationPreferences;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::UpdateCustomerInfoRequest>,
+                            request: tonic::Request<super::GetNotificationPreferencesRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::update_customer_info(
-                                        &inner,
-                                        request,
-                                    )
+                                <T as CustomerService>::get_notification_preferences(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2404,7 +5255,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = UpdateCustomerInfoSvc(inner);
+                        let method = GetNotificationPreferencesSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2420,28 +5271,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/UpdateCustomerSocial" => {
+                "/ihavefood.CustomerService/UpdateNotificationPreferences" => {
                     #[allow(non_camel_case_types)]
-                    struct UpdateCustomerSocialSvc<T: CustomerService>(pub Arc<T>);
+                    struct UpdateNotificationPreferencesSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::UpdateCustomerSocialRequest>
-                    for UpdateCustomerSocialSvc<T> {
-                        type Response = super::Customer;
+                    > tonic::server::UnaryService<super::UpdateNotificationPreferencesRequest>
+                    for UpdateNotificationPreferencesSvc<T> {
+                        type Response = super::NotificationPreferences;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::UpdateCustomerSocialRequest>,
+                            request: tonic::Request<super::UpdateNotificationPreferencesRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::update_customer_social(
-                                        &inner,
-                                        request,
-                                    )
+                                <T as CustomerService>::update_notification_preferences(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2453,7 +5301,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = UpdateCustomerSocialSvc(inner);
+                        let method = UpdateNotificationPreferencesSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2469,28 +5317,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/UpdateCustomerAddress" => {
+                "/ihavefood.CustomerService/ListNotifications" => {
                     #[allow(non_camel_case_types)]
-                    struct UpdateCustomerAddressSvc<T: CustomerService>(pub Arc<T>);
+                    struct ListNotificationsSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::UpdateCustomerAddressRequest>
-                    for UpdateCustomerAddressSvc<T> {
-                        type Response = super::Address;
+                    > tonic::server::UnaryService<super::ListNotificationsRequest>
+                    for ListNotificationsSvc<T> {
+                        type Response = super::ListNotificationsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::UpdateCustomerAddressRequest>,
+                            request: tonic::Request<super::ListNotificationsRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::update_customer_address(
-                                        &inner,
-                                        request,
-                                    )
+                                <T as CustomerService>::list_notifications(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2502,7 +5347,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = UpdateCustomerAddressSvc(inner);
+                        let method = ListNotificationsSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2518,25 +5363,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/DeleteCustomer" => {
+                "/ihavefood.CustomerService/MarkNotificationsRead" => {
                     #[allow(non_camel_case_types)]
-                    struct DeleteCustomerSvc<T: CustomerService>(pub Arc<T>);
+                    struct MarkNotificationsReadSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::DeleteCustomerRequest>
-                    for DeleteCustomerSvc<T> {
-                        type Response = ::prost_wkt_types::Empty;
+                    > tonic::server::UnaryService<super::MarkNotificationsReadRequest>
+                    for MarkNotificationsReadSvc<T> {
+                        type Response = super::UnreadNotificationCount;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::DeleteCustomerRequest>,
+                            request: tonic::Request<super::MarkNotificationsReadRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::delete_customer(&inner, request)
+                                <T as CustomerService>::mark_notifications_read(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2548,7 +5393,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = DeleteCustomerSvc(inner);
+                        let method = MarkNotificationsReadSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2564,28 +5409,25 @@ pub mod customer_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/ihavefood.CustomerService/DeleteCustomerAddress" => {
+                "/ihavefood.CustomerService/GetUnreadNotificationCount" => {
                     #[allow(non_camel_case_types)]
-                    struct DeleteCustomerAddressSvc<T: CustomerService>(pub Arc<T>);
+                    struct GetUnreadNotificationCountSvc<T: CustomerService>(pub Arc<T>);
                     impl<
                         T: CustomerService,
-                    > tonic::server::UnaryService<super::DeleteCustomerAddressRequest>
-                    for DeleteCustomerAddressSvc<T> {
-                        type Response = ::prost_wkt_types::Empty;
+                    > tonic::server::UnaryService<super::GetUnreadNotificationCountRequest>
+                    for GetUnreadNotificationCountSvc<T> {
+                        type Response = super::UnreadNotificationCount;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::DeleteCustomerAddressRequest>,
+                            request: tonic::Request<super::GetUnreadNotificationCountRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as CustomerService>::delete_customer_address(
-                                        &inner,
-                                        request,
-                                    )
+                                <T as CustomerService>::get_unread_notification_count(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -2597,7 +5439,7 @@ pub mod customer_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = DeleteCustomerAddressSvc(inner);
+                        let method = GetUnreadNotificationCountSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2687,6 +5529,11 @@ pub struct PlaceOrder {
     pub order_status: i32,
     #[prost(message, optional, tag = "16")]
     pub timestamps: ::core::option::Option<OrderEventTimestamps>,
+    #[prost(int32, tag = "17")]
+    pub points_redeemed: i32,
+    /// points_discount is the discount of the redeemed loyalty points.
+    #[prost(int32, tag = "18")]
+    pub points_discount: i32,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -2743,10 +5590,22 @@ pub struct CreatePlaceOrderRequest {
     pub coupon_code: ::prost::alloc::string::String,
     #[prost(int32, tag = "6")]
     pub discount: i32,
+    /// customer_address_id defaults to the default address of the customer.
     #[prost(string, tag = "7")]
     pub customer_address_id: ::prost::alloc::string::String,
     #[prost(enumeration = "PaymentMethods", tag = "8")]
     pub payment_methods: i32,
+    /// redeem_points are loyalty points to spend for a discount.
+    #[prost(int32, tag = "9")]
+    pub redeem_points: i32,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CancelPlaceOrderRequest {
+    #[prost(string, tag = "1")]
+    pub order_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub customer_id: ::prost::alloc::string::String,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
@@ -2756,6 +5615,8 @@ pub enum PaymentMethods {
     PaymentMethodCash = 1,
     PaymentMethodCreditCard = 2,
     PaymentMethodPromptPay = 3,
+    /// Paid from the customer wallet at checkout.
+    PaymentMethodWallet = 4,
 }
 impl PaymentMethods {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -2768,6 +5629,7 @@ impl PaymentMethods {
             Self::PaymentMethodCash => "PAYMENT_METHOD_CASH",
             Self::PaymentMethodCreditCard => "PAYMENT_METHOD_CREDIT_CARD",
             Self::PaymentMethodPromptPay => "PAYMENT_METHOD_PROMPT_PAY",
+            Self::PaymentMethodWallet => "PAYMENT_METHOD_WALLET",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -2777,6 +5639,7 @@ impl PaymentMethods {
             "PAYMENT_METHOD_CASH" => Some(Self::PaymentMethodCash),
             "PAYMENT_METHOD_CREDIT_CARD" => Some(Self::PaymentMethodCreditCard),
             "PAYMENT_METHOD_PROMPT_PAY" => Some(Self::PaymentMethodPromptPay),
+            "PAYMENT_METHOD_WALLET" => Some(Self::PaymentMethodWallet),
             _ => None,
         }
     }
@@ -2995,6 +5858,29 @@ pub mod order_service_client {
                 .insert(GrpcMethod::new("ihavefood.OrderService", "CreatePlaceOrder"));
             self.inner.unary(req, path, codec).await
         }
+        /// CancelPlaceOrder cancels an order the merchant has not accepted yet.
+        /// Redeemed loyalty points are given back.
+        pub async fn cancel_place_order(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CancelPlaceOrderRequest>,
+        ) -> std::result::Result<tonic::Response<super::PlaceOrder>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/ihavefood.OrderService/CancelPlaceOrder",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("ihavefood.OrderService", "CancelPlaceOrder"));
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -3022,6 +5908,12 @@ pub mod order_service_server {
             &self,
             request: tonic::Request<super::CreatePlaceOrderRequest>,
         ) -> std::result::Result<tonic::Response<super::PlaceOrder>, tonic::Status>;
+        /// CancelPlaceOrder cancels an order the merchant has not accepted yet.
+        /// Redeemed loyalty points are given back.
+        async fn cancel_place_order(
+            &self,
+            request: tonic::Request<super::CancelPlaceOrderRequest>,
+        ) -> std::result::Result<tonic::Response<super::PlaceOrder>, tonic::Status>;
     }
     /// ---------------------ORDER SERVICE------------------------------
     /// Manages place order process.
@@ -3193,6 +6085,52 @@ pub mod order_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/ihavefood.OrderService/CancelPlaceOrder" => {
+                    #[allow(non_camel_case_types)]
+                    struct CancelPlaceOrderSvc<T: OrderService>(pub Arc<T>);
+                    impl<
+                        T: OrderService,
+                    > tonic::server::UnaryService<super::CancelPlaceOrderRequest>
+                    for CancelPlaceOrderSvc<T> {
+                        type Response = super::PlaceOrder;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CancelPlaceOrderRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as OrderService>::cancel_place_order(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = CancelPlaceOrderSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         let mut response = http::Response::new(empty_body());
@@ -3247,6 +6185,45 @@ pub struct MerchantAcceptedEvent {
     #[prost(message, optional, tag = "3")]
     pub accept_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
 }
+/// Routing key is "merchant.updated.event", published with the whole merchant
+/// after its profile or menu changed.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MerchantUpdatedEvent {
+    #[prost(message, optional, tag = "1")]
+    pub merchant: ::core::option::Option<Merchant>,
+    #[prost(message, optional, tag = "2")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+/// Routing key is "order.delivered.event", published by orders once the
+/// order is delivered.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct OrderDeliveredEvent {
+    #[prost(message, optional, tag = "1")]
+    pub order: ::core::option::Option<PlaceOrder>,
+    #[prost(message, optional, tag = "2")]
+    pub deliver_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+/// Routing key is "order.cancelled.event".
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct OrderCancelledEvent {
+    #[prost(message, optional, tag = "1")]
+    pub order: ::core::option::Option<PlaceOrder>,
+    #[prost(message, optional, tag = "2")]
+    pub cancel_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+/// Routing key is "coupon.added.event", published by coupons when a coupon
+/// is added.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CouponAddedEvent {
+    #[prost(message, optional, tag = "1")]
+    pub coupon: ::core::option::Option<Coupon>,
+    #[prost(message, optional, tag = "2")]
+    pub add_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct RiderNotifiedEvent {
@@ -3295,6 +6272,24 @@ pub struct SyncCustomerCreated {
     pub email: ::prost::alloc::string::String,
     #[prost(message, optional, tag = "4")]
     pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    /// Set for guests, who have no real email.
+    #[prost(string, tag = "5")]
+    pub phone_number: ::prost::alloc::string::String,
+    #[prost(bool, tag = "6")]
+    pub guest: bool,
+}
+/// Routing key is "sync.customer.merged". The orders and addresses of the
+/// guest source customer move to the target customer, and the source is
+/// removed.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncCustomerMerged {
+    #[prost(string, tag = "1")]
+    pub source_customer_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub target_customer_id: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub merge_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
 }
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -3316,6 +6311,129 @@ pub struct SyncMerchantCreated {
     #[prost(message, optional, tag = "4")]
     pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
 }
+/// Routing key is "sync.admin.created". Admins have no record in other
+/// services, the event is kept for the audit trail of admin accounts.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncAdminCreated {
+    #[prost(string, tag = "1")]
+    pub admin_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub email: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "3")]
+    pub role: i32,
+    #[prost(message, optional, tag = "4")]
+    pub create_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+    /// The super admin who created the account.
+    #[prost(string, tag = "5")]
+    pub created_by: ::prost::alloc::string::String,
+}
+/// Account changes made by admins. Routing keys are "sync.<role>.disabled",
+/// "sync.<role>.enabled" and "sync.<role>.deleted" where <role> is the
+/// lowercase role name, e.g. "sync.customer.deleted".
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncAccountStatusUpdated {
+    #[prost(string, tag = "1")]
+    pub auth_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "2")]
+    pub role: i32,
+    #[prost(bool, tag = "3")]
+    pub disabled: bool,
+    #[prost(message, optional, tag = "4")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncAccountRoleUpdated {
+    #[prost(string, tag = "1")]
+    pub auth_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub email: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "3")]
+    pub old_role: i32,
+    #[prost(enumeration = "Roles", tag = "4")]
+    pub new_role: i32,
+    #[prost(message, optional, tag = "5")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncAccountDeleted {
+    #[prost(string, tag = "1")]
+    pub auth_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "2")]
+    pub role: i32,
+    #[prost(message, optional, tag = "3")]
+    pub delete_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+/// Routing key is "sync.<role>.email.updated", e.g. "sync.customer.email.updated".
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncEmailUpdated {
+    #[prost(string, tag = "1")]
+    pub auth_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "2")]
+    pub role: i32,
+    #[prost(string, tag = "3")]
+    pub email: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "4")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+/// Routing key is "sync.<role>.phone_number.updated". phone_number is empty
+/// when the number was removed.
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SyncPhoneNumberUpdated {
+    #[prost(string, tag = "1")]
+    pub auth_id: ::prost::alloc::string::String,
+    #[prost(enumeration = "Roles", tag = "2")]
+    pub role: i32,
+    #[prost(string, tag = "3")]
+    pub phone_number: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "4")]
+    pub update_time: ::core::option::Option<::prost_wkt_types::Timestamp>,
+}
+#[derive(serde::Deserialize, serde::Serialize)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum Roles {
+    Unspecified = 0,
+    Customer = 1,
+    Rider = 2,
+    Merchant = 3,
+    /// For simplicity, admin roles are included in this enum.
+    SuperAdmin = 20,
+    Admin = 21,
+}
+impl Roles {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "ROLES_UNSPECIFIED",
+            Self::Customer => "ROLES_CUSTOMER",
+            Self::Rider => "ROLES_RIDER",
+            Self::Merchant => "ROLES_MERCHANT",
+            Self::SuperAdmin => "ROLES_SUPER_ADMIN",
+            Self::Admin => "ROLES_ADMIN",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "ROLES_UNSPECIFIED" => Some(Self::Unspecified),
+            "ROLES_CUSTOMER" => Some(Self::Customer),
+            "ROLES_RIDER" => Some(Self::Rider),
+            "ROLES_MERCHANT" => Some(Self::Merchant),
+            "ROLES_SUPER_ADMIN" => Some(Self::SuperAdmin),
+            "ROLES_ADMIN" => Some(Self::Admin),
+            _ => None,
+        }
+    }
+}
 /// naming from routing key
 #[derive(serde::Deserialize, serde::Serialize)]
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
@@ -168,6 +168,21 @@ impl EventDispatcher {
                                 return;
                             }
                         }
+                        "sync.rider.deleted" => {
+                            if let Err(e) = self_cloned.handle_rider_deleted(data.as_ref()).await {
+                                error!("failed to handle rider deleted: {}", e);
+                                return;
+                            }
+                        }
+                        "sync.rider.phone_number.updated" => {
+                            if let Err(e) = self_cloned
+                                .handle_rider_phone_number_updated(data.as_ref())
+                                .await
+                            {
+                                error!("failed to handle rider phone number updated: {}", e);
+                                return;
+                            }
+                        }
                         _ => {
                             error!("Error: unknown key {}", key.as_str());
                             return;
@@ -243,4 +258,27 @@ impl EventDispatcher {
 
         Ok(())
     }
+
+    // Anonymise the rider when the account is deleted in auth. Deliveries are
+    // kept since they are needed for payouts.
+    async fn handle_rider_deleted(&self, buf: impl Buf) -> Result<()> {
+        let deleted = SyncAccountDeleted::decode(buf)?;
+
+        self.db.anonymize_rider(&deleted.auth_id).await?;
+
+        info!("anonymized a deleted rider: {}", deleted.auth_id);
+        Ok(())
+    }
+
+    // Apply a phone number change made in auth, which owns the number.
+    async fn handle_rider_phone_number_updated(&self, buf: impl Buf) -> Result<()> {
+        let updated = SyncPhoneNumberUpdated::decode(buf)?;
+
+        self.db
+            .update_rider_phone_number(&updated.auth_id, &updated.phone_number)
+            .await?;
+
+        info!("updated rider phone number: {}", updated.auth_id);
+        Ok(())
+    }
 }
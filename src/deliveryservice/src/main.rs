@@ -149,6 +149,14 @@ async fn main() -> Result<()> {
                 queue: String::from(""),
                 key: String::from("sync.rider.created"),
             })
+            .add_event(EventHandler {
+                queue: String::from(""),
+                key: String::from("sync.rider.deleted"),
+            })
+            .add_event(EventHandler {
+                queue: String::from(""),
+                key: String::from("sync.rider.phone_number.updated"),
+            })
             .run()
             .await
     });
@@ -26,6 +26,52 @@ impl Db {
         Ok(())
     }
 
+    // Replace the rider's personal data, including the copies embedded in
+    // deliveries.
+    pub async fn anonymize_rider(&self, rider_id: &str) -> Result<()> {
+        let username = format!("deleted-{rider_id}");
+
+        self.rider_coll
+            .update_one(
+                doc! { "id": rider_id },
+                doc! { "$set": doc! {"username": &username, "phone_number": ""} },
+            )
+            .await?;
+
+        self.delivery_coll
+            .update_many(
+                doc! { "rider.id": rider_id },
+                doc! { "$set": doc! {"rider.username": &username, "rider.phone_number": ""} },
+            )
+            .await?;
+
+        Ok(())
+    }
+
+    // Apply a phone number change made in auth, including the copies embedded
+    // in deliveries.
+    pub async fn update_rider_phone_number(
+        &self,
+        rider_id: &str,
+        phone_number: &str,
+    ) -> Result<()> {
+        self.rider_coll
+            .update_one(
+                doc! { "id": rider_id },
+                doc! { "$set": doc! {"phone_number": phone_number} },
+            )
+            .await?;
+
+        self.delivery_coll
+            .update_many(
+                doc! { "rider.id": rider_id },
+                doc! { "$set": doc! {"rider.phone_number": phone_number} },
+            )
+            .await?;
+
+        Ok(())
+    }
+
     pub async fn get_delivery(&self, order_id: &str) -> Result<DbDelivery> {
         self.delivery_coll
             .find_one(doc! { "order_id":order_id })
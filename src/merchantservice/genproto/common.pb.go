@@ -23,12 +23,23 @@ const (
 )
 
 type NewAddress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressName   string                 `protobuf:"bytes,2,opt,name=address_name,json=addressName,proto3" json:"address_name,omitempty"`
-	SubDistrict   string                 `protobuf:"bytes,3,opt,name=sub_district,json=subDistrict,proto3" json:"sub_district,omitempty"`
-	District      string                 `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
-	Province      string                 `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AddressName string                 `protobuf:"bytes,2,opt,name=address_name,json=addressName,proto3" json:"address_name,omitempty"`
+	SubDistrict string                 `protobuf:"bytes,3,opt,name=sub_district,json=subDistrict,proto3" json:"sub_district,omitempty"`
+	District    string                 `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
+	Province    string                 `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode  string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// latitude and longitude of the pin dropped by the customer, in degrees.
+	// Both are 0 when the address has no pin.
+	Latitude  float64 `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// detail is the house number, building, floor or room.
+	Detail               string `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	Landmark             string `protobuf:"bytes,10,opt,name=landmark,proto3" json:"landmark,omitempty"`
+	DeliveryInstructions string `protobuf:"bytes,11,opt,name=delivery_instructions,json=deliveryInstructions,proto3" json:"delivery_instructions,omitempty"`
+	// is_default marks the address used when an order names none. A customer
+	// has at most one default address.
+	IsDefault     bool `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,16 +109,73 @@ func (x *NewAddress) GetPostalCode() string {
 	return ""
 }
 
+func (x *NewAddress) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NewAddress) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *NewAddress) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *NewAddress) GetLandmark() string {
+	if x != nil {
+		return x.Landmark
+	}
+	return ""
+}
+
+func (x *NewAddress) GetDeliveryInstructions() string {
+	if x != nil {
+		return x.DeliveryInstructions
+	}
+	return ""
+}
+
+func (x *NewAddress) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
 type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressId     string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	AddressName   string                 `protobuf:"bytes,2,opt,name=address_name,json=addressName,proto3" json:"address_name,omitempty"`
-	SubDistrict   string                 `protobuf:"bytes,3,opt,name=sub_district,json=subDistrict,proto3" json:"sub_district,omitempty"`
-	District      string                 `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
-	Province      string                 `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AddressId   string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	AddressName string                 `protobuf:"bytes,2,opt,name=address_name,json=addressName,proto3" json:"address_name,omitempty"`
+	SubDistrict string                 `protobuf:"bytes,3,opt,name=sub_district,json=subDistrict,proto3" json:"sub_district,omitempty"`
+	District    string                 `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
+	Province    string                 `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode  string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// latitude and longitude of the pin dropped by the customer, in degrees.
+	// Without a pin they are geocoded from the sub-district, see
+	// location_approximate. Both are 0 when the address has no location.
+	Latitude  float64 `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// detail is the house number, building, floor or room.
+	Detail               string `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	Landmark             string `protobuf:"bytes,10,opt,name=landmark,proto3" json:"landmark,omitempty"`
+	DeliveryInstructions string `protobuf:"bytes,11,opt,name=delivery_instructions,json=deliveryInstructions,proto3" json:"delivery_instructions,omitempty"`
+	// is_default marks the address used when an order names none. A customer
+	// has at most one default address.
+	IsDefault bool `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// location_approximate is set when latitude and longitude are geocoded
+	// from the address rather than pinned by the customer.
+	LocationApproximate bool `protobuf:"varint,13,opt,name=location_approximate,json=locationApproximate,proto3" json:"location_approximate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Address) Reset() {
@@ -182,6 +250,55 @@ func (x *Address) GetPostalCode() string {
 	return ""
 }
 
+func (x *Address) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Address) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Address) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Address) GetLandmark() string {
+	if x != nil {
+		return x.Landmark
+	}
+	return ""
+}
+
+func (x *Address) GetDeliveryInstructions() string {
+	if x != nil {
+		return x.DeliveryInstructions
+	}
+	return ""
+}
+
+func (x *Address) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Address) GetLocationApproximate() bool {
+	if x != nil {
+		return x.LocationApproximate
+	}
+	return false
+}
+
 type Social struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Facebook      string                 `protobuf:"bytes,1,opt,name=facebook,proto3" json:"facebook,omitempty"`
@@ -246,7 +363,7 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\tihavefood\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xab\x01\n" +
+	"\fcommon.proto\x12\tihavefood\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xed\x02\n" +
 	"\n" +
 	"NewAddress\x12!\n" +
 	"\faddress_name\x18\x02 \x01(\tR\vaddressName\x12!\n" +
@@ -254,7 +371,15 @@ const file_common_proto_rawDesc = "" +
 	"\bdistrict\x18\x04 \x01(\tR\bdistrict\x12\x1a\n" +
 	"\bprovince\x18\x05 \x01(\tR\bprovince\x12\x1f\n" +
 	"\vpostal_code\x18\x06 \x01(\tR\n" +
-	"postalCode\"\xc7\x01\n" +
+	"postalCode\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\x12\x1a\n" +
+	"\blandmark\x18\n" +
+	" \x01(\tR\blandmark\x123\n" +
+	"\x15delivery_instructions\x18\v \x01(\tR\x14deliveryInstructions\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\f \x01(\bR\tisDefault\"\xbc\x03\n" +
 	"\aAddress\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\tR\taddressId\x12!\n" +
@@ -263,7 +388,16 @@ const file_common_proto_rawDesc = "" +
 	"\bdistrict\x18\x04 \x01(\tR\bdistrict\x12\x1a\n" +
 	"\bprovince\x18\x05 \x01(\tR\bprovince\x12\x1f\n" +
 	"\vpostal_code\x18\x06 \x01(\tR\n" +
-	"postalCode\"V\n" +
+	"postalCode\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\x12\x1a\n" +
+	"\blandmark\x18\n" +
+	" \x01(\tR\blandmark\x123\n" +
+	"\x15delivery_instructions\x18\v \x01(\tR\x14deliveryInstructions\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\f \x01(\bR\tisDefault\x121\n" +
+	"\x14location_approximate\x18\r \x01(\bR\x13locationApproximate\"V\n" +
 	"\x06Social\x12\x1a\n" +
 	"\bfacebook\x18\x01 \x01(\tR\bfacebook\x12\x1c\n" +
 	"\tinstagram\x18\x02 \x01(\tR\tinstagram\x12\x12\n" +
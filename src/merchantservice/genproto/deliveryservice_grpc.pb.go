@@ -39,10 +39,9 @@ type DeliveryServiceClient interface {
 	// using merchant ID and customer address ID.
 	//
 	// Example:
+	//      GET /api/deliveries/fee?customer_id=1111&customer_address_id=2222&merchant_id=5555
 	//
-	//	    GET /api/deliveries/fee?customer_id=1111&customer_address_id=2222&merchant_id=5555
-	//
-	//	@deprecated
+	//  @deprecated
 	GetDeliveryFee(ctx context.Context, in *GetDeliveryFeeRequest, opts ...grpc.CallOption) (*GetDeliveryFeeResponse, error)
 	GetDeliveryEstimate(ctx context.Context, in *GetDeliveryEstimateRequest, opts ...grpc.CallOption) (*GetDeliveryEstimateResponse, error)
 	ReportDeliveryStatus(ctx context.Context, in *ReportDeliveryStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -118,10 +117,9 @@ type DeliveryServiceServer interface {
 	// using merchant ID and customer address ID.
 	//
 	// Example:
+	//      GET /api/deliveries/fee?customer_id=1111&customer_address_id=2222&merchant_id=5555
 	//
-	//	    GET /api/deliveries/fee?customer_id=1111&customer_address_id=2222&merchant_id=5555
-	//
-	//	@deprecated
+	//  @deprecated
 	GetDeliveryFee(context.Context, *GetDeliveryFeeRequest) (*GetDeliveryFeeResponse, error)
 	GetDeliveryEstimate(context.Context, *GetDeliveryEstimateRequest) (*GetDeliveryEstimateResponse, error)
 	ReportDeliveryStatus(context.Context, *ReportDeliveryStatusRequest) (*emptypb.Empty, error)
@@ -31,6 +31,8 @@ const (
 	PaymentMethods_PAYMENT_METHOD_CASH        PaymentMethods = 1
 	PaymentMethods_PAYMENT_METHOD_CREDIT_CARD PaymentMethods = 2
 	PaymentMethods_PAYMENT_METHOD_PROMPT_PAY  PaymentMethods = 3
+	// Paid from the customer wallet at checkout.
+	PaymentMethods_PAYMENT_METHOD_WALLET PaymentMethods = 4
 )
 
 // Enum value maps for PaymentMethods.
@@ -40,12 +42,14 @@ var (
 		1: "PAYMENT_METHOD_CASH",
 		2: "PAYMENT_METHOD_CREDIT_CARD",
 		3: "PAYMENT_METHOD_PROMPT_PAY",
+		4: "PAYMENT_METHOD_WALLET",
 	}
 	PaymentMethods_value = map[string]int32{
 		"PAYMENT_METHOD_UNSPECIFIED": 0,
 		"PAYMENT_METHOD_CASH":        1,
 		"PAYMENT_METHOD_CREDIT_CARD": 2,
 		"PAYMENT_METHOD_PROMPT_PAY":  3,
+		"PAYMENT_METHOD_WALLET":      4,
 	}
 )
 
@@ -208,8 +212,11 @@ type PlaceOrder struct {
 	PaymentStatus   PaymentStatus         `protobuf:"varint,14,opt,name=payment_status,json=paymentStatus,proto3,enum=ihavefood.PaymentStatus" json:"payment_status,omitempty"`
 	OrderStatus     OrderStatus           `protobuf:"varint,15,opt,name=order_status,json=orderStatus,proto3,enum=ihavefood.OrderStatus" json:"order_status,omitempty"`
 	Timestamps      *OrderEventTimestamps `protobuf:"bytes,16,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	PointsRedeemed  int32                 `protobuf:"varint,17,opt,name=points_redeemed,json=pointsRedeemed,proto3" json:"points_redeemed,omitempty"`
+	// points_discount is the discount of the redeemed loyalty points.
+	PointsDiscount int32 `protobuf:"varint,18,opt,name=points_discount,json=pointsDiscount,proto3" json:"points_discount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PlaceOrder) Reset() {
@@ -354,6 +361,20 @@ func (x *PlaceOrder) GetTimestamps() *OrderEventTimestamps {
 	return nil
 }
 
+func (x *PlaceOrder) GetPointsRedeemed() int32 {
+	if x != nil {
+		return x.PointsRedeemed
+	}
+	return 0
+}
+
+func (x *PlaceOrder) GetPointsDiscount() int32 {
+	if x != nil {
+		return x.PointsDiscount
+	}
+	return 0
+}
+
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
@@ -595,17 +616,20 @@ func (x *ListOrderHistoryResponse) GetPlaceOrders() []*PlaceOrder {
 }
 
 type CreatePlaceOrderRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	RequestId         string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	CustomerId        string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	MerchantId        string                 `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Items             []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	CouponCode        string                 `protobuf:"bytes,5,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
-	Discount          int32                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`
-	CustomerAddressId string                 `protobuf:"bytes,7,opt,name=customer_address_id,json=customerAddressId,proto3" json:"customer_address_id,omitempty"`
-	PaymentMethods    PaymentMethods         `protobuf:"varint,8,opt,name=payment_methods,json=paymentMethods,proto3,enum=ihavefood.PaymentMethods" json:"payment_methods,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RequestId  string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	MerchantId string                 `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Items      []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	CouponCode string                 `protobuf:"bytes,5,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	Discount   int32                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	// customer_address_id defaults to the default address of the customer.
+	CustomerAddressId string         `protobuf:"bytes,7,opt,name=customer_address_id,json=customerAddressId,proto3" json:"customer_address_id,omitempty"`
+	PaymentMethods    PaymentMethods `protobuf:"varint,8,opt,name=payment_methods,json=paymentMethods,proto3,enum=ihavefood.PaymentMethods" json:"payment_methods,omitempty"`
+	// redeem_points are loyalty points to spend for a discount.
+	RedeemPoints  int32 `protobuf:"varint,9,opt,name=redeem_points,json=redeemPoints,proto3" json:"redeem_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePlaceOrderRequest) Reset() {
@@ -694,11 +718,70 @@ func (x *CreatePlaceOrderRequest) GetPaymentMethods() PaymentMethods {
 	return PaymentMethods_PAYMENT_METHOD_UNSPECIFIED
 }
 
+func (x *CreatePlaceOrderRequest) GetRedeemPoints() int32 {
+	if x != nil {
+		return x.RedeemPoints
+	}
+	return 0
+}
+
+type CancelPlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPlaceOrderRequest) Reset() {
+	*x = CancelPlaceOrderRequest{}
+	mi := &file_orderservice_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPlaceOrderRequest) ProtoMessage() {}
+
+func (x *CancelPlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderservice_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orderservice_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelPlaceOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelPlaceOrderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
 var File_orderservice_proto protoreflect.FileDescriptor
 
 const file_orderservice_proto_rawDesc = "" +
 	"\n" +
-	"\x12orderservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\fcommon.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xdd\x05\n" +
+	"\x12orderservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\fcommon.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xaf\x06\n" +
 	"\n" +
 	"PlaceOrder\x12\x1d\n" +
 	"\n" +
@@ -723,7 +806,9 @@ const file_orderservice_proto_rawDesc = "" +
 	"\forder_status\x18\x0f \x01(\x0e2\x16.ihavefood.OrderStatusR\vorderStatus\x12?\n" +
 	"\n" +
 	"timestamps\x18\x10 \x01(\v2\x1f.ihavefood.OrderEventTimestampsR\n" +
-	"timestamps\"T\n" +
+	"timestamps\x12'\n" +
+	"\x0fpoints_redeemed\x18\x11 \x01(\x05R\x0epointsRedeemed\x12'\n" +
+	"\x0fpoints_discount\x18\x12 \x01(\x05R\x0epointsDiscount\"T\n" +
 	"\tOrderItem\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
@@ -740,7 +825,7 @@ const file_orderservice_proto_rawDesc = "" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId:;\x92A826{\"customer_id\":\"0cf361e1-4b44-483d-a159-54dabdf7e814\"}\"T\n" +
 	"\x18ListOrderHistoryResponse\x128\n" +
-	"\fplace_orders\x18\x01 \x03(\v2\x15.ihavefood.PlaceOrderR\vplaceOrders\"\xc4\x06\n" +
+	"\fplace_orders\x18\x01 \x03(\v2\x15.ihavefood.PlaceOrderR\vplaceOrders\"\xe9\x06\n" +
 	"\x17CreatePlaceOrderRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1f\n" +
@@ -753,12 +838,18 @@ const file_orderservice_proto_rawDesc = "" +
 	"couponCode\x12\x1a\n" +
 	"\bdiscount\x18\x06 \x01(\x05R\bdiscount\x12.\n" +
 	"\x13customer_address_id\x18\a \x01(\tR\x11customerAddressId\x12B\n" +
-	"\x0fpayment_methods\x18\b \x01(\x0e2\x19.ihavefood.PaymentMethodsR\x0epaymentMethods:\xea\x03\x92A\xe6\x032\xe3\x03{\"request_id\":\"cee942b9-5ae7-4511-9717-5e6a9d10ece2\",\"customer_id\":\"f7407f59-3904-495b-b7d1-c15dbbb723e3\",\"merchant_id\":\"422fe523-b6a0-4ff0-8cb5-aa6c65c29285\",\"items\":[{\"item_id\":\"874ae56c-730a-43cd-a0d6-96e8f0ccdad7\",\"quantity\":2,\"note\":\"no spice\"},{\"item_id\":\"28cf88d0-a31c-41b7-8c0e-e85e6344a99d\",\"quantity\":1,\"note\":\"extra egg\"}],\"coupon_code\":\"DISCOUNT10\",\"discount\":10,\"customer_address_id\":\"c912f252-4ceb-49e8-82eb-63ea6a61b8a2\",\"payment_methods\":\"PAYMENT_METHOD_CREDIT_CARD\"}*\x88\x01\n" +
+	"\x0fpayment_methods\x18\b \x01(\x0e2\x19.ihavefood.PaymentMethodsR\x0epaymentMethods\x12#\n" +
+	"\rredeem_points\x18\t \x01(\x05R\fredeemPoints:\xea\x03\x92A\xe6\x032\xe3\x03{\"request_id\":\"cee942b9-5ae7-4511-9717-5e6a9d10ece2\",\"customer_id\":\"f7407f59-3904-495b-b7d1-c15dbbb723e3\",\"merchant_id\":\"422fe523-b6a0-4ff0-8cb5-aa6c65c29285\",\"items\":[{\"item_id\":\"874ae56c-730a-43cd-a0d6-96e8f0ccdad7\",\"quantity\":2,\"note\":\"no spice\"},{\"item_id\":\"28cf88d0-a31c-41b7-8c0e-e85e6344a99d\",\"quantity\":1,\"note\":\"extra egg\"}],\"coupon_code\":\"DISCOUNT10\",\"discount\":10,\"customer_address_id\":\"c912f252-4ceb-49e8-82eb-63ea6a61b8a2\",\"payment_methods\":\"PAYMENT_METHOD_CREDIT_CARD\"}\"U\n" +
+	"\x17CancelPlaceOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId*\xa3\x01\n" +
 	"\x0ePaymentMethods\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CASH\x10\x01\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x02\x12\x1d\n" +
-	"\x19PAYMENT_METHOD_PROMPT_PAY\x10\x03*d\n" +
+	"\x19PAYMENT_METHOD_PROMPT_PAY\x10\x03\x12\x19\n" +
+	"\x15PAYMENT_METHOD_WALLET\x10\x04*d\n" +
 	"\rPaymentStatus\x12\x1e\n" +
 	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x17\n" +
@@ -771,10 +862,11 @@ const file_orderservice_proto_rawDesc = "" +
 	"\x1cORDER_STATUS_WAIT_FOR_PICKUP\x10\x04\x12\x18\n" +
 	"\x14ORDER_STATUS_ONGOING\x10\x05\x12\x1a\n" +
 	"\x16ORDER_STATUS_DELIVERED\x10\x06\x12\x1a\n" +
-	"\x16ORDER_STATUS_CANCELLED\x10\a2\x81\x02\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\a2\xfa\x02\n" +
 	"\fOrderService\x12~\n" +
 	"\x10ListOrderHistory\x12\".ihavefood.ListOrderHistoryRequest\x1a#.ihavefood.ListOrderHistoryResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/orders/{customer_id}\x12q\n" +
-	"\x10CreatePlaceOrder\x12\".ihavefood.CreatePlaceOrderRequest\x1a\x15.ihavefood.PlaceOrder\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/orders/place_orderB\vZ\t/genprotob\x06proto3"
+	"\x10CreatePlaceOrder\x12\".ihavefood.CreatePlaceOrderRequest\x1a\x15.ihavefood.PlaceOrder\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/orders/place_order\x12w\n" +
+	"\x10CancelPlaceOrder\x12\".ihavefood.CancelPlaceOrderRequest\x1a\x15.ihavefood.PlaceOrder\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/orders/{order_id}/cancelB\vZ\t/genprotob\x06proto3"
 
 var (
 	file_orderservice_proto_rawDescOnce sync.Once
@@ -789,7 +881,7 @@ func file_orderservice_proto_rawDescGZIP() []byte {
 }
 
 var file_orderservice_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_orderservice_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_orderservice_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_orderservice_proto_goTypes = []any{
 	(PaymentMethods)(0),              // 0: ihavefood.PaymentMethods
 	(PaymentStatus)(0),               // 1: ihavefood.PaymentStatus
@@ -800,33 +892,36 @@ var file_orderservice_proto_goTypes = []any{
 	(*ListOrderHistoryRequest)(nil),  // 6: ihavefood.ListOrderHistoryRequest
 	(*ListOrderHistoryResponse)(nil), // 7: ihavefood.ListOrderHistoryResponse
 	(*CreatePlaceOrderRequest)(nil),  // 8: ihavefood.CreatePlaceOrderRequest
-	(*Address)(nil),                  // 9: ihavefood.Address
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(*CancelPlaceOrderRequest)(nil),  // 9: ihavefood.CancelPlaceOrderRequest
+	(*Address)(nil),                  // 10: ihavefood.Address
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
 }
 var file_orderservice_proto_depIdxs = []int32{
 	4,  // 0: ihavefood.PlaceOrder.items:type_name -> ihavefood.OrderItem
-	9,  // 1: ihavefood.PlaceOrder.customer_address:type_name -> ihavefood.Address
-	9,  // 2: ihavefood.PlaceOrder.merchant_address:type_name -> ihavefood.Address
+	10, // 1: ihavefood.PlaceOrder.customer_address:type_name -> ihavefood.Address
+	10, // 2: ihavefood.PlaceOrder.merchant_address:type_name -> ihavefood.Address
 	0,  // 3: ihavefood.PlaceOrder.payment_methods:type_name -> ihavefood.PaymentMethods
 	1,  // 4: ihavefood.PlaceOrder.payment_status:type_name -> ihavefood.PaymentStatus
 	2,  // 5: ihavefood.PlaceOrder.order_status:type_name -> ihavefood.OrderStatus
 	5,  // 6: ihavefood.PlaceOrder.timestamps:type_name -> ihavefood.OrderEventTimestamps
-	10, // 7: ihavefood.OrderEventTimestamps.order_placed_time:type_name -> google.protobuf.Timestamp
-	10, // 8: ihavefood.OrderEventTimestamps.merchant_accept_time:type_name -> google.protobuf.Timestamp
-	10, // 9: ihavefood.OrderEventTimestamps.rider_notified_time:type_name -> google.protobuf.Timestamp
-	10, // 10: ihavefood.OrderEventTimestamps.rider_assigned_time:type_name -> google.protobuf.Timestamp
-	10, // 11: ihavefood.OrderEventTimestamps.rider_picked_up_time:type_name -> google.protobuf.Timestamp
-	10, // 12: ihavefood.OrderEventTimestamps.delivered_time:type_name -> google.protobuf.Timestamp
-	10, // 13: ihavefood.OrderEventTimestamps.cancelled_time:type_name -> google.protobuf.Timestamp
+	11, // 7: ihavefood.OrderEventTimestamps.order_placed_time:type_name -> google.protobuf.Timestamp
+	11, // 8: ihavefood.OrderEventTimestamps.merchant_accept_time:type_name -> google.protobuf.Timestamp
+	11, // 9: ihavefood.OrderEventTimestamps.rider_notified_time:type_name -> google.protobuf.Timestamp
+	11, // 10: ihavefood.OrderEventTimestamps.rider_assigned_time:type_name -> google.protobuf.Timestamp
+	11, // 11: ihavefood.OrderEventTimestamps.rider_picked_up_time:type_name -> google.protobuf.Timestamp
+	11, // 12: ihavefood.OrderEventTimestamps.delivered_time:type_name -> google.protobuf.Timestamp
+	11, // 13: ihavefood.OrderEventTimestamps.cancelled_time:type_name -> google.protobuf.Timestamp
 	3,  // 14: ihavefood.ListOrderHistoryResponse.place_orders:type_name -> ihavefood.PlaceOrder
 	4,  // 15: ihavefood.CreatePlaceOrderRequest.items:type_name -> ihavefood.OrderItem
 	0,  // 16: ihavefood.CreatePlaceOrderRequest.payment_methods:type_name -> ihavefood.PaymentMethods
 	6,  // 17: ihavefood.OrderService.ListOrderHistory:input_type -> ihavefood.ListOrderHistoryRequest
 	8,  // 18: ihavefood.OrderService.CreatePlaceOrder:input_type -> ihavefood.CreatePlaceOrderRequest
-	7,  // 19: ihavefood.OrderService.ListOrderHistory:output_type -> ihavefood.ListOrderHistoryResponse
-	3,  // 20: ihavefood.OrderService.CreatePlaceOrder:output_type -> ihavefood.PlaceOrder
-	19, // [19:21] is the sub-list for method output_type
-	17, // [17:19] is the sub-list for method input_type
+	9,  // 19: ihavefood.OrderService.CancelPlaceOrder:input_type -> ihavefood.CancelPlaceOrderRequest
+	7,  // 20: ihavefood.OrderService.ListOrderHistory:output_type -> ihavefood.ListOrderHistoryResponse
+	3,  // 21: ihavefood.OrderService.CreatePlaceOrder:output_type -> ihavefood.PlaceOrder
+	3,  // 22: ihavefood.OrderService.CancelPlaceOrder:output_type -> ihavefood.PlaceOrder
+	20, // [20:23] is the sub-list for method output_type
+	17, // [17:20] is the sub-list for method input_type
 	17, // [17:17] is the sub-list for extension type_name
 	17, // [17:17] is the sub-list for extension extendee
 	0,  // [0:17] is the sub-list for field type_name
@@ -844,7 +939,7 @@ func file_orderservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orderservice_proto_rawDesc), len(file_orderservice_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
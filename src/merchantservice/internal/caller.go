@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/pongsathonn/ihavefood/src/merchantservice/genproto"
+)
+
+// callerFromContext returns the auth ID and role of the caller. The api-gateway
+// forwards them as "auth-id" and "auth-role" metadata after verifying the token.
+func callerFromContext(ctx context.Context) (authID string, role pb.Roles, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	ids, roles := md.Get("auth-id"), md.Get("auth-role")
+	if len(ids) == 0 || ids[0] == "" || len(roles) == 0 {
+		return "", pb.Roles_ROLES_UNSPECIFIED, false
+	}
+
+	return ids[0], pb.Roles(pb.Roles_value[roles[0]]), true
+}
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/pongsathonn/ihavefood/src/merchantservice/genproto"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -21,7 +23,10 @@ import (
 type MerchantStorage interface {
 	GetMerchant(ctx context.Context, merchantID string) (*DbMerchant, error)
 	ListMerchants(ctx context.Context) ([]*DbMerchant, error)
-	CreateMerchant(ctx context.Context, newMerchant *NewMerchant) (string, error)
+	CreateOwner(ctx context.Context, owner *NewMerchantOwner) (string, error)
+	AnonymizeOwner(ctx context.Context, merchantID string) error
+	UpdateOwnerEmail(ctx context.Context, merchantID, email string) error
+	CreateMerchant(ctx context.Context, merchantID string, newMerchant *NewMerchant) (string, error)
 	CreateMenu(ctx context.Context, merchantID string, menu []*DbMenuItem) ([]*DbMenuItem, error)
 	UpdateMenuItem(ctx context.Context, merchantID string, updateMenu *DbMenuItem) (*DbMenuItem, error)
 	MerchantExistsByName(ctx context.Context, name string) (bool, error)
@@ -76,11 +81,32 @@ func (x *MerchantService) GetMerchant(ctx context.Context, in *pb.GetMerchantReq
 	return DbToProto(merchant), nil
 }
 
+// CreateMerchant sets up the merchant linked to the calling owner. The owner
+// must have registered through auth with ROLES_MERCHANT.
 func (x *MerchantService) CreateMerchant(ctx context.Context, in *pb.CreateMerchantRequest) (*pb.Merchant, error) {
 
+	ownerID, role, ok := callerFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	if role != pb.Roles_ROLES_MERCHANT {
+		return nil, status.Error(codes.PermissionDenied, "only merchant owners can create a merchant")
+	}
+
 	var newMerchant *NewMerchant
-	id, err := x.Storage.CreateMerchant(ctx, newMerchant.FromProto(in))
+	newMerchant = newMerchant.FromProto(in)
+	if in.Status == pb.StoreStatus_STORE_STATUS_UNSPECIFIED {
+		newMerchant.Status = pb.StoreStatus_STORE_STATUS_CLOSED.String()
+	}
+
+	id, err := x.Storage.CreateMerchant(ctx, ownerID, newMerchant)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.FailedPrecondition, "merchant account not found or merchant already created")
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "merchant name already exists")
+		}
 		slog.Error("failed to create merchant", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
@@ -90,6 +116,7 @@ func (x *MerchantService) CreateMerchant(ctx context.Context, in *pb.CreateMerch
 		slog.Error("failed to get merchant after creation", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
+	x.publishMerchantUpdated(ctx, merchant)
 
 	return DbToProto(merchant), nil
 }
@@ -119,6 +146,7 @@ func (x *MerchantService) CreateMenu(ctx context.Context, in *pb.CreateMenuReque
 		slog.Error("storage create menu", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
+	x.refreshMerchant(ctx, uuid.String())
 
 	var menu []*pb.MenuItem
 	for _, v := range createdMenu {
@@ -141,6 +169,7 @@ func (x *MerchantService) UpdateMenuItem(ctx context.Context, in *pb.UpdateMenuI
 	}
 
 	updatedMenu, err := x.Storage.UpdateMenuItem(ctx, uuid.String(), &DbMenuItem{
+		ItemID:   in.ItemId,
 		FoodName: in.FoodName,
 		Price:    in.Price,
 	})
@@ -148,6 +177,7 @@ func (x *MerchantService) UpdateMenuItem(ctx context.Context, in *pb.UpdateMenuI
 		slog.Error("storage update menu item", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
+	x.refreshMerchant(ctx, uuid.String())
 
 	return &pb.MenuItem{
 		ItemId:   updatedMenu.ItemID,
@@ -164,6 +194,104 @@ func (x *MerchantService) GetStoreStatus(context.Context, *pb.GetStoreStatusRequ
 	return nil, status.Error(codes.Unimplemented, "method GetStoreStatus not implemented")
 }
 
+// HandleMerchantCreation links a newly registered merchant owner to a merchant record.
+func (x *MerchantService) HandleMerchantCreation(msg amqp.Delivery) error {
+
+	var newMerchant pb.SyncMerchantCreated
+	if err := proto.Unmarshal(msg.Body, &newMerchant); err != nil {
+		return err
+	}
+
+	parsed, err := uuid.Parse(newMerchant.MerchantId)
+	if err != nil {
+		slog.Error("invalid uuid", "err", err)
+		return err
+	}
+
+	merchantID, err := x.Storage.CreateOwner(context.TODO(), &NewMerchantOwner{
+		MerchantID: parsed.String(),
+		Email:      newMerchant.Email,
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("linked a new merchant owner", "merchantID", merchantID)
+	return nil
+}
+
+// HandleMerchantDeletion anonymises the merchant when the owner account is
+// deleted in auth. The store is unlisted but kept for order history.
+func (x *MerchantService) HandleMerchantDeletion(msg amqp.Delivery) error {
+
+	var deleted pb.SyncAccountDeleted
+	if err := proto.Unmarshal(msg.Body, &deleted); err != nil {
+		return err
+	}
+
+	if err := x.Storage.AnonymizeOwner(context.TODO(), deleted.AuthId); err != nil {
+		return err
+	}
+	x.refreshMerchant(context.TODO(), deleted.AuthId)
+
+	slog.Info("anonymized a deleted merchant owner", "merchantID", deleted.AuthId)
+	return nil
+}
+
+// HandleMerchantEmailUpdated applies an email change of the owner account
+// made in auth.
+func (x *MerchantService) HandleMerchantEmailUpdated(msg amqp.Delivery) error {
+
+	var updated pb.SyncEmailUpdated
+	if err := proto.Unmarshal(msg.Body, &updated); err != nil {
+		return err
+	}
+
+	if err := x.Storage.UpdateOwnerEmail(context.TODO(), updated.AuthId, updated.Email); err != nil {
+		return err
+	}
+
+	slog.Info("updated merchant owner email", "merchantID", updated.AuthId)
+	return nil
+}
+
+// refreshMerchant publishes the merchant as it is stored now.
+func (x *MerchantService) refreshMerchant(ctx context.Context, merchantID string) {
+
+	merchant, err := x.Storage.GetMerchant(ctx, merchantID)
+	if err != nil {
+		slog.Error("storage get merchant", "err", err, "merchantID", merchantID)
+		return
+	}
+	x.publishMerchantUpdated(ctx, merchant)
+}
+
+// publishMerchantUpdated lets services holding copies of the merchant name,
+// image or menu refresh them. The change is already stored, so a failed
+// publish is only logged; copies catch up with the next change.
+func (x *MerchantService) publishMerchantUpdated(ctx context.Context, merchant *DbMerchant) {
+
+	body, err := proto.Marshal(&pb.MerchantUpdatedEvent{
+		Merchant:   DbToProto(merchant),
+		UpdateTime: timestamppb.Now(),
+	})
+	if err != nil {
+		slog.Error("marshal merchant updated event", "err", err)
+		return
+	}
+
+	rk := "merchant.updated.event"
+	if err := x.rabbitmq.Publish(ctx, rk, amqp.Publishing{Body: body}); err != nil {
+		slog.Error("publish event", "err", err, "routingKey", rk)
+		return
+	}
+
+	slog.Info("published event",
+		"routingKey", rk,
+		"merchantId", merchant.ID,
+	)
+}
+
 // handlePlaceOrder will notify to merchant and waiting for merchant accept the order then publish "merchant.accepted.event"
 func (x *MerchantService) HandlePlaceOrder(msg amqp.Delivery) error {
 
@@ -29,6 +29,13 @@ type NewAddress struct {
 	PostalCode  string `json:"postalCode"`
 }
 
+// NewMerchantOwner is the merchant account synced from auth. Its ID is the
+// owner's auth ID and becomes the ID of the merchant record.
+type NewMerchantOwner struct {
+	MerchantID string
+	Email      string
+}
+
 type DbMerchant struct {
 	ID        string        `bson:"_id,omitempty"`
 	Name      string        `bson:"name"`
@@ -9,6 +9,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	pb "github.com/pongsathonn/ihavefood/src/merchantservice/genproto"
 )
 
 type merchantStorage struct {
@@ -19,6 +21,16 @@ func NewMerchantStorage(coll *mongo.Collection) MerchantStorage {
 	return &merchantStorage{coll: coll}
 }
 
+// CreateIndexes creates the indexes of the merchants collection. Store names
+// are unique.
+func CreateIndexes(ctx context.Context, coll *mongo.Collection) error {
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 func (s *merchantStorage) MerchantExistsByName(ctx context.Context, name string) (bool, error) {
 
 	filter := bson.M{"name": name}
@@ -41,7 +53,10 @@ func (s *merchantStorage) GetMerchant(ctx context.Context, merchantID string) (*
 
 func (s *merchantStorage) ListMerchants(ctx context.Context) ([]*DbMerchant, error) {
 
-	cursor, err := s.coll.Find(ctx, bson.D{})
+	// Skip merchant accounts whose owner has not created the merchant yet.
+	filter := bson.M{"status": bson.M{"$ne": pb.StoreStatus_STORE_STATUS_UNSPECIFIED.String()}}
+
+	cursor, err := s.coll.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -65,21 +80,70 @@ func (s *merchantStorage) ListMerchants(ctx context.Context) ([]*DbMerchant, err
 
 }
 
-func (s *merchantStorage) CreateMerchant(ctx context.Context, newMerchant *NewMerchant) (string, error) {
+// CreateOwner inserts the merchant record linked to a newly registered owner.
+// The record stays hidden from ListMerchants until CreateMerchant fills it.
+// Its placeholder name holds the whole merchant ID, as names are unique.
+func (s *merchantStorage) CreateOwner(ctx context.Context, owner *NewMerchantOwner) (string, error) {
 
-	var merchant DbMerchant
-	merchant.ID = uuid.New().String()
-	merchant.Name = newMerchant.Name
+	merchant := DbMerchant{
+		ID:     owner.MerchantID,
+		Name:   "merchant-" + owner.MerchantID,
+		Email:  owner.Email,
+		Status: pb.StoreStatus_STORE_STATUS_UNSPECIFIED.String(),
+	}
+
+	if _, err := s.coll.InsertOne(ctx, merchant); err != nil {
+		return "", err
+	}
+	return merchant.ID, nil
+}
+
+// AnonymizeOwner removes the owner's contact details and unlists the store.
+// Name and menu are kept since past orders refer to them.
+func (s *merchantStorage) AnonymizeOwner(ctx context.Context, merchantID string) error {
+
+	update := bson.M{
+		"$set": bson.M{
+			"email":  "",
+			"status": pb.StoreStatus_STORE_STATUS_UNSPECIFIED.String(),
+		},
+		"$unset": bson.M{"phone": ""},
+	}
+
+	if _, err := s.coll.UpdateByID(ctx, merchantID, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateOwnerEmail replaces the contact email of the merchant.
+func (s *merchantStorage) UpdateOwnerEmail(ctx context.Context, merchantID, email string) error {
+
+	update := bson.M{"$set": bson.M{"email": email}}
+
+	if _, err := s.coll.UpdateByID(ctx, merchantID, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateMerchant fills the merchant record linked to the owner. It returns
+// mongo.ErrNoDocuments if the owner has no record or it was already created.
+func (s *merchantStorage) CreateMerchant(ctx context.Context, merchantID string, newMerchant *NewMerchant) (string, error) {
+
+	var menu []*DbMenuItem
 	for _, item := range newMerchant.Menu {
-		merchant.Menu = append(merchant.Menu, &DbMenuItem{
+		menu = append(menu, &DbMenuItem{
 			ItemID:    uuid.New().String(),
 			FoodName:  item.FoodName,
 			Price:     item.Price,
 			ImageInfo: item.ImageInfo,
 		})
 	}
+
+	var address *DbAddress
 	if newMerchant.Address != nil {
-		merchant.Address = &DbAddress{
+		address = &DbAddress{
 			AddressID:   uuid.New().String(),
 			AddressName: newMerchant.Address.AddressName,
 			SubDistrict: newMerchant.Address.SubDistrict,
@@ -88,16 +152,32 @@ func (s *merchantStorage) CreateMerchant(ctx context.Context, newMerchant *NewMe
 			PostalCode:  newMerchant.Address.PostalCode,
 		}
 	}
-	merchant.ImageInfo = newMerchant.ImageInfo
-	merchant.Phone = newMerchant.Phone
-	merchant.Email = newMerchant.Email
-	merchant.Status = newMerchant.Status
 
-	_, err := s.coll.InsertOne(ctx, merchant)
+	set := bson.M{
+		"name":      newMerchant.Name,
+		"menu":      menu,
+		"imageInfo": newMerchant.ImageInfo,
+		"address":   address,
+		"phone":     newMerchant.Phone,
+		"status":    newMerchant.Status,
+	}
+	if newMerchant.Email != "" {
+		set["email"] = newMerchant.Email
+	}
+
+	filter := bson.M{
+		"_id":    merchantID,
+		"status": pb.StoreStatus_STORE_STATUS_UNSPECIFIED.String(),
+	}
+
+	res, err := s.coll.UpdateOne(ctx, filter, bson.M{"$set": set})
 	if err != nil {
 		return "", err
 	}
-	return merchant.ID, nil
+	if res.MatchedCount == 0 {
+		return "", mongo.ErrNoDocuments
+	}
+	return merchantID, nil
 }
 
 func (s *merchantStorage) CreateMenu(ctx context.Context, merchantID string, menu []*DbMenuItem) ([]*DbMenuItem, error) {
@@ -109,7 +189,7 @@ func (s *merchantStorage) UpdateMenuItem(ctx context.Context, merchantID string,
 
 	set := bson.M{}
 	if updateMenu.FoodName != "" {
-		set["menu.$.food_name"] = updateMenu.FoodName
+		set["menu.$.foodName"] = updateMenu.FoodName
 	}
 	if updateMenu.Price != 0 {
 		set["menu.$.price"] = updateMenu.Price
@@ -128,7 +208,7 @@ func (s *merchantStorage) UpdateMenuItem(ctx context.Context, merchantID string,
 
 	update := bson.M{"$set": set}
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
-	if err := s.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(updatedMerchant); err != nil {
+	if err := s.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updatedMerchant); err != nil {
 		return nil, fmt.Errorf("failed to update menu item: %v", err)
 	}
 
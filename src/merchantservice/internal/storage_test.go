@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// testCollection returns a new merchants collection with its indexes in the
+// MongoDB at MERCHANT_TEST_MONGO_URI, dropped after the test. The tests using
+// it are skipped when the variable is not set.
+func testCollection(t *testing.T) *mongo.Collection {
+	t.Helper()
+
+	uri := os.Getenv("MERCHANT_TEST_MONGO_URI")
+	if uri == "" {
+		t.Skip("MERCHANT_TEST_MONGO_URI is not set")
+	}
+
+	ctx := context.Background()
+
+	client, err := mongo.Connect(options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Disconnect(ctx) })
+
+	coll := client.Database("merchantdb_test").Collection("merchants_" + uuid.NewString())
+	t.Cleanup(func() { coll.Drop(ctx) })
+
+	if err := CreateIndexes(ctx, coll); err != nil {
+		t.Fatal(err)
+	}
+	return coll
+}
+
+func TestCreateOwnersWithSameIDSuffix(t *testing.T) {
+
+	s := NewMerchantStorage(testCollection(t))
+	ctx := context.Background()
+
+	// Both IDs end in "0001", which the placeholder names used to share.
+	for _, id := range []string{
+		"3f2b8c1e-5d4a-4e6f-9a7b-2c1d0e3f0001",
+		"a9d4e7c2-1b3f-4a8e-8c6d-5e7f9a1b0001",
+	} {
+		if _, err := s.CreateOwner(ctx, &NewMerchantOwner{MerchantID: id, Email: id + "@example.com"}); err != nil {
+			t.Fatalf("create owner %s: %v", id, err)
+		}
+	}
+}
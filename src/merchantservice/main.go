@@ -13,7 +13,6 @@ import (
 
 	"github.com/pongsathonn/ihavefood/src/merchantservice/internal"
 
-	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -77,12 +76,7 @@ func initMongoDB() *mongo.Collection {
 
 	coll := client.Database("merchantdb", nil).Collection("merchants")
 
-	indexModel := mongo.IndexModel{
-		Keys:    bson.D{{Key: "name", Value: 1}},
-		Options: options.Index().SetUnique(true),
-	}
-	_, err = coll.Indexes().CreateOne(context.TODO(), indexModel)
-	if err != nil {
+	if err := internal.CreateIndexes(context.TODO(), coll); err != nil {
 		panic(err)
 	}
 
@@ -116,6 +110,18 @@ func main() {
 			Key:     "order.placed.event",
 			Handler: srv.HandlePlaceOrder,
 		},
+		{
+			Key:     "sync.merchant.created",
+			Handler: srv.HandleMerchantCreation,
+		},
+		{
+			Key:     "sync.merchant.deleted",
+			Handler: srv.HandleMerchantDeletion,
+		},
+		{
+			Key:     "sync.merchant.email.updated",
+			Handler: srv.HandleMerchantEmailUpdated,
+		},
 	})
 
 	uri := fmt.Sprintf(":%s", os.Getenv("PORT"))
@@ -25,16 +25,414 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CustomerSort int32
+
+const (
+	CustomerSort_CUSTOMER_SORT_CREATE_TIME_DESC     CustomerSort = 0
+	CustomerSort_CUSTOMER_SORT_CREATE_TIME_ASC      CustomerSort = 1
+	CustomerSort_CUSTOMER_SORT_USERNAME_ASC         CustomerSort = 2
+	CustomerSort_CUSTOMER_SORT_USERNAME_DESC        CustomerSort = 3
+	CustomerSort_CUSTOMER_SORT_LAST_ORDER_TIME_DESC CustomerSort = 4
+)
+
+// Enum value maps for CustomerSort.
+var (
+	CustomerSort_name = map[int32]string{
+		0: "CUSTOMER_SORT_CREATE_TIME_DESC",
+		1: "CUSTOMER_SORT_CREATE_TIME_ASC",
+		2: "CUSTOMER_SORT_USERNAME_ASC",
+		3: "CUSTOMER_SORT_USERNAME_DESC",
+		4: "CUSTOMER_SORT_LAST_ORDER_TIME_DESC",
+	}
+	CustomerSort_value = map[string]int32{
+		"CUSTOMER_SORT_CREATE_TIME_DESC":     0,
+		"CUSTOMER_SORT_CREATE_TIME_ASC":      1,
+		"CUSTOMER_SORT_USERNAME_ASC":         2,
+		"CUSTOMER_SORT_USERNAME_DESC":        3,
+		"CUSTOMER_SORT_LAST_ORDER_TIME_DESC": 4,
+	}
+)
+
+func (x CustomerSort) Enum() *CustomerSort {
+	p := new(CustomerSort)
+	*p = x
+	return p
+}
+
+func (x CustomerSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomerSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomerSort) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[0]
+}
+
+func (x CustomerSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomerSort.Descriptor instead.
+func (CustomerSort) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{0}
+}
+
+type CustomerOrdersFilter int32
+
+const (
+	CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_ANY            CustomerOrdersFilter = 0
+	CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_WITH_ORDERS    CustomerOrdersFilter = 1
+	CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS CustomerOrdersFilter = 2
+)
+
+// Enum value maps for CustomerOrdersFilter.
+var (
+	CustomerOrdersFilter_name = map[int32]string{
+		0: "CUSTOMER_ORDERS_FILTER_ANY",
+		1: "CUSTOMER_ORDERS_FILTER_WITH_ORDERS",
+		2: "CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS",
+	}
+	CustomerOrdersFilter_value = map[string]int32{
+		"CUSTOMER_ORDERS_FILTER_ANY":            0,
+		"CUSTOMER_ORDERS_FILTER_WITH_ORDERS":    1,
+		"CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS": 2,
+	}
+)
+
+func (x CustomerOrdersFilter) Enum() *CustomerOrdersFilter {
+	p := new(CustomerOrdersFilter)
+	*p = x
+	return p
+}
+
+func (x CustomerOrdersFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomerOrdersFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[1].Descriptor()
+}
+
+func (CustomerOrdersFilter) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[1]
+}
+
+func (x CustomerOrdersFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomerOrdersFilter.Descriptor instead.
+func (CustomerOrdersFilter) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{1}
+}
+
+type DataExportState int32
+
+const (
+	DataExportState_DATA_EXPORT_STATE_UNSPECIFIED DataExportState = 0
+	DataExportState_DATA_EXPORT_STATE_PENDING     DataExportState = 1
+	DataExportState_DATA_EXPORT_STATE_RUNNING     DataExportState = 2
+	DataExportState_DATA_EXPORT_STATE_SUCCEEDED   DataExportState = 3
+	DataExportState_DATA_EXPORT_STATE_FAILED      DataExportState = 4
+	// The archive was deleted after expire_time.
+	DataExportState_DATA_EXPORT_STATE_EXPIRED DataExportState = 5
+)
+
+// Enum value maps for DataExportState.
+var (
+	DataExportState_name = map[int32]string{
+		0: "DATA_EXPORT_STATE_UNSPECIFIED",
+		1: "DATA_EXPORT_STATE_PENDING",
+		2: "DATA_EXPORT_STATE_RUNNING",
+		3: "DATA_EXPORT_STATE_SUCCEEDED",
+		4: "DATA_EXPORT_STATE_FAILED",
+		5: "DATA_EXPORT_STATE_EXPIRED",
+	}
+	DataExportState_value = map[string]int32{
+		"DATA_EXPORT_STATE_UNSPECIFIED": 0,
+		"DATA_EXPORT_STATE_PENDING":     1,
+		"DATA_EXPORT_STATE_RUNNING":     2,
+		"DATA_EXPORT_STATE_SUCCEEDED":   3,
+		"DATA_EXPORT_STATE_FAILED":      4,
+		"DATA_EXPORT_STATE_EXPIRED":     5,
+	}
+)
+
+func (x DataExportState) Enum() *DataExportState {
+	p := new(DataExportState)
+	*p = x
+	return p
+}
+
+func (x DataExportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataExportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[2].Descriptor()
+}
+
+func (DataExportState) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[2]
+}
+
+func (x DataExportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataExportState.Descriptor instead.
+func (DataExportState) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{2}
+}
+
+type LoyaltyTransactionType int32
+
+const (
+	LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_UNSPECIFIED LoyaltyTransactionType = 0
+	LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_EARN        LoyaltyTransactionType = 1
+	LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_REDEEM      LoyaltyTransactionType = 2
+	// Points given back when the order of a redemption was cancelled.
+	LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_REVERSE LoyaltyTransactionType = 3
+	LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_EXPIRE  LoyaltyTransactionType = 4
+)
+
+// Enum value maps for LoyaltyTransactionType.
+var (
+	LoyaltyTransactionType_name = map[int32]string{
+		0: "LOYALTY_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "LOYALTY_TRANSACTION_TYPE_EARN",
+		2: "LOYALTY_TRANSACTION_TYPE_REDEEM",
+		3: "LOYALTY_TRANSACTION_TYPE_REVERSE",
+		4: "LOYALTY_TRANSACTION_TYPE_EXPIRE",
+	}
+	LoyaltyTransactionType_value = map[string]int32{
+		"LOYALTY_TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"LOYALTY_TRANSACTION_TYPE_EARN":        1,
+		"LOYALTY_TRANSACTION_TYPE_REDEEM":      2,
+		"LOYALTY_TRANSACTION_TYPE_REVERSE":     3,
+		"LOYALTY_TRANSACTION_TYPE_EXPIRE":      4,
+	}
+)
+
+func (x LoyaltyTransactionType) Enum() *LoyaltyTransactionType {
+	p := new(LoyaltyTransactionType)
+	*p = x
+	return p
+}
+
+func (x LoyaltyTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoyaltyTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[3].Descriptor()
+}
+
+func (LoyaltyTransactionType) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[3]
+}
+
+func (x LoyaltyTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoyaltyTransactionType.Descriptor instead.
+func (LoyaltyTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{3}
+}
+
+type WalletTransactionType int32
+
+const (
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_UNSPECIFIED WalletTransactionType = 0
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP      WalletTransactionType = 1
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_GIFT_CARD   WalletTransactionType = 2
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_PAYMENT     WalletTransactionType = 3
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND      WalletTransactionType = 4
+	// The balance of a guest moved to the customer it merged into.
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_TRANSFER        WalletTransactionType = 5
+	WalletTransactionType_WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE WalletTransactionType = 6
+)
+
+// Enum value maps for WalletTransactionType.
+var (
+	WalletTransactionType_name = map[int32]string{
+		0: "WALLET_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "WALLET_TRANSACTION_TYPE_TOP_UP",
+		2: "WALLET_TRANSACTION_TYPE_GIFT_CARD",
+		3: "WALLET_TRANSACTION_TYPE_PAYMENT",
+		4: "WALLET_TRANSACTION_TYPE_REFUND",
+		5: "WALLET_TRANSACTION_TYPE_TRANSFER",
+		6: "WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE",
+	}
+	WalletTransactionType_value = map[string]int32{
+		"WALLET_TRANSACTION_TYPE_UNSPECIFIED":     0,
+		"WALLET_TRANSACTION_TYPE_TOP_UP":          1,
+		"WALLET_TRANSACTION_TYPE_GIFT_CARD":       2,
+		"WALLET_TRANSACTION_TYPE_PAYMENT":         3,
+		"WALLET_TRANSACTION_TYPE_REFUND":          4,
+		"WALLET_TRANSACTION_TYPE_TRANSFER":        5,
+		"WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE": 6,
+	}
+)
+
+func (x WalletTransactionType) Enum() *WalletTransactionType {
+	p := new(WalletTransactionType)
+	*p = x
+	return p
+}
+
+func (x WalletTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[4].Descriptor()
+}
+
+func (WalletTransactionType) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[4]
+}
+
+func (x WalletTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletTransactionType.Descriptor instead.
+func (WalletTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{4}
+}
+
+type NotificationChannel int32
+
+const (
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	NotificationChannel_NOTIFICATION_CHANNEL_PUSH        NotificationChannel = 1
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL       NotificationChannel = 2
+	NotificationChannel_NOTIFICATION_CHANNEL_SMS         NotificationChannel = 3
+	NotificationChannel_NOTIFICATION_CHANNEL_LINE        NotificationChannel = 4
+	// The inbox of ListNotifications.
+	NotificationChannel_NOTIFICATION_CHANNEL_IN_APP NotificationChannel = 5
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_PUSH",
+		2: "NOTIFICATION_CHANNEL_EMAIL",
+		3: "NOTIFICATION_CHANNEL_SMS",
+		4: "NOTIFICATION_CHANNEL_LINE",
+		5: "NOTIFICATION_CHANNEL_IN_APP",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_PUSH":        1,
+		"NOTIFICATION_CHANNEL_EMAIL":       2,
+		"NOTIFICATION_CHANNEL_SMS":         3,
+		"NOTIFICATION_CHANNEL_LINE":        4,
+		"NOTIFICATION_CHANNEL_IN_APP":      5,
+	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
+}
+
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[5].Descriptor()
+}
+
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[5]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{5}
+}
+
+type NotificationCategory int32
+
+const (
+	NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED NotificationCategory = 0
+	// Orders placed, delivered and cancelled.
+	NotificationCategory_NOTIFICATION_CATEGORY_ORDER_UPDATES NotificationCategory = 1
+	// New coupons.
+	NotificationCategory_NOTIFICATION_CATEGORY_PROMOTIONS NotificationCategory = 2
+)
+
+// Enum value maps for NotificationCategory.
+var (
+	NotificationCategory_name = map[int32]string{
+		0: "NOTIFICATION_CATEGORY_UNSPECIFIED",
+		1: "NOTIFICATION_CATEGORY_ORDER_UPDATES",
+		2: "NOTIFICATION_CATEGORY_PROMOTIONS",
+	}
+	NotificationCategory_value = map[string]int32{
+		"NOTIFICATION_CATEGORY_UNSPECIFIED":   0,
+		"NOTIFICATION_CATEGORY_ORDER_UPDATES": 1,
+		"NOTIFICATION_CATEGORY_PROMOTIONS":    2,
+	}
+)
+
+func (x NotificationCategory) Enum() *NotificationCategory {
+	p := new(NotificationCategory)
+	*p = x
+	return p
+}
+
+func (x NotificationCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_customerservice_proto_enumTypes[6].Descriptor()
+}
+
+func (NotificationCategory) Type() protoreflect.EnumType {
+	return &file_customerservice_proto_enumTypes[6]
+}
+
+func (x NotificationCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationCategory.Descriptor instead.
+func (NotificationCategory) EnumDescriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{6}
+}
+
 type Customer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	Social        *Social                `protobuf:"bytes,6,opt,name=social,proto3" json:"social,omitempty"`
-	Addresses     []*Address             `protobuf:"bytes,7,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"` // bytes picture = 10;
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Username   string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// email and phone are copies of the auth credentials, updated by
+	// "sync.customer.*" events.
+	Email      string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone      string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Social     *Social                `protobuf:"bytes,6,opt,name=social,proto3" json:"social,omitempty"`
+	Addresses  []*Address             `protobuf:"bytes,7,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Unset for customers without a profile picture.
+	Picture *CustomerPicture `protobuf:"bytes,10,opt,name=picture,proto3" json:"picture,omitempty"`
+	// last_order_time is when the customer last placed an order, unset for
+	// customers without orders.
+	LastOrderTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_order_time,json=lastOrderTime,proto3" json:"last_order_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -104,48 +502,2953 @@ func (x *Customer) GetSocial() *Social {
 	return nil
 }
 
-func (x *Customer) GetAddresses() []*Address {
+func (x *Customer) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Customer) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Customer) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Customer) GetPicture() *CustomerPicture {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+func (x *Customer) GetLastOrderTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastOrderTime
+	}
+	return nil
+}
+
+// CustomerPicture holds the URLs of the profile picture, square JPEGs at
+// most the given size.
+type CustomerPicture struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 64 pixels.
+	SmallUrl string `protobuf:"bytes,1,opt,name=small_url,json=smallUrl,proto3" json:"small_url,omitempty"`
+	// 256 pixels.
+	MediumUrl string `protobuf:"bytes,2,opt,name=medium_url,json=mediumUrl,proto3" json:"medium_url,omitempty"`
+	// 1024 pixels.
+	LargeUrl      string                 `protobuf:"bytes,3,opt,name=large_url,json=largeUrl,proto3" json:"large_url,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerPicture) Reset() {
+	*x = CustomerPicture{}
+	mi := &file_customerservice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerPicture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerPicture) ProtoMessage() {}
+
+func (x *CustomerPicture) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerPicture.ProtoReflect.Descriptor instead.
+func (*CustomerPicture) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomerPicture) GetSmallUrl() string {
+	if x != nil {
+		return x.SmallUrl
+	}
+	return ""
+}
+
+func (x *CustomerPicture) GetMediumUrl() string {
+	if x != nil {
+		return x.MediumUrl
+	}
+	return ""
+}
+
+func (x *CustomerPicture) GetLargeUrl() string {
+	if x != nil {
+		return x.LargeUrl
+	}
+	return ""
+}
+
+func (x *CustomerPicture) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListCustomersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 50, at most 200.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is only valid with the filters and sort it was returned for.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Case-insensitive prefix of the email.
+	EmailPrefix string `protobuf:"bytes,3,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	// Prefix of the phone number, e.g. "+6681".
+	PhonePrefix string `protobuf:"bytes,4,opt,name=phone_prefix,json=phonePrefix,proto3" json:"phone_prefix,omitempty"`
+	// Customers created at or after create_time_from and before create_time_to.
+	CreateTimeFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time_from,json=createTimeFrom,proto3" json:"create_time_from,omitempty"`
+	CreateTimeTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time_to,json=createTimeTo,proto3" json:"create_time_to,omitempty"`
+	Orders         CustomerOrdersFilter   `protobuf:"varint,7,opt,name=orders,proto3,enum=ihavefood.CustomerOrdersFilter" json:"orders,omitempty"`
+	Sort           CustomerSort           `protobuf:"varint,8,opt,name=sort,proto3,enum=ihavefood.CustomerSort" json:"sort,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCustomersRequest) Reset() {
+	*x = ListCustomersRequest{}
+	mi := &file_customerservice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersRequest) ProtoMessage() {}
+
+func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCustomersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetEmailPrefix() string {
+	if x != nil {
+		return x.EmailPrefix
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetPhonePrefix() string {
+	if x != nil {
+		return x.PhonePrefix
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetCreateTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTimeFrom
+	}
+	return nil
+}
+
+func (x *ListCustomersRequest) GetCreateTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTimeTo
+	}
+	return nil
+}
+
+func (x *ListCustomersRequest) GetOrders() CustomerOrdersFilter {
+	if x != nil {
+		return x.Orders
+	}
+	return CustomerOrdersFilter_CUSTOMER_ORDERS_FILTER_ANY
+}
+
+func (x *ListCustomersRequest) GetSort() CustomerSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CustomerSort_CUSTOMER_SORT_CREATE_TIME_DESC
+}
+
+type ListCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersResponse) Reset() {
+	*x = ListCustomersResponse{}
+	mi := &file_customerservice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersResponse) ProtoMessage() {}
+
+func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *ListCustomersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	mi := &file_customerservice_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type CreateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Address       *NewAddress            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddressRequest) Reset() {
+	*x = CreateAddressRequest{}
+	mi := &file_customerservice_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressRequest) ProtoMessage() {}
+
+func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetAddress() *NewAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateCustomerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	NewUsername   string                 `protobuf:"bytes,2,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerInfoRequest) Reset() {
+	*x = UpdateCustomerInfoRequest{}
+	mi := &file_customerservice_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerInfoRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCustomerInfoRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateCustomerInfoRequest) GetNewUsername() string {
+	if x != nil {
+		return x.NewUsername
+	}
+	return ""
+}
+
+type UploadCustomerPictureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCustomerPictureRequest) Reset() {
+	*x = UploadCustomerPictureRequest{}
+	mi := &file_customerservice_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCustomerPictureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCustomerPictureRequest) ProtoMessage() {}
+
+func (x *UploadCustomerPictureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCustomerPictureRequest.ProtoReflect.Descriptor instead.
+func (*UploadCustomerPictureRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadCustomerPictureRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UploadCustomerPictureRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DeleteCustomerPictureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerPictureRequest) Reset() {
+	*x = DeleteCustomerPictureRequest{}
+	mi := &file_customerservice_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerPictureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerPictureRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerPictureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerPictureRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerPictureRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteCustomerPictureRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type UpdateCustomerSocialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	NewSocial     *Social                `protobuf:"bytes,2,opt,name=new_social,json=newSocial,proto3" json:"new_social,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerSocialRequest) Reset() {
+	*x = UpdateCustomerSocialRequest{}
+	mi := &file_customerservice_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerSocialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerSocialRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerSocialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerSocialRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerSocialRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateCustomerSocialRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateCustomerSocialRequest) GetNewSocial() *Social {
+	if x != nil {
+		return x.NewSocial
+	}
+	return nil
+}
+
+type UpdateCustomerAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerAddressRequest) Reset() {
+	*x = UpdateCustomerAddressRequest{}
+	mi := &file_customerservice_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerAddressRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateCustomerAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateCustomerAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *UpdateCustomerAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type DeleteCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	mi := &file_customerservice_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type DeleteCustomerAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerAddressRequest) Reset() {
+	*x = DeleteCustomerAddressRequest{}
+	mi := &file_customerservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerAddressRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteCustomerAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *DeleteCustomerAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type SuggestAddressesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prefix of a sub-district, district or province name in Thai or
+	// English, or of a postal code.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only suggest addresses in the province or district, by name.
+	Province string `protobuf:"bytes,2,opt,name=province,proto3" json:"province,omitempty"`
+	District string `protobuf:"bytes,3,opt,name=district,proto3" json:"district,omitempty"`
+	// Defaults to 10, at most 50.
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAddressesRequest) Reset() {
+	*x = SuggestAddressesRequest{}
+	mi := &file_customerservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAddressesRequest) ProtoMessage() {}
+
+func (x *SuggestAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAddressesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SuggestAddressesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SuggestAddressesRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *SuggestAddressesRequest) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *SuggestAddressesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AddressSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubDistrict   string                 `protobuf:"bytes,1,opt,name=sub_district,json=subDistrict,proto3" json:"sub_district,omitempty"`
+	District      string                 `protobuf:"bytes,2,opt,name=district,proto3" json:"district,omitempty"`
+	Province      string                 `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	SubDistrictTh string                 `protobuf:"bytes,5,opt,name=sub_district_th,json=subDistrictTh,proto3" json:"sub_district_th,omitempty"`
+	DistrictTh    string                 `protobuf:"bytes,6,opt,name=district_th,json=districtTh,proto3" json:"district_th,omitempty"`
+	ProvinceTh    string                 `protobuf:"bytes,7,opt,name=province_th,json=provinceTh,proto3" json:"province_th,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressSuggestion) Reset() {
+	*x = AddressSuggestion{}
+	mi := &file_customerservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressSuggestion) ProtoMessage() {}
+
+func (x *AddressSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressSuggestion.ProtoReflect.Descriptor instead.
+func (*AddressSuggestion) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddressSuggestion) GetSubDistrict() string {
+	if x != nil {
+		return x.SubDistrict
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetSubDistrictTh() string {
+	if x != nil {
+		return x.SubDistrictTh
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetDistrictTh() string {
+	if x != nil {
+		return x.DistrictTh
+	}
+	return ""
+}
+
+func (x *AddressSuggestion) GetProvinceTh() string {
+	if x != nil {
+		return x.ProvinceTh
+	}
+	return ""
+}
+
+type SuggestAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*AddressSuggestion   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAddressesResponse) Reset() {
+	*x = SuggestAddressesResponse{}
+	mi := &file_customerservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAddressesResponse) ProtoMessage() {}
+
+func (x *SuggestAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAddressesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SuggestAddressesResponse) GetSuggestions() []*AddressSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// FavouriteMerchant keeps a copy of the merchant name and image, refreshed
+// from merchant.updated.event.
+type FavouriteMerchant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    string                 `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	MerchantName  string                 `protobuf:"bytes,2,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavouriteMerchant) Reset() {
+	*x = FavouriteMerchant{}
+	mi := &file_customerservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavouriteMerchant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavouriteMerchant) ProtoMessage() {}
+
+func (x *FavouriteMerchant) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavouriteMerchant.ProtoReflect.Descriptor instead.
+func (*FavouriteMerchant) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FavouriteMerchant) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *FavouriteMerchant) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *FavouriteMerchant) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *FavouriteMerchant) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type FavouriteMenuItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId   string                 `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId       string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	FoodName     string                 `protobuf:"bytes,3,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	MerchantName string                 `protobuf:"bytes,4,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`
+	ImageUrl     string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// available is false once the item was removed from the menu.
+	Available     bool                   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavouriteMenuItem) Reset() {
+	*x = FavouriteMenuItem{}
+	mi := &file_customerservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavouriteMenuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavouriteMenuItem) ProtoMessage() {}
+
+func (x *FavouriteMenuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavouriteMenuItem.ProtoReflect.Descriptor instead.
+func (*FavouriteMenuItem) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FavouriteMenuItem) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *FavouriteMenuItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FavouriteMenuItem) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *FavouriteMenuItem) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *FavouriteMenuItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *FavouriteMenuItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *FavouriteMenuItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListFavouritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavouritesRequest) Reset() {
+	*x = ListFavouritesRequest{}
+	mi := &file_customerservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavouritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavouritesRequest) ProtoMessage() {}
+
+func (x *ListFavouritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavouritesRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListFavouritesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// The merchant_id of merchants matches Merchant.merchant_id, so clients can
+// mark favourites in ListMerchants results.
+type ListFavouritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchants     []*FavouriteMerchant   `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	MenuItems     []*FavouriteMenuItem   `protobuf:"bytes,2,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavouritesResponse) Reset() {
+	*x = ListFavouritesResponse{}
+	mi := &file_customerservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavouritesResponse) ProtoMessage() {}
+
+func (x *ListFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListFavouritesResponse) GetMerchants() []*FavouriteMerchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+func (x *ListFavouritesResponse) GetMenuItems() []*FavouriteMenuItem {
+	if x != nil {
+		return x.MenuItems
+	}
+	return nil
+}
+
+type AddFavouriteMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	MerchantId    string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavouriteMerchantRequest) Reset() {
+	*x = AddFavouriteMerchantRequest{}
+	mi := &file_customerservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavouriteMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteMerchantRequest) ProtoMessage() {}
+
+func (x *AddFavouriteMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteMerchantRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddFavouriteMerchantRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddFavouriteMerchantRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+type RemoveFavouriteMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	MerchantId    string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavouriteMerchantRequest) Reset() {
+	*x = RemoveFavouriteMerchantRequest{}
+	mi := &file_customerservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavouriteMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteMerchantRequest) ProtoMessage() {}
+
+func (x *RemoveFavouriteMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteMerchantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveFavouriteMerchantRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RemoveFavouriteMerchantRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+type AddFavouriteMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	MerchantId    string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavouriteMenuItemRequest) Reset() {
+	*x = AddFavouriteMenuItemRequest{}
+	mi := &file_customerservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavouriteMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteMenuItemRequest) ProtoMessage() {}
+
+func (x *AddFavouriteMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddFavouriteMenuItemRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddFavouriteMenuItemRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *AddFavouriteMenuItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type RemoveFavouriteMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavouriteMenuItemRequest) Reset() {
+	*x = RemoveFavouriteMenuItemRequest{}
+	mi := &file_customerservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavouriteMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteMenuItemRequest) ProtoMessage() {}
+
+func (x *RemoveFavouriteMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveFavouriteMenuItemRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RemoveFavouriteMenuItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type DataExport struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ExportId     string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	CustomerId   string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	State        DataExportState        `protobuf:"varint,3,opt,name=state,proto3,enum=ihavefood.DataExportState" json:"state,omitempty"`
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CompleteTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	// Set once the export succeeded.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_customerservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DataExport) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DataExport) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *DataExport) GetState() DataExportState {
+	if x != nil {
+		return x.State
+	}
+	return DataExportState_DATA_EXPORT_STATE_UNSPECIFIED
+}
+
+func (x *DataExport) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
+func (x *DataExport) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *DataExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_customerservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RequestDataExportRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_customerservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetDataExportRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type DownloadDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_customerservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DownloadDataExportRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *DownloadDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+// DataExportArchive is a chunk of an archive. Only the first chunk has the
+// filename and content type, the archive is the content of every chunk in
+// order.
+type DataExportArchive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExportArchive) Reset() {
+	*x = DataExportArchive{}
+	mi := &file_customerservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExportArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportArchive) ProtoMessage() {}
+
+func (x *DataExportArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportArchive.ProtoReflect.Descriptor instead.
+func (*DataExportArchive) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DataExportArchive) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DataExportArchive) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DataExportArchive) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type LoyaltyBalance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Points     int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	// The points expiring next and when, unset without points.
+	NextExpiringPoints int32                  `protobuf:"varint,3,opt,name=next_expiring_points,json=nextExpiringPoints,proto3" json:"next_expiring_points,omitempty"`
+	NextExpireTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_expire_time,json=nextExpireTime,proto3" json:"next_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LoyaltyBalance) Reset() {
+	*x = LoyaltyBalance{}
+	mi := &file_customerservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoyaltyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyBalance) ProtoMessage() {}
+
+func (x *LoyaltyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyBalance.ProtoReflect.Descriptor instead.
+func (*LoyaltyBalance) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LoyaltyBalance) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *LoyaltyBalance) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LoyaltyBalance) GetNextExpiringPoints() int32 {
+	if x != nil {
+		return x.NextExpiringPoints
+	}
+	return 0
+}
+
+func (x *LoyaltyBalance) GetNextExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextExpireTime
+	}
+	return nil
+}
+
+type LoyaltyTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Type          LoyaltyTransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=ihavefood.LoyaltyTransactionType" json:"type,omitempty"`
+	// points is negative for redemptions and expiry.
+	Points  int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	OrderId string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Set for earned and given back points.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoyaltyTransaction) Reset() {
+	*x = LoyaltyTransaction{}
+	mi := &file_customerservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoyaltyTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyTransaction) ProtoMessage() {}
+
+func (x *LoyaltyTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyTransaction.ProtoReflect.Descriptor instead.
+func (*LoyaltyTransaction) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LoyaltyTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LoyaltyTransaction) GetType() LoyaltyTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return LoyaltyTransactionType_LOYALTY_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *LoyaltyTransaction) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LoyaltyTransaction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *LoyaltyTransaction) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *LoyaltyTransaction) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GetLoyaltyBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoyaltyBalanceRequest) Reset() {
+	*x = GetLoyaltyBalanceRequest{}
+	mi := &file_customerservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoyaltyBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoyaltyBalanceRequest) ProtoMessage() {}
+
+func (x *GetLoyaltyBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoyaltyBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetLoyaltyBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLoyaltyBalanceRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListLoyaltyTransactionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoyaltyTransactionsRequest) Reset() {
+	*x = ListLoyaltyTransactionsRequest{}
+	mi := &file_customerservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoyaltyTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyTransactionsRequest) ProtoMessage() {}
+
+func (x *ListLoyaltyTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListLoyaltyTransactionsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListLoyaltyTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoyaltyTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLoyaltyTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*LoyaltyTransaction  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoyaltyTransactionsResponse) Reset() {
+	*x = ListLoyaltyTransactionsResponse{}
+	mi := &file_customerservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoyaltyTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyTransactionsResponse) ProtoMessage() {}
+
+func (x *ListLoyaltyTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListLoyaltyTransactionsResponse) GetTransactions() []*LoyaltyTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListLoyaltyTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RedeemLoyaltyPointsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	OrderId    string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Points     int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	// order_amount caps the discount, customers can only pay part of an
+	// order with points.
+	OrderAmount   int32 `protobuf:"varint,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemLoyaltyPointsRequest) Reset() {
+	*x = RedeemLoyaltyPointsRequest{}
+	mi := &file_customerservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemLoyaltyPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemLoyaltyPointsRequest) ProtoMessage() {}
+
+func (x *RedeemLoyaltyPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemLoyaltyPointsRequest.ProtoReflect.Descriptor instead.
+func (*RedeemLoyaltyPointsRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RedeemLoyaltyPointsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RedeemLoyaltyPointsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RedeemLoyaltyPointsRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RedeemLoyaltyPointsRequest) GetOrderAmount() int32 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+type RedeemLoyaltyPointsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Discount int32                  `protobuf:"varint,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	// balance is the points left after the redemption.
+	Balance       int32 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemLoyaltyPointsResponse) Reset() {
+	*x = RedeemLoyaltyPointsResponse{}
+	mi := &file_customerservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemLoyaltyPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemLoyaltyPointsResponse) ProtoMessage() {}
+
+func (x *RedeemLoyaltyPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemLoyaltyPointsResponse.ProtoReflect.Descriptor instead.
+func (*RedeemLoyaltyPointsResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RedeemLoyaltyPointsResponse) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *RedeemLoyaltyPointsResponse) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type ReverseLoyaltyRedemptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseLoyaltyRedemptionRequest) Reset() {
+	*x = ReverseLoyaltyRedemptionRequest{}
+	mi := &file_customerservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseLoyaltyRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseLoyaltyRedemptionRequest) ProtoMessage() {}
+
+func (x *ReverseLoyaltyRedemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseLoyaltyRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseLoyaltyRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReverseLoyaltyRedemptionRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ReverseLoyaltyRedemptionRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type Wallet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_customerservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Wallet) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type WalletTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Type          WalletTransactionType  `protobuf:"varint,2,opt,name=type,proto3,enum=ihavefood.WalletTransactionType" json:"type,omitempty"`
+	// amount is positive when it credits the wallet.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reference is the order of payments and refunds, the gift card of
+	// redemptions and the payment of top-ups.
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_customerservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WalletTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetType() WalletTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletTransactionType_WALLET_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *WalletTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GiftCard struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	GiftCardId string                 `protobuf:"bytes,1,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	// Only set in the response of the first IssueGiftCard.
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Unset for gift cards that do not expire.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftCard) Reset() {
+	*x = GiftCard{}
+	mi := &file_customerservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCard) ProtoMessage() {}
+
+func (x *GiftCard) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCard.ProtoReflect.Descriptor instead.
+func (*GiftCard) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GiftCard) GetGiftCardId() string {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return ""
+}
+
+func (x *GiftCard) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GiftCard) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GiftCard) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *GiftCard) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_customerservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetWalletRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListWalletTransactionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsRequest) Reset() {
+	*x = ListWalletTransactionsRequest{}
+	mi := &file_customerservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsRequest) ProtoMessage() {}
+
+func (x *ListWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWalletTransactionsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListWalletTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWalletTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWalletTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletTransactionsResponse) Reset() {
+	*x = ListWalletTransactionsResponse{}
+	mi := &file_customerservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsResponse) ProtoMessage() {}
+
+func (x *ListWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListWalletTransactionsResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListWalletTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Redeeming a gift card again returns the first transaction.
+type RedeemGiftCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemGiftCardRequest) Reset() {
+	*x = RedeemGiftCardRequest{}
+	mi := &file_customerservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemGiftCardRequest) ProtoMessage() {}
+
+func (x *RedeemGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*RedeemGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RedeemGiftCardRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RedeemGiftCardRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The idempotency_key of a request is chosen by the client. Retrying with the
+// same key returns the first transaction, reusing it for another request
+// fails.
+type TopUpWalletRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId       string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount           int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentReference string                 `protobuf:"bytes,3,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	IdempotencyKey   string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TopUpWalletRequest) Reset() {
+	*x = TopUpWalletRequest{}
+	mi := &file_customerservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpWalletRequest) ProtoMessage() {}
+
+func (x *TopUpWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpWalletRequest.ProtoReflect.Descriptor instead.
+func (*TopUpWalletRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TopUpWalletRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpWalletRequest) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *TopUpWalletRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type IssueGiftCardRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Amount         int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpireTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IssueGiftCardRequest) Reset() {
+	*x = IssueGiftCardRequest{}
+	mi := &file_customerservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueGiftCardRequest) ProtoMessage() {}
+
+func (x *IssueGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*IssueGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IssueGiftCardRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IssueGiftCardRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *IssueGiftCardRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type PayWithWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayWithWalletRequest) Reset() {
+	*x = PayWithWalletRequest{}
+	mi := &file_customerservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayWithWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayWithWalletRequest) ProtoMessage() {}
+
+func (x *PayWithWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayWithWalletRequest.ProtoReflect.Descriptor instead.
+func (*PayWithWalletRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PayWithWalletRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *PayWithWalletRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PayWithWalletRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RefundToWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundToWalletRequest) Reset() {
+	*x = RefundToWalletRequest{}
+	mi := &file_customerservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundToWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundToWalletRequest) ProtoMessage() {}
+
+func (x *RefundToWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundToWalletRequest.ProtoReflect.Descriptor instead.
+func (*RefundToWalletRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RefundToWalletRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RefundToWalletRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundToWalletRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      NotificationCategory   `protobuf:"varint,1,opt,name=category,proto3,enum=ihavefood.NotificationCategory" json:"category,omitempty"`
+	Channel       NotificationChannel    `protobuf:"varint,2,opt,name=channel,proto3,enum=ihavefood.NotificationChannel" json:"channel,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_customerservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NotificationPreference) GetCategory() NotificationCategory {
 	if x != nil {
-		return x.Addresses
+		return x.Category
 	}
-	return nil
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
 }
 
-func (x *Customer) GetCreateTime() *timestamppb.Timestamp {
+func (x *NotificationPreference) GetChannel() NotificationChannel {
 	if x != nil {
-		return x.CreateTime
+		return x.Channel
 	}
-	return nil
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
 }
 
-func (x *Customer) GetUpdateTime() *timestamppb.Timestamp {
+func (x *NotificationPreference) GetEnabled() bool {
 	if x != nil {
-		return x.UpdateTime
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-type ListCustomersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type NotificationPreferences struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// One preference for every category and channel.
+	Preferences   []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCustomersRequest) Reset() {
-	*x = ListCustomersRequest{}
-	mi := &file_customerservice_proto_msgTypes[1]
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_customerservice_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCustomersRequest) String() string {
+func (x *NotificationPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCustomersRequest) ProtoMessage() {}
+func (*NotificationPreferences) ProtoMessage() {}
 
-func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[1]
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,33 +3459,47 @@ func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
-func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{49}
 }
 
-type ListCustomersResponse struct {
+func (x *NotificationPreferences) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type GetNotificationPreferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCustomersResponse) Reset() {
-	*x = ListCustomersResponse{}
-	mi := &file_customerservice_proto_msgTypes[2]
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_customerservice_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCustomersResponse) String() string {
+func (x *GetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCustomersResponse) ProtoMessage() {}
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[2]
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,40 +3510,41 @@ func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
-func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListCustomersResponse) GetCustomers() []*Customer {
+func (x *GetNotificationPreferencesRequest) GetCustomerId() string {
 	if x != nil {
-		return x.Customers
+		return x.CustomerId
 	}
-	return nil
+	return ""
 }
 
-type GetCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	CustomerId    string                    `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerRequest) Reset() {
-	*x = GetCustomerRequest{}
-	mi := &file_customerservice_proto_msgTypes[3]
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_customerservice_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerRequest) String() string {
+func (x *UpdateNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerRequest) ProtoMessage() {}
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[3]
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,41 +3555,56 @@ func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetCustomerRequest) GetCustomerId() string {
+func (x *UpdateNotificationPreferencesRequest) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-type CreateAddressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Address       *NewAddress            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Category       NotificationCategory   `protobuf:"varint,2,opt,name=category,proto3,enum=ihavefood.NotificationCategory" json:"category,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// reference is the order of order updates and the coupon code of
+	// promotions.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Unset for unread notifications.
+	ReadTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAddressRequest) Reset() {
-	*x = CreateAddressRequest{}
-	mi := &file_customerservice_proto_msgTypes[4]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_customerservice_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAddressRequest) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAddressRequest) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[4]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,49 +3615,86 @@ func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAddressRequest.ProtoReflect.Descriptor instead.
-func (*CreateAddressRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateAddressRequest) GetCustomerId() string {
+func (x *Notification) GetNotificationId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.NotificationId
 	}
 	return ""
 }
 
-func (x *CreateAddressRequest) GetAddress() *NewAddress {
+func (x *Notification) GetCategory() NotificationCategory {
 	if x != nil {
-		return x.Address
+		return x.Category
+	}
+	return NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Notification) GetReadTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadTime
 	}
 	return nil
 }
 
-type UpdateCustomerInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	NewUsername   string                 `protobuf:"bytes,2,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
-	NewPhone      string                 `protobuf:"bytes,3,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"` //bytes new_picture = TODO;
+func (x *Notification) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool   `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerInfoRequest) Reset() {
-	*x = UpdateCustomerInfoRequest{}
-	mi := &file_customerservice_proto_msgTypes[5]
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_customerservice_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerInfoRequest) String() string {
+func (x *ListNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerInfoRequest) ProtoMessage() {}
+func (*ListNotificationsRequest) ProtoMessage() {}
 
-func (x *UpdateCustomerInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[5]
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,55 +3705,62 @@ func (x *UpdateCustomerInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerInfoRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateCustomerInfoRequest) GetCustomerId() string {
+func (x *ListNotificationsRequest) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *UpdateCustomerInfoRequest) GetNewUsername() string {
+func (x *ListNotificationsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NewUsername
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCustomerInfoRequest) GetNewPhone() string {
+func (x *ListNotificationsRequest) GetPageToken() string {
 	if x != nil {
-		return x.NewPhone
+		return x.PageToken
 	}
 	return ""
 }
 
-type UpdateCustomerSocialRequest struct {
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	NewSocial     *Social                `protobuf:"bytes,2,opt,name=new_social,json=newSocial,proto3" json:"new_social,omitempty"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerSocialRequest) Reset() {
-	*x = UpdateCustomerSocialRequest{}
-	mi := &file_customerservice_proto_msgTypes[6]
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_customerservice_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerSocialRequest) String() string {
+func (x *ListNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerSocialRequest) ProtoMessage() {}
+func (*ListNotificationsResponse) ProtoMessage() {}
 
-func (x *UpdateCustomerSocialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[6]
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,49 +3771,48 @@ func (x *UpdateCustomerSocialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerSocialRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerSocialRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateCustomerSocialRequest) GetCustomerId() string {
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.CustomerId
+		return x.Notifications
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateCustomerSocialRequest) GetNewSocial() *Social {
+func (x *ListNotificationsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.NewSocial
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type UpdateCustomerAddressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId      string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	NotificationIds []string               `protobuf:"bytes,2,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerAddressRequest) Reset() {
-	*x = UpdateCustomerAddressRequest{}
-	mi := &file_customerservice_proto_msgTypes[7]
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_customerservice_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerAddressRequest) String() string {
+func (x *MarkNotificationsReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerAddressRequest) ProtoMessage() {}
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
 
-func (x *UpdateCustomerAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[7]
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,54 +3823,47 @@ func (x *UpdateCustomerAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerAddressRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerAddressRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateCustomerAddressRequest) GetCustomerId() string {
+func (x *MarkNotificationsReadRequest) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *UpdateCustomerAddressRequest) GetAddressId() string {
-	if x != nil {
-		return x.AddressId
-	}
-	return ""
-}
-
-func (x *UpdateCustomerAddressRequest) GetAddress() *Address {
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []string {
 	if x != nil {
-		return x.Address
+		return x.NotificationIds
 	}
 	return nil
 }
 
-type DeleteCustomerRequest struct {
+type GetUnreadNotificationCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomerRequest) Reset() {
-	*x = DeleteCustomerRequest{}
-	mi := &file_customerservice_proto_msgTypes[8]
+func (x *GetUnreadNotificationCountRequest) Reset() {
+	*x = GetUnreadNotificationCountRequest{}
+	mi := &file_customerservice_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerRequest) String() string {
+func (x *GetUnreadNotificationCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerRequest) ProtoMessage() {}
+func (*GetUnreadNotificationCountRequest) ProtoMessage() {}
 
-func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[8]
+func (x *GetUnreadNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,41 +3874,40 @@ func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetUnreadNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteCustomerRequest) GetCustomerId() string {
+func (x *GetUnreadNotificationCountRequest) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-type DeleteCustomerAddressRequest struct {
+type UnreadNotificationCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomerAddressRequest) Reset() {
-	*x = DeleteCustomerAddressRequest{}
-	mi := &file_customerservice_proto_msgTypes[9]
+func (x *UnreadNotificationCount) Reset() {
+	*x = UnreadNotificationCount{}
+	mi := &file_customerservice_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerAddressRequest) String() string {
+func (x *UnreadNotificationCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerAddressRequest) ProtoMessage() {}
+func (*UnreadNotificationCount) ProtoMessage() {}
 
-func (x *DeleteCustomerAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_customerservice_proto_msgTypes[9]
+func (x *UnreadNotificationCount) ProtoReflect() protoreflect.Message {
+	mi := &file_customerservice_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,30 +3918,23 @@ func (x *DeleteCustomerAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerAddressRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerAddressRequest) Descriptor() ([]byte, []int) {
-	return file_customerservice_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *DeleteCustomerAddressRequest) GetCustomerId() string {
-	if x != nil {
-		return x.CustomerId
-	}
-	return ""
+// Deprecated: Use UnreadNotificationCount.ProtoReflect.Descriptor instead.
+func (*UnreadNotificationCount) Descriptor() ([]byte, []int) {
+	return file_customerservice_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DeleteCustomerAddressRequest) GetAddressId() string {
+func (x *UnreadNotificationCount) GetUnreadCount() int32 {
 	if x != nil {
-		return x.AddressId
+		return x.UnreadCount
 	}
-	return ""
+	return 0
 }
 
 var File_customerservice_proto protoreflect.FileDescriptor
 
 const file_customerservice_proto_rawDesc = "" +
 	"\n" +
-	"\x15customerservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xca\x02\n" +
+	"\x15customerservice.proto\x12\tihavefood\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc4\x03\n" +
 	"\bCustomer\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x1a\n" +
@@ -585,22 +3946,48 @@ const file_customerservice_proto_rawDesc = "" +
 	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12;\n" +
 	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"\x16\n" +
-	"\x14ListCustomersRequest\"J\n" +
+	"updateTime\x124\n" +
+	"\apicture\x18\n" +
+	" \x01(\v2\x1a.ihavefood.CustomerPictureR\apicture\x12B\n" +
+	"\x0flast_order_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rlastOrderTime\"\xa7\x01\n" +
+	"\x0fCustomerPicture\x12\x1b\n" +
+	"\tsmall_url\x18\x01 \x01(\tR\bsmallUrl\x12\x1d\n" +
+	"\n" +
+	"medium_url\x18\x02 \x01(\tR\tmediumUrl\x12\x1b\n" +
+	"\tlarge_url\x18\x03 \x01(\tR\blargeUrl\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x86\x03\n" +
+	"\x14ListCustomersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12!\n" +
+	"\femail_prefix\x18\x03 \x01(\tR\vemailPrefix\x12!\n" +
+	"\fphone_prefix\x18\x04 \x01(\tR\vphonePrefix\x12D\n" +
+	"\x10create_time_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecreateTimeFrom\x12@\n" +
+	"\x0ecreate_time_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreateTimeTo\x127\n" +
+	"\x06orders\x18\a \x01(\x0e2\x1f.ihavefood.CustomerOrdersFilterR\x06orders\x12+\n" +
+	"\x04sort\x18\b \x01(\x0e2\x17.ihavefood.CustomerSortR\x04sort\"r\n" +
 	"\x15ListCustomersResponse\x121\n" +
-	"\tcustomers\x18\x01 \x03(\v2\x13.ihavefood.CustomerR\tcustomers\"t\n" +
+	"\tcustomers\x18\x01 \x03(\v2\x13.ihavefood.CustomerR\tcustomers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"t\n" +
 	"\x12GetCustomerRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId:=\x92A:28{\"customer_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\" }\"\xc1\x02\n" +
 	"\x14CreateAddressRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12/\n" +
-	"\aaddress\x18\x02 \x01(\v2\x15.ihavefood.NewAddressR\aaddress:\xd6\x01\x92A\xd2\x012\xcf\x01{\"customer_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\", \"address\": {\"address_name\": \"Arun House\", \"sub_district\": \"Suthep\", \"district\": \"Mueang Chiang Mai\", \"province\": \"Chiang Mai\", \"postal_code\": \"50200\"}}\"\xee\x01\n" +
+	"\aaddress\x18\x02 \x01(\v2\x15.ihavefood.NewAddressR\aaddress:\xd6\x01\x92A\xd2\x012\xcf\x01{\"customer_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\", \"address\": {\"address_name\": \"Arun House\", \"sub_district\": \"Suthep\", \"district\": \"Mueang Chiang Mai\", \"province\": \"Chiang Mai\", \"postal_code\": \"50200\"}}\"\xc9\x01\n" +
 	"\x19UpdateCustomerInfoRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12!\n" +
-	"\fnew_username\x18\x02 \x01(\tR\vnewUsername\x12\x1b\n" +
-	"\tnew_phone\x18\x03 \x01(\tR\bnewPhone:p\x92Am2k{\"customer_id\":\"0cf361e1-4b44-483d-a159-54dabdf7e814\",\"new_username\":\"anurak_new\",\"new_phone\":\"0812345678\"}\"\x8a\x02\n" +
+	"\fnew_username\x18\x02 \x01(\tR\vnewUsername:W\x92AT2R{\"customer_id\":\"0cf361e1-4b44-483d-a159-54dabdf7e814\",\"new_username\":\"anurak_new\"}J\x04\b\x03\x10\x04R\tnew_phone\"Y\n" +
+	"\x1cUploadCustomerPictureRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"?\n" +
+	"\x1cDeleteCustomerPictureRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x8a\x02\n" +
 	"\x1bUpdateCustomerSocialRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x120\n" +
@@ -619,16 +4006,316 @@ const file_customerservice_proto_rawDesc = "" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x1d\n" +
 	"\n" +
-	"address_id\x18\x02 \x01(\tR\taddressId:s\x92Ap2n{\"customer_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\", \"address_id\": \"e8d58539-a66e-4996-a92c-64c450086c8a\" }2\x89\b\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId:s\x92Ap2n{\"customer_id\": \"0cf361e1-4b44-483d-a159-54dabdf7e814\", \"address_id\": \"e8d58539-a66e-4996-a92c-64c450086c8a\" }\"\xb5\x01\n" +
+	"\x17SuggestAddressesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bprovince\x18\x02 \x01(\tR\bprovince\x12\x1a\n" +
+	"\bdistrict\x18\x03 \x01(\tR\bdistrict\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize:/\x92A,2*{\"query\": \"sut\", \"province\": \"Chiang Mai\"}\"\xf9\x01\n" +
+	"\x11AddressSuggestion\x12!\n" +
+	"\fsub_district\x18\x01 \x01(\tR\vsubDistrict\x12\x1a\n" +
+	"\bdistrict\x18\x02 \x01(\tR\bdistrict\x12\x1a\n" +
+	"\bprovince\x18\x03 \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12&\n" +
+	"\x0fsub_district_th\x18\x05 \x01(\tR\rsubDistrictTh\x12\x1f\n" +
+	"\vdistrict_th\x18\x06 \x01(\tR\n" +
+	"districtTh\x12\x1f\n" +
+	"\vprovince_th\x18\a \x01(\tR\n" +
+	"provinceTh\"Z\n" +
+	"\x18SuggestAddressesResponse\x12>\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1c.ihavefood.AddressSuggestionR\vsuggestions\"\xb3\x01\n" +
+	"\x11FavouriteMerchant\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\tR\n" +
+	"merchantId\x12#\n" +
+	"\rmerchant_name\x18\x02 \x01(\tR\fmerchantName\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x87\x02\n" +
+	"\x11FavouriteMenuItem\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\tR\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tfood_name\x18\x03 \x01(\tR\bfoodName\x12#\n" +
+	"\rmerchant_name\x18\x04 \x01(\tR\fmerchantName\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"8\n" +
+	"\x15ListFavouritesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x91\x01\n" +
+	"\x16ListFavouritesResponse\x12:\n" +
+	"\tmerchants\x18\x01 \x03(\v2\x1c.ihavefood.FavouriteMerchantR\tmerchants\x12;\n" +
+	"\n" +
+	"menu_items\x18\x02 \x03(\v2\x1c.ihavefood.FavouriteMenuItemR\tmenuItems\"_\n" +
+	"\x1bAddFavouriteMerchantRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\tR\n" +
+	"merchantId\"b\n" +
+	"\x1eRemoveFavouriteMerchantRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\tR\n" +
+	"merchantId\"x\n" +
+	"\x1bAddFavouriteMenuItemRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\tR\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\"Z\n" +
+	"\x1eRemoveFavouriteMenuItemRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\"\xd6\x02\n" +
+	"\n" +
+	"DataExport\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x120\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1a.ihavefood.DataExportStateR\x05state\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12?\n" +
+	"\rcomplete_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcompleteTime\x12;\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\a \x01(\x03R\tsizeBytes\";\n" +
+	"\x18RequestDataExportRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"T\n" +
+	"\x14GetDataExportRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"Y\n" +
+	"\x19DownloadDataExportRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"l\n" +
+	"\x11DataExportArchive\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\xc1\x01\n" +
+	"\x0eLoyaltyBalance\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\x120\n" +
+	"\x14next_expiring_points\x18\x03 \x01(\x05R\x12nextExpiringPoints\x12D\n" +
+	"\x10next_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0enextExpireTime\"\x9f\x02\n" +
+	"\x12LoyaltyTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.ihavefood.LoyaltyTransactionTypeR\x04type\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\";\n" +
+	"\x18GetLoyaltyBalanceRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"}\n" +
+	"\x1eListLoyaltyTransactionsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8c\x01\n" +
+	"\x1fListLoyaltyTransactionsResponse\x12A\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1d.ihavefood.LoyaltyTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x93\x01\n" +
+	"\x1aRedeemLoyaltyPointsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12!\n" +
+	"\forder_amount\x18\x04 \x01(\x05R\vorderAmount\"S\n" +
+	"\x1bRedeemLoyaltyPointsResponse\x12\x1a\n" +
+	"\bdiscount\x18\x01 \x01(\x05R\bdiscount\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x05R\abalance\"]\n" +
+	"\x1fReverseLoyaltyRedemptionRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"C\n" +
+	"\x06Wallet\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\"\xe3\x01\n" +
+	"\x11WalletTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .ihavefood.WalletTransactionTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xd2\x01\n" +
+	"\bGiftCard\x12 \n" +
+	"\fgift_card_id\x18\x01 \x01(\tR\n" +
+	"giftCardId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"3\n" +
+	"\x10GetWalletRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"|\n" +
+	"\x1dListWalletTransactionsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8a\x01\n" +
+	"\x1eListWalletTransactionsResponse\x12@\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1c.ihavefood.WalletTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"L\n" +
+	"\x15RedeemGiftCardRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xa3\x01\n" +
+	"\x12TopUpWalletRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12+\n" +
+	"\x11payment_reference\x18\x03 \x01(\tR\x10paymentReference\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x94\x01\n" +
+	"\x14IssueGiftCardRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"j\n" +
+	"\x14PayWithWalletRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"k\n" +
+	"\x15RefundToWalletRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\xa9\x01\n" +
+	"\x16NotificationPreference\x12;\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x1f.ihavefood.NotificationCategoryR\bcategory\x128\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x1e.ihavefood.NotificationChannelR\achannel\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\x7f\n" +
+	"\x17NotificationPreferences\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12C\n" +
+	"\vpreferences\x18\x02 \x03(\v2!.ihavefood.NotificationPreferenceR\vpreferences\"D\n" +
+	"!GetNotificationPreferencesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x8c\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12C\n" +
+	"\vpreferences\x18\x02 \x03(\v2!.ihavefood.NotificationPreferenceR\vpreferences\"\xb2\x02\n" +
+	"\fNotification\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12;\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x1f.ihavefood.NotificationCategoryR\bcategory\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x127\n" +
+	"\tread_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\breadTime\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x98\x01\n" +
+	"\x18ListNotificationsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"\x82\x01\n" +
+	"\x19ListNotificationsResponse\x12=\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x17.ihavefood.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"j\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\tR\x0fnotificationIds\"D\n" +
+	"!GetUnreadNotificationCountRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"<\n" +
+	"\x17UnreadNotificationCount\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount*\xbe\x01\n" +
+	"\fCustomerSort\x12\"\n" +
+	"\x1eCUSTOMER_SORT_CREATE_TIME_DESC\x10\x00\x12!\n" +
+	"\x1dCUSTOMER_SORT_CREATE_TIME_ASC\x10\x01\x12\x1e\n" +
+	"\x1aCUSTOMER_SORT_USERNAME_ASC\x10\x02\x12\x1f\n" +
+	"\x1bCUSTOMER_SORT_USERNAME_DESC\x10\x03\x12&\n" +
+	"\"CUSTOMER_SORT_LAST_ORDER_TIME_DESC\x10\x04*\x89\x01\n" +
+	"\x14CustomerOrdersFilter\x12\x1e\n" +
+	"\x1aCUSTOMER_ORDERS_FILTER_ANY\x10\x00\x12&\n" +
+	"\"CUSTOMER_ORDERS_FILTER_WITH_ORDERS\x10\x01\x12)\n" +
+	"%CUSTOMER_ORDERS_FILTER_WITHOUT_ORDERS\x10\x02*\xd0\x01\n" +
+	"\x0fDataExportState\x12!\n" +
+	"\x1dDATA_EXPORT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DATA_EXPORT_STATE_PENDING\x10\x01\x12\x1d\n" +
+	"\x19DATA_EXPORT_STATE_RUNNING\x10\x02\x12\x1f\n" +
+	"\x1bDATA_EXPORT_STATE_SUCCEEDED\x10\x03\x12\x1c\n" +
+	"\x18DATA_EXPORT_STATE_FAILED\x10\x04\x12\x1d\n" +
+	"\x19DATA_EXPORT_STATE_EXPIRED\x10\x05*\xd5\x01\n" +
+	"\x16LoyaltyTransactionType\x12(\n" +
+	"$LOYALTY_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dLOYALTY_TRANSACTION_TYPE_EARN\x10\x01\x12#\n" +
+	"\x1fLOYALTY_TRANSACTION_TYPE_REDEEM\x10\x02\x12$\n" +
+	" LOYALTY_TRANSACTION_TYPE_REVERSE\x10\x03\x12#\n" +
+	"\x1fLOYALTY_TRANSACTION_TYPE_EXPIRE\x10\x04*\xa7\x02\n" +
+	"\x15WalletTransactionType\x12'\n" +
+	"#WALLET_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eWALLET_TRANSACTION_TYPE_TOP_UP\x10\x01\x12%\n" +
+	"!WALLET_TRANSACTION_TYPE_GIFT_CARD\x10\x02\x12#\n" +
+	"\x1fWALLET_TRANSACTION_TYPE_PAYMENT\x10\x03\x12\"\n" +
+	"\x1eWALLET_TRANSACTION_TYPE_REFUND\x10\x04\x12$\n" +
+	" WALLET_TRANSACTION_TYPE_TRANSFER\x10\x05\x12+\n" +
+	"'WALLET_TRANSACTION_TYPE_GIFT_CARD_ISSUE\x10\x06*\xd8\x01\n" +
+	"\x13NotificationChannel\x12$\n" +
+	" NOTIFICATION_CHANNEL_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19NOTIFICATION_CHANNEL_PUSH\x10\x01\x12\x1e\n" +
+	"\x1aNOTIFICATION_CHANNEL_EMAIL\x10\x02\x12\x1c\n" +
+	"\x18NOTIFICATION_CHANNEL_SMS\x10\x03\x12\x1d\n" +
+	"\x19NOTIFICATION_CHANNEL_LINE\x10\x04\x12\x1f\n" +
+	"\x1bNOTIFICATION_CHANNEL_IN_APP\x10\x05*\x8c\x01\n" +
+	"\x14NotificationCategory\x12%\n" +
+	"!NOTIFICATION_CATEGORY_UNSPECIFIED\x10\x00\x12'\n" +
+	"#NOTIFICATION_CATEGORY_ORDER_UPDATES\x10\x01\x12$\n" +
+	" NOTIFICATION_CATEGORY_PROMOTIONS\x10\x022\xa1%\n" +
 	"\x0fCustomerService\x12p\n" +
 	"\rListCustomers\x12\x1f.ihavefood.ListCustomersRequest\x1a .ihavefood.ListCustomersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/admin/customers\x12g\n" +
 	"\vGetCustomer\x12\x1d.ihavefood.GetCustomerRequest\x1a\x13.ihavefood.Customer\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/customers/{customer_id}\x12u\n" +
 	"\rCreateAddress\x12\x1f.ihavefood.CreateAddressRequest\x1a\x12.ihavefood.Address\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/customers/{customer_id}/address\x12}\n" +
 	"\x12UpdateCustomerInfo\x12$.ihavefood.UpdateCustomerInfoRequest\x1a\x13.ihavefood.Customer\",\x82\xd3\xe4\x93\x02&:\x01*2!/api/customers/{customer_id}/info\x12\x83\x01\n" +
-	"\x14UpdateCustomerSocial\x12&.ihavefood.UpdateCustomerSocialRequest\x1a\x13.ihavefood.Customer\".\x82\xd3\xe4\x93\x02(:\x01*2#/api/customers/{customer_id}/social\x12\x94\x01\n" +
+	"\x14UpdateCustomerSocial\x12&.ihavefood.UpdateCustomerSocialRequest\x1a\x13.ihavefood.Customer\".\x82\xd3\xe4\x93\x02(:\x01*2#/api/customers/{customer_id}/social\x12\x8d\x01\n" +
+	"\x15UploadCustomerPicture\x12'.ihavefood.UploadCustomerPictureRequest\x1a\x1a.ihavefood.CustomerPicture\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/customers/{customer_id}/picture\x12\x86\x01\n" +
+	"\x15DeleteCustomerPicture\x12'.ihavefood.DeleteCustomerPictureRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/api/customers/{customer_id}/picture\x12\x94\x01\n" +
 	"\x15UpdateCustomerAddress\x12'.ihavefood.UpdateCustomerAddressRequest\x1a\x12.ihavefood.Address\">\x82\xd3\xe4\x93\x028:\x01*23/api/customers/{customer_id}/addresses/{address_id}\x12p\n" +
 	"\x0eDeleteCustomer\x12 .ihavefood.DeleteCustomerRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/customers/{customer_id}\x12\x95\x01\n" +
-	"\x15DeleteCustomerAddress\x12'.ihavefood.DeleteCustomerAddressRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/api/customers/{customer_id}/addresses/{address_id}B\vZ\t/genprotob\x06proto3"
+	"\x15DeleteCustomerAddress\x12'.ihavefood.DeleteCustomerAddressRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/api/customers/{customer_id}/addresses/{address_id}\x12\x7f\n" +
+	"\x10SuggestAddresses\x12\".ihavefood.SuggestAddressesRequest\x1a#.ihavefood.SuggestAddressesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/addresses/suggestions\x12\x86\x01\n" +
+	"\x0eListFavourites\x12 .ihavefood.ListFavouritesRequest\x1a!.ihavefood.ListFavouritesResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/customers/{customer_id}/favourites\x12\x9a\x01\n" +
+	"\x14AddFavouriteMerchant\x12&.ihavefood.AddFavouriteMerchantRequest\x1a\x1c.ihavefood.FavouriteMerchant\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/customers/{customer_id}/favourites/merchants\x12\xa5\x01\n" +
+	"\x17RemoveFavouriteMerchant\x12).ihavefood.RemoveFavouriteMerchantRequest\x1a\x16.google.protobuf.Empty\"G\x82\xd3\xe4\x93\x02A*?/api/customers/{customer_id}/favourites/merchants/{merchant_id}\x12\x9b\x01\n" +
+	"\x14AddFavouriteMenuItem\x12&.ihavefood.AddFavouriteMenuItemRequest\x1a\x1c.ihavefood.FavouriteMenuItem\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/customers/{customer_id}/favourites/menu-items\x12\xa2\x01\n" +
+	"\x17RemoveFavouriteMenuItem\x12).ihavefood.RemoveFavouriteMenuItemRequest\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>*</api/customers/{customer_id}/favourites/menu-items/{item_id}\x12\x85\x01\n" +
+	"\x11RequestDataExport\x12#.ihavefood.RequestDataExportRequest\x1a\x15.ihavefood.DataExport\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/customers/{customer_id}/data-exports\x12\x86\x01\n" +
+	"\rGetDataExport\x12\x1f.ihavefood.GetDataExportRequest\x1a\x15.ihavefood.DataExport\"=\x82\xd3\xe4\x93\x027\x125/api/customers/{customer_id}/data-exports/{export_id}\x12\xa1\x01\n" +
+	"\x12DownloadDataExport\x12$.ihavefood.DownloadDataExportRequest\x1a\x1c.ihavefood.DataExportArchive\"E\x82\xd3\xe4\x93\x02?\x12=/api/customers/{customer_id}/data-exports/{export_id}/archive0\x01\x12\x81\x01\n" +
+	"\x11GetLoyaltyBalance\x12#.ihavefood.GetLoyaltyBalanceRequest\x1a\x19.ihavefood.LoyaltyBalance\",\x82\xd3\xe4\x93\x02&\x12$/api/customers/{customer_id}/loyalty\x12\xab\x01\n" +
+	"\x17ListLoyaltyTransactions\x12).ihavefood.ListLoyaltyTransactionsRequest\x1a*.ihavefood.ListLoyaltyTransactionsResponse\"9\x82\xd3\xe4\x93\x023\x121/api/customers/{customer_id}/loyalty/transactions\x12f\n" +
+	"\x13RedeemLoyaltyPoints\x12%.ihavefood.RedeemLoyaltyPointsRequest\x1a&.ihavefood.RedeemLoyaltyPointsResponse\"\x00\x12c\n" +
+	"\x18ReverseLoyaltyRedemption\x12*.ihavefood.ReverseLoyaltyRedemptionRequest\x1a\x19.ihavefood.LoyaltyBalance\"\x00\x12h\n" +
+	"\tGetWallet\x12\x1b.ihavefood.GetWalletRequest\x1a\x11.ihavefood.Wallet\"+\x82\xd3\xe4\x93\x02%\x12#/api/customers/{customer_id}/wallet\x12\xa7\x01\n" +
+	"\x16ListWalletTransactions\x12(.ihavefood.ListWalletTransactionsRequest\x1a).ihavefood.ListWalletTransactionsResponse\"8\x82\xd3\xe4\x93\x022\x120/api/customers/{customer_id}/wallet/transactions\x12\x92\x01\n" +
+	"\x0eRedeemGiftCard\x12 .ihavefood.RedeemGiftCardRequest\x1a\x1c.ihavefood.WalletTransaction\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/customers/{customer_id}/wallet/gift-cards/redeem\x12L\n" +
+	"\vTopUpWallet\x12\x1d.ihavefood.TopUpWalletRequest\x1a\x1c.ihavefood.WalletTransaction\"\x00\x12G\n" +
+	"\rIssueGiftCard\x12\x1f.ihavefood.IssueGiftCardRequest\x1a\x13.ihavefood.GiftCard\"\x00\x12P\n" +
+	"\rPayWithWallet\x12\x1f.ihavefood.PayWithWalletRequest\x1a\x1c.ihavefood.WalletTransaction\"\x00\x12R\n" +
+	"\x0eRefundToWallet\x12 .ihavefood.RefundToWalletRequest\x1a\x1c.ihavefood.WalletTransaction\"\x00\x12\xad\x01\n" +
+	"\x1aGetNotificationPreferences\x12,.ihavefood.GetNotificationPreferencesRequest\x1a\".ihavefood.NotificationPreferences\"=\x82\xd3\xe4\x93\x027\x125/api/customers/{customer_id}/notification-preferences\x12\xb6\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12/.ihavefood.UpdateNotificationPreferencesRequest\x1a\".ihavefood.NotificationPreferences\"@\x82\xd3\xe4\x93\x02::\x01*25/api/customers/{customer_id}/notification-preferences\x12\x92\x01\n" +
+	"\x11ListNotifications\x12#.ihavefood.ListNotificationsRequest\x1a$.ihavefood.ListNotificationsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/customers/{customer_id}/notifications\x12\xa0\x01\n" +
+	"\x15MarkNotificationsRead\x12'.ihavefood.MarkNotificationsReadRequest\x1a\".ihavefood.UnreadNotificationCount\":\x82\xd3\xe4\x93\x024:\x01*\"//api/customers/{customer_id}/notifications/read\x12\xaf\x01\n" +
+	"\x1aGetUnreadNotificationCount\x12,.ihavefood.GetUnreadNotificationCountRequest\x1a\".ihavefood.UnreadNotificationCount\"?\x82\xd3\xe4\x93\x029\x127/api/customers/{customer_id}/notifications/unread-countB\vZ\t/genprotob\x06proto3"
 
 var (
 	file_customerservice_proto_rawDescOnce sync.Once
@@ -642,54 +4329,199 @@ func file_customerservice_proto_rawDescGZIP() []byte {
 	return file_customerservice_proto_rawDescData
 }
 
-var file_customerservice_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_customerservice_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_customerservice_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_customerservice_proto_goTypes = []any{
-	(*Customer)(nil),                     // 0: ihavefood.Customer
-	(*ListCustomersRequest)(nil),         // 1: ihavefood.ListCustomersRequest
-	(*ListCustomersResponse)(nil),        // 2: ihavefood.ListCustomersResponse
-	(*GetCustomerRequest)(nil),           // 3: ihavefood.GetCustomerRequest
-	(*CreateAddressRequest)(nil),         // 4: ihavefood.CreateAddressRequest
-	(*UpdateCustomerInfoRequest)(nil),    // 5: ihavefood.UpdateCustomerInfoRequest
-	(*UpdateCustomerSocialRequest)(nil),  // 6: ihavefood.UpdateCustomerSocialRequest
-	(*UpdateCustomerAddressRequest)(nil), // 7: ihavefood.UpdateCustomerAddressRequest
-	(*DeleteCustomerRequest)(nil),        // 8: ihavefood.DeleteCustomerRequest
-	(*DeleteCustomerAddressRequest)(nil), // 9: ihavefood.DeleteCustomerAddressRequest
-	(*Social)(nil),                       // 10: ihavefood.Social
-	(*Address)(nil),                      // 11: ihavefood.Address
-	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
-	(*NewAddress)(nil),                   // 13: ihavefood.NewAddress
-	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
+	(CustomerSort)(0),                            // 0: ihavefood.CustomerSort
+	(CustomerOrdersFilter)(0),                    // 1: ihavefood.CustomerOrdersFilter
+	(DataExportState)(0),                         // 2: ihavefood.DataExportState
+	(LoyaltyTransactionType)(0),                  // 3: ihavefood.LoyaltyTransactionType
+	(WalletTransactionType)(0),                   // 4: ihavefood.WalletTransactionType
+	(NotificationChannel)(0),                     // 5: ihavefood.NotificationChannel
+	(NotificationCategory)(0),                    // 6: ihavefood.NotificationCategory
+	(*Customer)(nil),                             // 7: ihavefood.Customer
+	(*CustomerPicture)(nil),                      // 8: ihavefood.CustomerPicture
+	(*ListCustomersRequest)(nil),                 // 9: ihavefood.ListCustomersRequest
+	(*ListCustomersResponse)(nil),                // 10: ihavefood.ListCustomersResponse
+	(*GetCustomerRequest)(nil),                   // 11: ihavefood.GetCustomerRequest
+	(*CreateAddressRequest)(nil),                 // 12: ihavefood.CreateAddressRequest
+	(*UpdateCustomerInfoRequest)(nil),            // 13: ihavefood.UpdateCustomerInfoRequest
+	(*UploadCustomerPictureRequest)(nil),         // 14: ihavefood.UploadCustomerPictureRequest
+	(*DeleteCustomerPictureRequest)(nil),         // 15: ihavefood.DeleteCustomerPictureRequest
+	(*UpdateCustomerSocialRequest)(nil),          // 16: ihavefood.UpdateCustomerSocialRequest
+	(*UpdateCustomerAddressRequest)(nil),         // 17: ihavefood.UpdateCustomerAddressRequest
+	(*DeleteCustomerRequest)(nil),                // 18: ihavefood.DeleteCustomerRequest
+	(*DeleteCustomerAddressRequest)(nil),         // 19: ihavefood.DeleteCustomerAddressRequest
+	(*SuggestAddressesRequest)(nil),              // 20: ihavefood.SuggestAddressesRequest
+	(*AddressSuggestion)(nil),                    // 21: ihavefood.AddressSuggestion
+	(*SuggestAddressesResponse)(nil),             // 22: ihavefood.SuggestAddressesResponse
+	(*FavouriteMerchant)(nil),                    // 23: ihavefood.FavouriteMerchant
+	(*FavouriteMenuItem)(nil),                    // 24: ihavefood.FavouriteMenuItem
+	(*ListFavouritesRequest)(nil),                // 25: ihavefood.ListFavouritesRequest
+	(*ListFavouritesResponse)(nil),               // 26: ihavefood.ListFavouritesResponse
+	(*AddFavouriteMerchantRequest)(nil),          // 27: ihavefood.AddFavouriteMerchantRequest
+	(*RemoveFavouriteMerchantRequest)(nil),       // 28: ihavefood.RemoveFavouriteMerchantRequest
+	(*AddFavouriteMenuItemRequest)(nil),          // 29: ihavefood.AddFavouriteMenuItemRequest
+	(*RemoveFavouriteMenuItemRequest)(nil),       // 30: ihavefood.RemoveFavouriteMenuItemRequest
+	(*DataExport)(nil),                           // 31: ihavefood.DataExport
+	(*RequestDataExportRequest)(nil),             // 32: ihavefood.RequestDataExportRequest
+	(*GetDataExportRequest)(nil),                 // 33: ihavefood.GetDataExportRequest
+	(*DownloadDataExportRequest)(nil),            // 34: ihavefood.DownloadDataExportRequest
+	(*DataExportArchive)(nil),                    // 35: ihavefood.DataExportArchive
+	(*LoyaltyBalance)(nil),                       // 36: ihavefood.LoyaltyBalance
+	(*LoyaltyTransaction)(nil),                   // 37: ihavefood.LoyaltyTransaction
+	(*GetLoyaltyBalanceRequest)(nil),             // 38: ihavefood.GetLoyaltyBalanceRequest
+	(*ListLoyaltyTransactionsRequest)(nil),       // 39: ihavefood.ListLoyaltyTransactionsRequest
+	(*ListLoyaltyTransactionsResponse)(nil),      // 40: ihavefood.ListLoyaltyTransactionsResponse
+	(*RedeemLoyaltyPointsRequest)(nil),           // 41: ihavefood.RedeemLoyaltyPointsRequest
+	(*RedeemLoyaltyPointsResponse)(nil),          // 42: ihavefood.RedeemLoyaltyPointsResponse
+	(*ReverseLoyaltyRedemptionRequest)(nil),      // 43: ihavefood.ReverseLoyaltyRedemptionRequest
+	(*Wallet)(nil),                               // 44: ihavefood.Wallet
+	(*WalletTransaction)(nil),                    // 45: ihavefood.WalletTransaction
+	(*GiftCard)(nil),                             // 46: ihavefood.GiftCard
+	(*GetWalletRequest)(nil),                     // 47: ihavefood.GetWalletRequest
+	(*ListWalletTransactionsRequest)(nil),        // 48: ihavefood.ListWalletTransactionsRequest
+	(*ListWalletTransactionsResponse)(nil),       // 49: ihavefood.ListWalletTransactionsResponse
+	(*RedeemGiftCardRequest)(nil),                // 50: ihavefood.RedeemGiftCardRequest
+	(*TopUpWalletRequest)(nil),                   // 51: ihavefood.TopUpWalletRequest
+	(*IssueGiftCardRequest)(nil),                 // 52: ihavefood.IssueGiftCardRequest
+	(*PayWithWalletRequest)(nil),                 // 53: ihavefood.PayWithWalletRequest
+	(*RefundToWalletRequest)(nil),                // 54: ihavefood.RefundToWalletRequest
+	(*NotificationPreference)(nil),               // 55: ihavefood.NotificationPreference
+	(*NotificationPreferences)(nil),              // 56: ihavefood.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),    // 57: ihavefood.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 58: ihavefood.UpdateNotificationPreferencesRequest
+	(*Notification)(nil),                         // 59: ihavefood.Notification
+	(*ListNotificationsRequest)(nil),             // 60: ihavefood.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 61: ihavefood.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),         // 62: ihavefood.MarkNotificationsReadRequest
+	(*GetUnreadNotificationCountRequest)(nil),    // 63: ihavefood.GetUnreadNotificationCountRequest
+	(*UnreadNotificationCount)(nil),              // 64: ihavefood.UnreadNotificationCount
+	(*Social)(nil),                               // 65: ihavefood.Social
+	(*Address)(nil),                              // 66: ihavefood.Address
+	(*timestamppb.Timestamp)(nil),                // 67: google.protobuf.Timestamp
+	(*NewAddress)(nil),                           // 68: ihavefood.NewAddress
+	(*emptypb.Empty)(nil),                        // 69: google.protobuf.Empty
 }
 var file_customerservice_proto_depIdxs = []int32{
-	10, // 0: ihavefood.Customer.social:type_name -> ihavefood.Social
-	11, // 1: ihavefood.Customer.addresses:type_name -> ihavefood.Address
-	12, // 2: ihavefood.Customer.create_time:type_name -> google.protobuf.Timestamp
-	12, // 3: ihavefood.Customer.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 4: ihavefood.ListCustomersResponse.customers:type_name -> ihavefood.Customer
-	13, // 5: ihavefood.CreateAddressRequest.address:type_name -> ihavefood.NewAddress
-	10, // 6: ihavefood.UpdateCustomerSocialRequest.new_social:type_name -> ihavefood.Social
-	11, // 7: ihavefood.UpdateCustomerAddressRequest.address:type_name -> ihavefood.Address
-	1,  // 8: ihavefood.CustomerService.ListCustomers:input_type -> ihavefood.ListCustomersRequest
-	3,  // 9: ihavefood.CustomerService.GetCustomer:input_type -> ihavefood.GetCustomerRequest
-	4,  // 10: ihavefood.CustomerService.CreateAddress:input_type -> ihavefood.CreateAddressRequest
-	5,  // 11: ihavefood.CustomerService.UpdateCustomerInfo:input_type -> ihavefood.UpdateCustomerInfoRequest
-	6,  // 12: ihavefood.CustomerService.UpdateCustomerSocial:input_type -> ihavefood.UpdateCustomerSocialRequest
-	7,  // 13: ihavefood.CustomerService.UpdateCustomerAddress:input_type -> ihavefood.UpdateCustomerAddressRequest
-	8,  // 14: ihavefood.CustomerService.DeleteCustomer:input_type -> ihavefood.DeleteCustomerRequest
-	9,  // 15: ihavefood.CustomerService.DeleteCustomerAddress:input_type -> ihavefood.DeleteCustomerAddressRequest
-	2,  // 16: ihavefood.CustomerService.ListCustomers:output_type -> ihavefood.ListCustomersResponse
-	0,  // 17: ihavefood.CustomerService.GetCustomer:output_type -> ihavefood.Customer
-	11, // 18: ihavefood.CustomerService.CreateAddress:output_type -> ihavefood.Address
-	0,  // 19: ihavefood.CustomerService.UpdateCustomerInfo:output_type -> ihavefood.Customer
-	0,  // 20: ihavefood.CustomerService.UpdateCustomerSocial:output_type -> ihavefood.Customer
-	11, // 21: ihavefood.CustomerService.UpdateCustomerAddress:output_type -> ihavefood.Address
-	14, // 22: ihavefood.CustomerService.DeleteCustomer:output_type -> google.protobuf.Empty
-	14, // 23: ihavefood.CustomerService.DeleteCustomerAddress:output_type -> google.protobuf.Empty
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	65, // 0: ihavefood.Customer.social:type_name -> ihavefood.Social
+	66, // 1: ihavefood.Customer.addresses:type_name -> ihavefood.Address
+	67, // 2: ihavefood.Customer.create_time:type_name -> google.protobuf.Timestamp
+	67, // 3: ihavefood.Customer.update_time:type_name -> google.protobuf.Timestamp
+	8,  // 4: ihavefood.Customer.picture:type_name -> ihavefood.CustomerPicture
+	67, // 5: ihavefood.Customer.last_order_time:type_name -> google.protobuf.Timestamp
+	67, // 6: ihavefood.CustomerPicture.update_time:type_name -> google.protobuf.Timestamp
+	67, // 7: ihavefood.ListCustomersRequest.create_time_from:type_name -> google.protobuf.Timestamp
+	67, // 8: ihavefood.ListCustomersRequest.create_time_to:type_name -> google.protobuf.Timestamp
+	1,  // 9: ihavefood.ListCustomersRequest.orders:type_name -> ihavefood.CustomerOrdersFilter
+	0,  // 10: ihavefood.ListCustomersRequest.sort:type_name -> ihavefood.CustomerSort
+	7,  // 11: ihavefood.ListCustomersResponse.customers:type_name -> ihavefood.Customer
+	68, // 12: ihavefood.CreateAddressRequest.address:type_name -> ihavefood.NewAddress
+	65, // 13: ihavefood.UpdateCustomerSocialRequest.new_social:type_name -> ihavefood.Social
+	66, // 14: ihavefood.UpdateCustomerAddressRequest.address:type_name -> ihavefood.Address
+	21, // 15: ihavefood.SuggestAddressesResponse.suggestions:type_name -> ihavefood.AddressSuggestion
+	67, // 16: ihavefood.FavouriteMerchant.create_time:type_name -> google.protobuf.Timestamp
+	67, // 17: ihavefood.FavouriteMenuItem.create_time:type_name -> google.protobuf.Timestamp
+	23, // 18: ihavefood.ListFavouritesResponse.merchants:type_name -> ihavefood.FavouriteMerchant
+	24, // 19: ihavefood.ListFavouritesResponse.menu_items:type_name -> ihavefood.FavouriteMenuItem
+	2,  // 20: ihavefood.DataExport.state:type_name -> ihavefood.DataExportState
+	67, // 21: ihavefood.DataExport.create_time:type_name -> google.protobuf.Timestamp
+	67, // 22: ihavefood.DataExport.complete_time:type_name -> google.protobuf.Timestamp
+	67, // 23: ihavefood.DataExport.expire_time:type_name -> google.protobuf.Timestamp
+	67, // 24: ihavefood.LoyaltyBalance.next_expire_time:type_name -> google.protobuf.Timestamp
+	3,  // 25: ihavefood.LoyaltyTransaction.type:type_name -> ihavefood.LoyaltyTransactionType
+	67, // 26: ihavefood.LoyaltyTransaction.expire_time:type_name -> google.protobuf.Timestamp
+	67, // 27: ihavefood.LoyaltyTransaction.create_time:type_name -> google.protobuf.Timestamp
+	37, // 28: ihavefood.ListLoyaltyTransactionsResponse.transactions:type_name -> ihavefood.LoyaltyTransaction
+	4,  // 29: ihavefood.WalletTransaction.type:type_name -> ihavefood.WalletTransactionType
+	67, // 30: ihavefood.WalletTransaction.create_time:type_name -> google.protobuf.Timestamp
+	67, // 31: ihavefood.GiftCard.expire_time:type_name -> google.protobuf.Timestamp
+	67, // 32: ihavefood.GiftCard.create_time:type_name -> google.protobuf.Timestamp
+	45, // 33: ihavefood.ListWalletTransactionsResponse.transactions:type_name -> ihavefood.WalletTransaction
+	67, // 34: ihavefood.IssueGiftCardRequest.expire_time:type_name -> google.protobuf.Timestamp
+	6,  // 35: ihavefood.NotificationPreference.category:type_name -> ihavefood.NotificationCategory
+	5,  // 36: ihavefood.NotificationPreference.channel:type_name -> ihavefood.NotificationChannel
+	55, // 37: ihavefood.NotificationPreferences.preferences:type_name -> ihavefood.NotificationPreference
+	55, // 38: ihavefood.UpdateNotificationPreferencesRequest.preferences:type_name -> ihavefood.NotificationPreference
+	6,  // 39: ihavefood.Notification.category:type_name -> ihavefood.NotificationCategory
+	67, // 40: ihavefood.Notification.read_time:type_name -> google.protobuf.Timestamp
+	67, // 41: ihavefood.Notification.create_time:type_name -> google.protobuf.Timestamp
+	59, // 42: ihavefood.ListNotificationsResponse.notifications:type_name -> ihavefood.Notification
+	9,  // 43: ihavefood.CustomerService.ListCustomers:input_type -> ihavefood.ListCustomersRequest
+	11, // 44: ihavefood.CustomerService.GetCustomer:input_type -> ihavefood.GetCustomerRequest
+	12, // 45: ihavefood.CustomerService.CreateAddress:input_type -> ihavefood.CreateAddressRequest
+	13, // 46: ihavefood.CustomerService.UpdateCustomerInfo:input_type -> ihavefood.UpdateCustomerInfoRequest
+	16, // 47: ihavefood.CustomerService.UpdateCustomerSocial:input_type -> ihavefood.UpdateCustomerSocialRequest
+	14, // 48: ihavefood.CustomerService.UploadCustomerPicture:input_type -> ihavefood.UploadCustomerPictureRequest
+	15, // 49: ihavefood.CustomerService.DeleteCustomerPicture:input_type -> ihavefood.DeleteCustomerPictureRequest
+	17, // 50: ihavefood.CustomerService.UpdateCustomerAddress:input_type -> ihavefood.UpdateCustomerAddressRequest
+	18, // 51: ihavefood.CustomerService.DeleteCustomer:input_type -> ihavefood.DeleteCustomerRequest
+	19, // 52: ihavefood.CustomerService.DeleteCustomerAddress:input_type -> ihavefood.DeleteCustomerAddressRequest
+	20, // 53: ihavefood.CustomerService.SuggestAddresses:input_type -> ihavefood.SuggestAddressesRequest
+	25, // 54: ihavefood.CustomerService.ListFavourites:input_type -> ihavefood.ListFavouritesRequest
+	27, // 55: ihavefood.CustomerService.AddFavouriteMerchant:input_type -> ihavefood.AddFavouriteMerchantRequest
+	28, // 56: ihavefood.CustomerService.RemoveFavouriteMerchant:input_type -> ihavefood.RemoveFavouriteMerchantRequest
+	29, // 57: ihavefood.CustomerService.AddFavouriteMenuItem:input_type -> ihavefood.AddFavouriteMenuItemRequest
+	30, // 58: ihavefood.CustomerService.RemoveFavouriteMenuItem:input_type -> ihavefood.RemoveFavouriteMenuItemRequest
+	32, // 59: ihavefood.CustomerService.RequestDataExport:input_type -> ihavefood.RequestDataExportRequest
+	33, // 60: ihavefood.CustomerService.GetDataExport:input_type -> ihavefood.GetDataExportRequest
+	34, // 61: ihavefood.CustomerService.DownloadDataExport:input_type -> ihavefood.DownloadDataExportRequest
+	38, // 62: ihavefood.CustomerService.GetLoyaltyBalance:input_type -> ihavefood.GetLoyaltyBalanceRequest
+	39, // 63: ihavefood.CustomerService.ListLoyaltyTransactions:input_type -> ihavefood.ListLoyaltyTransactionsRequest
+	41, // 64: ihavefood.CustomerService.RedeemLoyaltyPoints:input_type -> ihavefood.RedeemLoyaltyPointsRequest
+	43, // 65: ihavefood.CustomerService.ReverseLoyaltyRedemption:input_type -> ihavefood.ReverseLoyaltyRedemptionRequest
+	47, // 66: ihavefood.CustomerService.GetWallet:input_type -> ihavefood.GetWalletRequest
+	48, // 67: ihavefood.CustomerService.ListWalletTransactions:input_type -> ihavefood.ListWalletTransactionsRequest
+	50, // 68: ihavefood.CustomerService.RedeemGiftCard:input_type -> ihavefood.RedeemGiftCardRequest
+	51, // 69: ihavefood.CustomerService.TopUpWallet:input_type -> ihavefood.TopUpWalletRequest
+	52, // 70: ihavefood.CustomerService.IssueGiftCard:input_type -> ihavefood.IssueGiftCardRequest
+	53, // 71: ihavefood.CustomerService.PayWithWallet:input_type -> ihavefood.PayWithWalletRequest
+	54, // 72: ihavefood.CustomerService.RefundToWallet:input_type -> ihavefood.RefundToWalletRequest
+	57, // 73: ihavefood.CustomerService.GetNotificationPreferences:input_type -> ihavefood.GetNotificationPreferencesRequest
+	58, // 74: ihavefood.CustomerService.UpdateNotificationPreferences:input_type -> ihavefood.UpdateNotificationPreferencesRequest
+	60, // 75: ihavefood.CustomerService.ListNotifications:input_type -> ihavefood.ListNotificationsRequest
+	62, // 76: ihavefood.CustomerService.MarkNotificationsRead:input_type -> ihavefood.MarkNotificationsReadRequest
+	63, // 77: ihavefood.CustomerService.GetUnreadNotificationCount:input_type -> ihavefood.GetUnreadNotificationCountRequest
+	10, // 78: ihavefood.CustomerService.ListCustomers:output_type -> ihavefood.ListCustomersResponse
+	7,  // 79: ihavefood.CustomerService.GetCustomer:output_type -> ihavefood.Customer
+	66, // 80: ihavefood.CustomerService.CreateAddress:output_type -> ihavefood.Address
+	7,  // 81: ihavefood.CustomerService.UpdateCustomerInfo:output_type -> ihavefood.Customer
+	7,  // 82: ihavefood.CustomerService.UpdateCustomerSocial:output_type -> ihavefood.Customer
+	8,  // 83: ihavefood.CustomerService.UploadCustomerPicture:output_type -> ihavefood.CustomerPicture
+	69, // 84: ihavefood.CustomerService.DeleteCustomerPicture:output_type -> google.protobuf.Empty
+	66, // 85: ihavefood.CustomerService.UpdateCustomerAddress:output_type -> ihavefood.Address
+	69, // 86: ihavefood.CustomerService.DeleteCustomer:output_type -> google.protobuf.Empty
+	69, // 87: ihavefood.CustomerService.DeleteCustomerAddress:output_type -> google.protobuf.Empty
+	22, // 88: ihavefood.CustomerService.SuggestAddresses:output_type -> ihavefood.SuggestAddressesResponse
+	26, // 89: ihavefood.CustomerService.ListFavourites:output_type -> ihavefood.ListFavouritesResponse
+	23, // 90: ihavefood.CustomerService.AddFavouriteMerchant:output_type -> ihavefood.FavouriteMerchant
+	69, // 91: ihavefood.CustomerService.RemoveFavouriteMerchant:output_type -> google.protobuf.Empty
+	24, // 92: ihavefood.CustomerService.AddFavouriteMenuItem:output_type -> ihavefood.FavouriteMenuItem
+	69, // 93: ihavefood.CustomerService.RemoveFavouriteMenuItem:output_type -> google.protobuf.Empty
+	31, // 94: ihavefood.CustomerService.RequestDataExport:output_type -> ihavefood.DataExport
+	31, // 95: ihavefood.CustomerService.GetDataExport:output_type -> ihavefood.DataExport
+	35, // 96: ihavefood.CustomerService.DownloadDataExport:output_type -> ihavefood.DataExportArchive
+	36, // 97: ihavefood.CustomerService.GetLoyaltyBalance:output_type -> ihavefood.LoyaltyBalance
+	40, // 98: ihavefood.CustomerService.ListLoyaltyTransactions:output_type -> ihavefood.ListLoyaltyTransactionsResponse
+	42, // 99: ihavefood.CustomerService.RedeemLoyaltyPoints:output_type -> ihavefood.RedeemLoyaltyPointsResponse
+	36, // 100: ihavefood.CustomerService.ReverseLoyaltyRedemption:output_type -> ihavefood.LoyaltyBalance
+	44, // 101: ihavefood.CustomerService.GetWallet:output_type -> ihavefood.Wallet
+	49, // 102: ihavefood.CustomerService.ListWalletTransactions:output_type -> ihavefood.ListWalletTransactionsResponse
+	45, // 103: ihavefood.CustomerService.RedeemGiftCard:output_type -> ihavefood.WalletTransaction
+	45, // 104: ihavefood.CustomerService.TopUpWallet:output_type -> ihavefood.WalletTransaction
+	46, // 105: ihavefood.CustomerService.IssueGiftCard:output_type -> ihavefood.GiftCard
+	45, // 106: ihavefood.CustomerService.PayWithWallet:output_type -> ihavefood.WalletTransaction
+	45, // 107: ihavefood.CustomerService.RefundToWallet:output_type -> ihavefood.WalletTransaction
+	56, // 108: ihavefood.CustomerService.GetNotificationPreferences:output_type -> ihavefood.NotificationPreferences
+	56, // 109: ihavefood.CustomerService.UpdateNotificationPreferences:output_type -> ihavefood.NotificationPreferences
+	61, // 110: ihavefood.CustomerService.ListNotifications:output_type -> ihavefood.ListNotificationsResponse
+	64, // 111: ihavefood.CustomerService.MarkNotificationsRead:output_type -> ihavefood.UnreadNotificationCount
+	64, // 112: ihavefood.CustomerService.GetUnreadNotificationCount:output_type -> ihavefood.UnreadNotificationCount
+	78, // [78:113] is the sub-list for method output_type
+	43, // [43:78] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_customerservice_proto_init() }
@@ -703,13 +4535,14 @@ func file_customerservice_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_customerservice_proto_rawDesc), len(file_customerservice_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      7,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_customerservice_proto_goTypes,
 		DependencyIndexes: file_customerservice_proto_depIdxs,
+		EnumInfos:         file_customerservice_proto_enumTypes,
 		MessageInfos:      file_customerservice_proto_msgTypes,
 	}.Build()
 	File_customerservice_proto = out.File
@@ -4,17 +4,19 @@ import (
 	"time"
 
 	// "google.golang.org/protobuf/types/known/timestamppb"
-	"github.com/google/uuid"
 	pb "github.com/pongsathonn/ihavefood/src/orderservice/genproto"
 )
 
 type newPlaceOrder struct {
+	OrderID         string
 	RequestID       string
 	CustomerID      string
 	MerchantID      string
 	Items           []*dbOrderItem
 	CouponCode      string
 	Discount        int32
+	PointsRedeemed  int32
+	PointsDiscount  int32
 	DeliveryFee     int32
 	Total           int32
 	CustomerAddress *dbAddress
@@ -33,6 +35,8 @@ type dbPlaceOrder struct {
 	Items           []*dbOrderItem   `bson:"menus"`
 	CouponCode      string           `bson:"couponCode"`
 	Discount        int32            `bson:"discount"`
+	PointsRedeemed  int32            `bson:"pointsRedeemed,omitempty"`
+	PointsDiscount  int32            `bson:"pointsDiscount,omitempty"`
 	DeliveryFee     int32            `bson:"deliveryFee"`
 	Total           int32            `bson:"total"`
 	CustomerAddress *dbAddress       `bson:"customerAddress"`
@@ -51,11 +55,17 @@ type dbOrderItem struct {
 }
 
 type dbAddress struct {
-	AddressName string `bson:"addressName"`
-	SubDistrict string `bson:"subDistrict"`
-	District    string `bson:"district"`
-	Province    string `bson:"province"`
-	PostalCode  string `bson:"postalCode"`
+	AddressName          string  `bson:"addressName"`
+	SubDistrict          string  `bson:"subDistrict"`
+	District             string  `bson:"district"`
+	Province             string  `bson:"province"`
+	PostalCode           string  `bson:"postalCode"`
+	Latitude             float64 `bson:"latitude,omitempty"`
+	Longitude            float64 `bson:"longitude,omitempty"`
+	LocationApproximate  bool    `bson:"locationApproximate,omitempty"`
+	Detail               string  `bson:"detail,omitempty"`
+	Landmark             string  `bson:"landmark,omitempty"`
+	DeliveryInstructions string  `bson:"deliveryInstructions,omitempty"`
 }
 
 type dbPaymentMethods int32
@@ -83,6 +93,14 @@ const (
 	OrderStatus_CANCELLED       dbOrderStatus = 5
 )
 
+// orderStatusCancelled is stored as the pb value, like the statuses set by
+// handleOrderStatus, so that it reads back as ORDER_STATUS_CANCELLED.
+const orderStatusCancelled = dbOrderStatus(pb.OrderStatus_ORDER_STATUS_CANCELLED)
+
+// paymentMethodWallet is stored as the pb value, like every payment method
+// of a new order.
+const paymentMethodWallet = dbPaymentMethods(pb.PaymentMethods_PAYMENT_METHOD_WALLET)
+
 type dbTimestamps struct {
 	CreateTime   time.Time `bson:"createTime"`
 	UpdateTime   time.Time `bson:"updateTime"`
@@ -107,13 +125,15 @@ func toDbPlaceOrder(n *newPlaceOrder) *dbPlaceOrder {
 
 	now := time.Now()
 	return &dbPlaceOrder{
-		OrderID:         uuid.New().String(),
+		OrderID:         n.OrderID,
 		RequestID:       n.RequestID,
 		CustomerID:      n.CustomerID,
 		MerchantID:      n.MerchantID,
 		Items:           n.Items,
 		CouponCode:      n.CouponCode,
 		Discount:        n.Discount,
+		PointsRedeemed:  n.PointsRedeemed,
+		PointsDiscount:  n.PointsDiscount,
 		DeliveryFee:     n.DeliveryFee,
 		Total:           n.Total,
 		CustomerAddress: n.CustomerAddress,
@@ -135,11 +155,17 @@ func toDbAddress(addr *pb.Address) *dbAddress {
 		return nil
 	}
 	return &dbAddress{
-		AddressName: addr.AddressName,
-		SubDistrict: addr.SubDistrict,
-		District:    addr.District,
-		Province:    addr.Province,
-		PostalCode:  addr.PostalCode,
+		AddressName:          addr.AddressName,
+		SubDistrict:          addr.SubDistrict,
+		District:             addr.District,
+		Province:             addr.Province,
+		PostalCode:           addr.PostalCode,
+		Latitude:             addr.Latitude,
+		Longitude:            addr.Longitude,
+		LocationApproximate:  addr.LocationApproximate,
+		Detail:               addr.Detail,
+		Landmark:             addr.Landmark,
+		DeliveryInstructions: addr.DeliveryInstructions,
 	}
 }
 
@@ -148,11 +174,17 @@ func toProtoAddress(addr *dbAddress) *pb.Address {
 		return nil
 	}
 	return &pb.Address{
-		AddressName: addr.AddressName,
-		SubDistrict: addr.SubDistrict,
-		District:    addr.District,
-		Province:    addr.Province,
-		PostalCode:  addr.PostalCode,
+		AddressName:          addr.AddressName,
+		SubDistrict:          addr.SubDistrict,
+		District:             addr.District,
+		Province:             addr.Province,
+		PostalCode:           addr.PostalCode,
+		Latitude:             addr.Latitude,
+		Longitude:            addr.Longitude,
+		LocationApproximate:  addr.LocationApproximate,
+		Detail:               addr.Detail,
+		Landmark:             addr.Landmark,
+		DeliveryInstructions: addr.DeliveryInstructions,
 	}
 }
 
@@ -178,6 +210,8 @@ func toProtoPlaceOrder(order *dbPlaceOrder) *pb.PlaceOrder {
 		MerchantId:      order.MerchantID,
 		Items:           items,
 		CouponDiscount:  order.Discount,
+		PointsRedeemed:  order.PointsRedeemed,
+		PointsDiscount:  order.PointsDiscount,
 		DeliveryFee:     order.DeliveryFee,
 		Total:           order.Total,
 		CustomerAddress: toProtoAddress(order.CustomerAddress),
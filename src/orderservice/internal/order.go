@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/pongsathonn/ihavefood/src/orderservice/genproto"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -23,6 +26,10 @@ type CouponClient interface {
 
 type CustomerClient interface {
 	GetCustomer(ctx context.Context, in *pb.GetCustomerRequest, opts ...grpc.CallOption) (*pb.Customer, error)
+	RedeemLoyaltyPoints(ctx context.Context, in *pb.RedeemLoyaltyPointsRequest, opts ...grpc.CallOption) (*pb.RedeemLoyaltyPointsResponse, error)
+	ReverseLoyaltyRedemption(ctx context.Context, in *pb.ReverseLoyaltyRedemptionRequest, opts ...grpc.CallOption) (*pb.LoyaltyBalance, error)
+	PayWithWallet(ctx context.Context, in *pb.PayWithWalletRequest, opts ...grpc.CallOption) (*pb.WalletTransaction, error)
+	RefundToWallet(ctx context.Context, in *pb.RefundToWalletRequest, opts ...grpc.CallOption) (*pb.WalletTransaction, error)
 }
 
 type DeliveryClient interface {
@@ -98,17 +105,28 @@ func (x *OrderService) CreatePlaceOrder(ctx context.Context, in *pb.CreatePlaceO
 
 	newOrder, err := x.prepareNewOrder(in)
 	if err != nil {
+		if errors.Is(err, errAddressNotFound) || errors.Is(err, errPointsNotRedeemed) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		slog.Error("Failed to prepare new order", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
+	var success bool
+	if newOrder.PointsRedeemed > 0 {
+		defer func() {
+			if !success {
+				x.reversePoints(ctx, newOrder.CustomerID, newOrder.OrderID)
+			}
+		}()
+	}
+
 	orderID, err := x.storage.Create(ctx, newOrder)
 	if err != nil {
 		slog.Error("storage create new order", "err", err)
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	var success bool
 	defer func() {
 		if !success {
 			if err := x.storage.DeletePlaceOrder(ctx, orderID); err != nil {
@@ -117,6 +135,32 @@ func (x *OrderService) CreatePlaceOrder(ctx context.Context, in *pb.CreatePlaceO
 		}
 	}()
 
+	if newOrder.PaymentMethods == paymentMethodWallet {
+		amount := int64(newOrder.Total) + int64(newOrder.DeliveryFee)
+		if _, err := x.customer.PayWithWallet(ctx, &pb.PayWithWalletRequest{
+			CustomerId: newOrder.CustomerID,
+			OrderId:    orderID,
+			Amount:     amount,
+		}); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				return nil, status.Error(codes.FailedPrecondition, status.Convert(err).Message())
+			}
+			slog.Error("pay with wallet", "err", err, "orderId", orderID)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		defer func() {
+			if !success {
+				x.refundWallet(ctx, newOrder.CustomerID, orderID, amount)
+			}
+		}()
+
+		if _, err := x.storage.UpdatePaymentStatus(ctx, orderID, PaymentStatus_PAID); err != nil {
+			slog.Error("storage update payment status", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
 	dbOrder, err := x.storage.GetPlaceOrder(ctx, orderID)
 	if err != nil {
 		slog.Error("storage get place order", "err", err)
@@ -145,6 +189,110 @@ func (x *OrderService) CreatePlaceOrder(ctx context.Context, in *pb.CreatePlaceO
 	return order, nil
 }
 
+// CancelPlaceOrder cancels an order the merchant has not accepted yet, gives
+// back its loyalty points and refunds it to the wallet when it was paid.
+// Cancelling a cancelled order retries giving back the points and the
+// refund.
+func (x *OrderService) CancelPlaceOrder(ctx context.Context, in *pb.CancelPlaceOrderRequest) (*pb.PlaceOrder, error) {
+
+	if in.OrderId == "" || in.CustomerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id and customer id must be provided")
+	}
+
+	order, err := x.storage.GetPlaceOrder(ctx, in.OrderId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		slog.Error("storage get place order", "err", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if order.CustomerID != in.CustomerId {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	switch order.OrderStatus {
+	case orderStatusCancelled:
+	case OrderStatus_PENDING:
+		order, err = x.storage.CancelPlaceOrder(ctx, in.OrderId)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, status.Error(codes.FailedPrecondition, "order can no longer be cancelled")
+			}
+			slog.Error("storage cancel place order", "err", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		x.publishOrderCancelled(ctx, order)
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "order can no longer be cancelled")
+	}
+
+	if order.PointsRedeemed > 0 {
+		if _, err := x.customer.ReverseLoyaltyRedemption(ctx, &pb.ReverseLoyaltyRedemptionRequest{
+			CustomerId: order.CustomerID,
+			OrderId:    order.OrderID,
+		}); err != nil {
+			slog.Error("reverse loyalty redemption", "err", err, "orderId", order.OrderID)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	if order.PaymentStatus == PaymentStatus_PAID {
+		if _, err := x.customer.RefundToWallet(ctx, &pb.RefundToWalletRequest{
+			CustomerId: order.CustomerID,
+			OrderId:    order.OrderID,
+			Amount:     int64(order.Total) + int64(order.DeliveryFee),
+		}); err != nil {
+			slog.Error("refund to wallet", "err", err, "orderId", order.OrderID)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return toProtoPlaceOrder(order), nil
+}
+
+func (x *OrderService) publishOrderCancelled(ctx context.Context, order *dbPlaceOrder) {
+
+	body, err := proto.Marshal(&pb.OrderCancelledEvent{
+		Order:      toProtoPlaceOrder(order),
+		CancelTime: timestamppb.Now(),
+	})
+	if err != nil {
+		slog.Error("protobuf marshal failed", "err", err)
+		return
+	}
+
+	if err := x.rabbitmq.Publish(ctx, "order.cancelled.event", amqp.Publishing{
+		Type: "ihavefood.OrderCancelledEvent",
+		Body: body,
+	}); err != nil {
+		slog.Error("rabbitmq publish", "err", err, "orderId", order.OrderID)
+	}
+}
+
+// reversePoints gives back the points redeemed for an order that was not
+// placed after all.
+func (x *OrderService) reversePoints(ctx context.Context, customerID, orderID string) {
+	if _, err := x.customer.ReverseLoyaltyRedemption(ctx, &pb.ReverseLoyaltyRedemptionRequest{
+		CustomerId: customerID,
+		OrderId:    orderID,
+	}); err != nil {
+		slog.Error("reverse loyalty redemption", "err", err, "orderId", orderID)
+	}
+}
+
+// refundWallet refunds the wallet payment of an order that was not placed
+// after all.
+func (x *OrderService) refundWallet(ctx context.Context, customerID, orderID string, amount int64) {
+	if _, err := x.customer.RefundToWallet(ctx, &pb.RefundToWalletRequest{
+		CustomerId: customerID,
+		OrderId:    orderID,
+		Amount:     amount,
+	}); err != nil {
+		slog.Error("refund to wallet", "err", err, "orderId", orderID)
+	}
+}
+
 //---------------------------------------------------------------------------------------
 
 func (x *OrderService) StartConsume() {
@@ -159,6 +307,8 @@ func (x *OrderService) StartConsume() {
 		{"order_status_update_queue", "rider.assigned.event", x.handleOrderStatus()},
 		{"order_status_update_queue", "rider.delivered.event", x.handleOrderStatus()},
 		{"payment_status_update_queue", "order.paid.event", x.handlePaymentStatus()},
+		{"order_customer_deleted_queue", "sync.customer.deleted", x.handleCustomerDeletion()},
+		{"order_customer_merged_queue", "sync.customer.merged", x.handleCustomerMerge()},
 	}
 
 	for _, r := range registerEvent {
@@ -214,11 +364,42 @@ func (x *OrderService) handleOrderStatus() chan<- amqp.Delivery {
 				slog.Error("updated status", "err", err, "orderId", orderID)
 				continue
 			}
+
+			if status == pb.OrderStatus_ORDER_STATUS_DELIVERED {
+				x.publishOrderDelivered(context.TODO(), orderID)
+			}
 		}
 	}()
 	return messages
 }
 
+// publishOrderDelivered publishes the delivered order, for the customer to
+// earn loyalty points.
+func (x *OrderService) publishOrderDelivered(ctx context.Context, orderID string) {
+
+	order, err := x.storage.GetPlaceOrder(ctx, orderID)
+	if err != nil {
+		slog.Error("storage get place order", "err", err, "orderId", orderID)
+		return
+	}
+
+	body, err := proto.Marshal(&pb.OrderDeliveredEvent{
+		Order:       toProtoPlaceOrder(order),
+		DeliverTime: timestamppb.Now(),
+	})
+	if err != nil {
+		slog.Error("protobuf marshal failed", "err", err)
+		return
+	}
+
+	if err := x.rabbitmq.Publish(ctx, "order.delivered.event", amqp.Publishing{
+		Type: "ihavefood.OrderDeliveredEvent",
+		Body: body,
+	}); err != nil {
+		slog.Error("rabbitmq publish", "err", err, "orderId", orderID)
+	}
+}
+
 // handlePaymentStatus updates payment status of an order after
 // it has been successfully processed.
 //   - Cash method update when rider received cash from customer after delivery.
@@ -256,6 +437,61 @@ func (x *OrderService) handlePaymentStatus() chan<- amqp.Delivery {
 	return messages
 }
 
+// handleCustomerDeletion anonymises the orders of a customer whose account
+// was deleted in auth.
+func (x *OrderService) handleCustomerDeletion() chan<- amqp.Delivery {
+
+	messages := make(chan amqp.Delivery)
+
+	go func() {
+		for msg := range messages {
+
+			var deleted pb.SyncAccountDeleted
+			if err := proto.Unmarshal(msg.Body, &deleted); err != nil {
+				slog.Error("unmarshal failed", "err", err)
+				continue
+			}
+
+			n, err := x.storage.AnonymizeCustomerOrders(context.TODO(), deleted.AuthId)
+			if err != nil {
+				slog.Error("anonymize customer orders", "err", err, "customerId", deleted.AuthId)
+				continue
+			}
+
+			slog.Info("anonymized orders of a deleted customer", "customerId", deleted.AuthId, "orders", n)
+		}
+	}()
+	return messages
+}
+
+// handleCustomerMerge moves the orders of a guest to the customer account it
+// merged into, so the order history is kept.
+func (x *OrderService) handleCustomerMerge() chan<- amqp.Delivery {
+
+	messages := make(chan amqp.Delivery)
+
+	go func() {
+		for msg := range messages {
+
+			var merged pb.SyncCustomerMerged
+			if err := proto.Unmarshal(msg.Body, &merged); err != nil {
+				slog.Error("unmarshal failed", "err", err)
+				continue
+			}
+
+			n, err := x.storage.MergeCustomerOrders(context.TODO(), merged.SourceCustomerId, merged.TargetCustomerId)
+			if err != nil {
+				slog.Error("merge customer orders", "err", err, "customerId", merged.TargetCustomerId)
+				continue
+			}
+
+			slog.Info("moved orders of a merged guest", "guestId", merged.SourceCustomerId,
+				"customerId", merged.TargetCustomerId, "orders", n)
+		}
+	}()
+	return messages
+}
+
 // prepareNewOrder validates order dependencies and calculates totals. then build the complete order
 // for database insertion.
 func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*newPlaceOrder, error) {
@@ -269,6 +505,11 @@ func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*n
 		return nil, err
 	}
 
+	selectedAddr, err := selectCustomerAddress(customer.Addresses, newOrder.CustomerAddressId)
+	if err != nil {
+		return nil, err
+	}
+
 	merchant, err := x.merchant.GetMerchant(ctx, &pb.GetMerchantRequest{MerchantId: newOrder.MerchantId})
 	if err != nil {
 		return nil, err
@@ -276,7 +517,7 @@ func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*n
 
 	deliveryFee, err := x.delivery.GetDeliveryFee(ctx, &pb.GetDeliveryFeeRequest{
 		CustomerId:        newOrder.CustomerId,
-		CustomerAddressId: newOrder.CustomerAddressId,
+		CustomerAddressId: selectedAddr.AddressId,
 		MerchantId:        newOrder.MerchantId,
 	})
 	if err != nil {
@@ -291,9 +532,30 @@ func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*n
 		}
 	}
 
+	orderID := uuid.New().String()
+
 	foodCost := calcFoodCost(merchant.Menu, newOrder.Items)
 	total := foodCost - newOrder.Discount
 
+	var pointsDiscount int32
+	if newOrder.RedeemPoints > 0 {
+		redeemed, err := x.customer.RedeemLoyaltyPoints(ctx, &pb.RedeemLoyaltyPointsRequest{
+			CustomerId:  newOrder.CustomerId,
+			OrderId:     orderID,
+			Points:      newOrder.RedeemPoints,
+			OrderAmount: total,
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				return nil, fmt.Errorf("%w: %s", errPointsNotRedeemed, status.Convert(err).Message())
+			}
+			return nil, fmt.Errorf("Failed to redeem loyalty points: %v", err)
+		}
+		pointsDiscount = redeemed.Discount
+		total -= pointsDiscount
+	}
+
 	var items []*dbOrderItem
 	for _, item := range newOrder.Items {
 		items = append(items, &dbOrderItem{
@@ -303,20 +565,16 @@ func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*n
 		})
 	}
 
-	var selectedAddr *pb.Address
-	for _, addr := range customer.Addresses {
-		if addr.AddressId == newOrder.CustomerAddressId {
-			selectedAddr = addr
-		}
-	}
-
 	return &newPlaceOrder{
+		OrderID:         orderID,
 		RequestID:       newOrder.RequestId,
 		CustomerID:      newOrder.CustomerId,
 		MerchantID:      newOrder.MerchantId,
 		Items:           items,
 		CouponCode:      newOrder.CouponCode,
 		Discount:        newOrder.Discount,
+		PointsRedeemed:  newOrder.RedeemPoints,
+		PointsDiscount:  pointsDiscount,
 		DeliveryFee:     deliveryFee.Fee,
 		Total:           total,
 		CustomerAddress: toDbAddress(selectedAddr),
@@ -326,6 +584,27 @@ func (x *OrderService) prepareNewOrder(newOrder *pb.CreatePlaceOrderRequest) (*n
 	}, nil
 }
 
+var (
+	errAddressNotFound   = errors.New("customer address not found")
+	errPointsNotRedeemed = errors.New("loyalty points not redeemed")
+)
+
+// selectCustomerAddress returns the address with the ID, or the default
+// address when the ID is empty.
+func selectCustomerAddress(addresses []*pb.Address, addressID string) (*pb.Address, error) {
+
+	for _, addr := range addresses {
+		if addressID == "" && addr.IsDefault || addressID != "" && addr.AddressId == addressID {
+			return addr, nil
+		}
+	}
+
+	if addressID == "" {
+		return nil, fmt.Errorf("%w: customer has no default address", errAddressNotFound)
+	}
+	return nil, errAddressNotFound
+}
+
 func calcFoodCost(menu []*pb.MenuItem, orderItems []*pb.OrderItem) int32 {
 	menuPrices := make(map[string]int32)
 	for _, menuItem := range menu {
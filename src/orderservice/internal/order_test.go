@@ -58,6 +58,24 @@ func (m *MockStorage) DeletePlaceOrder(ctx context.Context, orderID string) erro
 	return args.Error(0)
 }
 
+func (m *MockStorage) CancelPlaceOrder(ctx context.Context, orderID string) (*dbPlaceOrder, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dbPlaceOrder), args.Error(1)
+}
+
+func (m *MockStorage) AnonymizeCustomerOrders(ctx context.Context, customerID string) (int64, error) {
+	args := m.Called(ctx, customerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockStorage) MergeCustomerOrders(ctx context.Context, sourceID, targetID string) (int64, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // Mock RabbitMQ implementation
 type MockRabbitMQ struct {
 	mock.Mock
@@ -101,6 +119,38 @@ func (m *MockCustomerClient) GetCustomer(ctx context.Context, in *pb.GetCustomer
 	return args.Get(0).(*pb.Customer), args.Error(1)
 }
 
+func (m *MockCustomerClient) RedeemLoyaltyPoints(ctx context.Context, in *pb.RedeemLoyaltyPointsRequest, opts ...grpc.CallOption) (*pb.RedeemLoyaltyPointsResponse, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.RedeemLoyaltyPointsResponse), args.Error(1)
+}
+
+func (m *MockCustomerClient) ReverseLoyaltyRedemption(ctx context.Context, in *pb.ReverseLoyaltyRedemptionRequest, opts ...grpc.CallOption) (*pb.LoyaltyBalance, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.LoyaltyBalance), args.Error(1)
+}
+
+func (m *MockCustomerClient) PayWithWallet(ctx context.Context, in *pb.PayWithWalletRequest, opts ...grpc.CallOption) (*pb.WalletTransaction, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WalletTransaction), args.Error(1)
+}
+
+func (m *MockCustomerClient) RefundToWallet(ctx context.Context, in *pb.RefundToWalletRequest, opts ...grpc.CallOption) (*pb.WalletTransaction, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WalletTransaction), args.Error(1)
+}
+
 type MockDeliveryClient struct {
 	mock.Mock
 }
@@ -268,7 +318,7 @@ func TestCreatePlaceOrder_Failure_DeletesOrder(t *testing.T) {
 	mockCustomer.On("GetCustomer", mock.Anything, mock.Anything).Return(&pb.Customer{
 		CustomerId: "cust-123",
 		Phone:      "0812345678",
-		Addresses:  []*pb.Address{{AddressId: "addr-123"}},
+		Addresses:  []*pb.Address{{AddressId: "40000000-0000-4000-8000-000000000004"}},
 	}, nil)
 	mockMerchant.On("GetMerchant", mock.Anything, mock.Anything).Return(&pb.Merchant{
 		MerchantId: "merch-123",
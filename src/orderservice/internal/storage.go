@@ -7,6 +7,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type OrderStorage interface {
@@ -24,6 +25,18 @@ type OrderStorage interface {
 	UpdatePaymentStatus(ctx context.Context, orderID string, status dbPaymentStatus) (bool, error)
 
 	DeletePlaceOrder(ctx context.Context, orderID string) error
+
+	// CancelPlaceOrder cancels the order if it is still pending and returns
+	// it. It returns mongo.ErrNoDocuments when there is no pending order.
+	CancelPlaceOrder(ctx context.Context, orderID string) (*dbPlaceOrder, error)
+
+	// AnonymizeCustomerOrders removes the personal data of a deleted customer
+	// from its orders. Amounts and payment data are kept for accounting.
+	AnonymizeCustomerOrders(ctx context.Context, customerID string) (int64, error)
+
+	// MergeCustomerOrders moves the orders of a guest customer to the customer
+	// account it merged into.
+	MergeCustomerOrders(ctx context.Context, sourceID, targetID string) (int64, error)
 }
 
 type orderStorage struct {
@@ -143,3 +156,58 @@ func (s *orderStorage) DeletePlaceOrder(ctx context.Context, orderID string) err
 
 	return nil
 }
+
+func (s *orderStorage) CancelPlaceOrder(ctx context.Context, orderID string) (*dbPlaceOrder, error) {
+
+	filter := bson.D{
+		{Key: "_id", Value: orderID},
+		{Key: "orderStatus", Value: OrderStatus_PENDING},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "orderStatus", Value: orderStatusCancelled},
+			{Key: "timestamps.updateTime", Value: time.Now()},
+		}},
+	}
+
+	var order dbPlaceOrder
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := s.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&order); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (s *orderStorage) AnonymizeCustomerOrders(ctx context.Context, customerID string) (int64, error) {
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "customerPhone", Value: ""},
+			{Key: "customerAddress", Value: nil},
+		}},
+	}
+
+	res, err := s.coll.UpdateMany(ctx, bson.M{"customerId": customerID}, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+func (s *orderStorage) MergeCustomerOrders(ctx context.Context, sourceID, targetID string) (int64, error) {
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "customerId", Value: targetID},
+		}},
+	}
+
+	res, err := s.coll.UpdateMany(ctx, bson.M{"customerId": sourceID}, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
@@ -20,7 +20,7 @@ func SetupValidator() {
 		"CustomerId":        "required,uuid4",
 		"MerchantId":        "required,uuid4",
 		"Items":             "required,vitems",
-		"CustomerAddressId": "required,uuid4",
+		"CustomerAddressId": "omitempty,uuid4",
 		"PaymentMethods":    "vpayment_method",
 	}, pb.CreatePlaceOrderRequest{})
 